@@ -4,10 +4,13 @@ import (
 	"gomander/internal/app"
 	commanddomain "gomander/internal/command/domain"
 	commandgroupdomain "gomander/internal/commandgroup/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
 	configdomain "gomander/internal/config/domain"
 	localizationdomain "gomander/internal/localization/domain"
+	"gomander/internal/logstore"
 	"gomander/internal/project/application/usecases"
 	projectdomain "gomander/internal/project/domain"
+	"gomander/internal/runner"
 )
 
 type WailsControllers struct {
@@ -112,12 +115,36 @@ func (wc *WailsControllers) StopCommandGroupController(commandGroupId string) er
 	return wc.useCases.StopCommandGroup.Execute(commandGroupId)
 }
 
+func (wc *WailsControllers) RestartCommandGroupController(commandGroupId string) error {
+	return wc.useCases.RestartCommandGroup.Execute(commandGroupId)
+}
+
+func (wc *WailsControllers) GetPipelineStatesController() map[string]runner.PipelineState {
+	return wc.useCases.GetPipelineStates.Execute()
+}
+
 // Command controllers
 
 func (wc *WailsControllers) GetCommandsController() ([]commanddomain.Command, error) {
 	return wc.useCases.GetCommands.Execute()
 }
 
+func (wc *WailsControllers) GetCommandRunStatesController() map[string]runner.RunState {
+	return wc.useCases.GetCommandRunStates.Execute()
+}
+
+func (wc *WailsControllers) GetCommandLogRunsController(commandId string) ([]logstore.Run, error) {
+	return wc.useCases.GetCommandLogRuns.Execute(commandId)
+}
+
+func (wc *WailsControllers) GetCommandLogsController(commandId string, runId string, offset int, limit int) (logstore.Page, error) {
+	return wc.useCases.GetCommandLogs.Execute(commandId, runId, offset, limit)
+}
+
+func (wc *WailsControllers) GetCommandRunsController(filter commandrundomain.Filter) ([]commandrundomain.CommandRun, error) {
+	return wc.useCases.GetCommandRuns.Execute(filter)
+}
+
 func (wc *WailsControllers) AddCommandController(command commanddomain.Command) error {
 	return wc.useCases.AddCommand.Execute(command)
 }
@@ -139,13 +166,29 @@ func (wc *WailsControllers) ReorderCommandsController(orderedIds []string) error
 }
 
 func (wc *WailsControllers) RunCommandController(commandId string) error {
-	return wc.useCases.RunCommand.Execute(commandId)
+	return wc.useCases.RunCommand.Execute(commandId, commandrundomain.TriggerUI)
 }
 
 func (wc *WailsControllers) StopCommandController(commandId string) error {
 	return wc.useCases.StopCommand.Execute(commandId)
 }
 
+func (wc *WailsControllers) RestartCommandController(commandId string) error {
+	return wc.useCases.RestartCommand.Execute(commandId, commandrundomain.TriggerUI)
+}
+
+func (wc *WailsControllers) AdoptOrphanedProcessController(commandId string) error {
+	return wc.useCases.AdoptOrphanedProcess.Execute(commandId)
+}
+
+func (wc *WailsControllers) ResizeCommandTerminalController(commandId string, size runner.TerminalSize) error {
+	return wc.useCases.ResizeCommandTerminal.Execute(commandId, size)
+}
+
+func (wc *WailsControllers) WriteToCommandController(commandId string, data string) error {
+	return wc.useCases.WriteToCommand.Execute(commandId, data)
+}
+
 // Localization controllers
 
 func (wc *WailsControllers) GetTranslationController(locale string) (*localizationdomain.Localization, error) {
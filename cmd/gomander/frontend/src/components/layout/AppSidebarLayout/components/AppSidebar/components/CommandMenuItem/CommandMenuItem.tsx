@@ -1,6 +1,13 @@
 import { useSortable } from "@dnd-kit/sortable";
 import { CSS } from "@dnd-kit/utilities";
-import { GripVertical, LinkIcon, Play, Square } from "lucide-react";
+import {
+	GripVertical,
+	LinkIcon,
+	Play,
+	Square,
+	UserCheck,
+	X,
+} from "lucide-react";
 import { useTranslation } from "react-i18next";
 import { toast } from "sonner";
 
@@ -15,12 +22,18 @@ import {
 	ContextMenuTrigger,
 } from "@/design-system/components/ui/context-menu.tsx";
 import { SidebarMenuButton } from "@/design-system/components/ui/sidebar.tsx";
+import {
+	Tooltip,
+	TooltipContent,
+	TooltipTrigger,
+} from "@/design-system/components/ui/tooltip.tsx";
 import { cn } from "@/design-system/lib/utils.ts";
 import { parseError } from "@/helpers/errorHelpers.ts";
 import { fetchCommandGroups } from "@/queries/fetchCommandGroups.ts";
 import { fetchCommands } from "@/queries/fetchCommands.ts";
 import { useCommandStore } from "@/store/commandStore.ts";
 import { CommandStatus } from "@/types/CommandStatus.ts";
+import { adoptOrphanedProcess } from "@/useCases/command/adoptOrphanedProcess.ts";
 import { cleanCommandError } from "@/useCases/command/cleanCommandError.ts";
 import { deleteCommand } from "@/useCases/command/deleteCommand.ts";
 import { duplicateCommand } from "@/useCases/command/duplicateCommand.ts";
@@ -135,8 +148,28 @@ export const CommandMenuItem = ({
 		setActiveCommandId(command.id);
 	};
 
+	const handleAdoptOrphanedProcess = async () => {
+		try {
+			await adoptOrphanedProcess(command.id);
+		} catch (e) {
+			toast.error(parseError(e, t("toast.command.adoptFailed")));
+		}
+		setActiveCommandId(command.id);
+	};
+
+	// Orphaned processes are stopped like the running commands, though they can only be killed
+	const handleKillOrphanedProcess = async () => {
+		try {
+			await stopCommand(command.id);
+		} catch (e) {
+			toast.error(parseError(e, t("toast.command.killFailed")));
+		}
+	};
+
 	const isIdle = commandsStatus[command.id] === CommandStatus.IDLE;
 	const isRunning = commandsStatus[command.id] === CommandStatus.RUNNING;
+	const isOrphaned = commandsStatus[command.id] === CommandStatus.ORPHANED;
+	const isBusy = isRunning || isOrphaned;
 	const isActiveCommand = activeCommandId === command.id;
 	const hasError = commandIdsWithErrors.includes(command.id);
 
@@ -155,6 +188,11 @@ export const CommandMenuItem = ({
 			isRunning &&
 			theme === "dark" &&
 			"bg-green-200/40 hover:bg-green-200/40 focus:bg-green-200/40 active:bg-green-200/40",
+		isOrphaned &&
+			"bg-amber-100 hover:bg-amber-200 focus:bg-amber-100 active:bg-amber-100",
+		isOrphaned &&
+			theme === "dark" &&
+			"bg-amber-300/30 hover:bg-amber-200/40 focus:bg-amber-300/30 active:bg-amber-300/30",
 		hasError &&
 			"bg-red-100 hover:bg-red-200 focus:bg-red-100 active:bg-red-100",
 		hasError &&
@@ -190,7 +228,11 @@ export const CommandMenuItem = ({
 							)}
 							<p
 								className="cursor-default text-left truncate"
-								title={command.name}
+								title={
+									isOrphaned
+										? `${command.name} - ${t("sidebar.commands.orphaned")}`
+										: command.name
+								}
 							>
 								{command.name}
 							</p>
@@ -217,24 +259,48 @@ export const CommandMenuItem = ({
 									onClick={handleStopCommand}
 								/>
 							)}
+							{isOrphaned && (
+								<div className="flex items-center gap-1">
+									<Tooltip>
+										<TooltipTrigger asChild>
+											<UserCheck
+												size={16}
+												className="text-muted-foreground cursor-pointer hover:text-primary"
+												onClick={handleAdoptOrphanedProcess}
+											/>
+										</TooltipTrigger>
+										<TooltipContent>{t("sidebar.commands.adopt")}</TooltipContent>
+									</Tooltip>
+									<Tooltip>
+										<TooltipTrigger asChild>
+											<X
+												size={16}
+												className="text-muted-foreground cursor-pointer hover:text-primary"
+												onClick={handleKillOrphanedProcess}
+											/>
+										</TooltipTrigger>
+										<TooltipContent>{t("sidebar.commands.kill")}</TooltipContent>
+									</Tooltip>
+								</div>
+							)}
 						</div>
 					</button>
 				</SidebarMenuButton>
 			</ContextMenuTrigger>
 			<ContextMenuContent>
-				<ContextMenuItem disabled={isRunning} onClick={handleEditCommand}>
+				<ContextMenuItem disabled={isBusy} onClick={handleEditCommand}>
 					{t("common.edit")}
 				</ContextMenuItem>
-				<ContextMenuItem disabled={isRunning} onClick={handleDuplicateCommand}>
+				<ContextMenuItem disabled={isBusy} onClick={handleDuplicateCommand}>
 					{t("common.duplicate")}
 				</ContextMenuItem>
 				{!insideGroupId && (
-					<ContextMenuItem disabled={isRunning} onClick={handleDeleteCommand}>
+					<ContextMenuItem disabled={isBusy} onClick={handleDeleteCommand}>
 						{t("common.delete")}
 					</ContextMenuItem>
 				)}
 				{insideGroupId && (
-					<ContextMenuItem disabled={isRunning} onClick={handleRemoveFromGroup}>
+					<ContextMenuItem disabled={isBusy} onClick={handleRemoveFromGroup}>
 						{t("sidebar.commands.removeFromGroup")}
 					</ContextMenuItem>
 				)}
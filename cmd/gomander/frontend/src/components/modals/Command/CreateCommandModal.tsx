@@ -52,7 +52,7 @@ export const CreateCommandModal = ({
 			command: "",
 			workingDirectory: "",
 			link: "",
-			errorPatterns: "",
+			errorPatterns: [],
 		},
 	});
 
@@ -70,9 +70,17 @@ export const CreateCommandModal = ({
 				workingDirectory: values.workingDirectory,
 				position: 0, // Will be set by the backend
 				link: values.link,
-				errorPatterns: values.errorPatterns
-					.split("\n")
-					.filter((pattern) => pattern.trim() !== ""),
+				errorPatterns: values.errorPatterns,
+				terminalMode: false,
+				interactive: false,
+				environmentVariables: [],
+				envFiles: [],
+				restartPolicy: "",
+				maxRestarts: 0,
+				stopSignal: "",
+				stopTimeoutSeconds: 0,
+				stopCommand: "",
+				restartMode: "",
 			});
 			toast.success(t("toast.command.createSuccess"));
 
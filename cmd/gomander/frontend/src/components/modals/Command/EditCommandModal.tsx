@@ -10,6 +10,7 @@ import { CommandErrorPatternsField } from "@/components/modals/Command/common/Co
 import { CommandLinkField } from "@/components/modals/Command/common/CommandLinkField.tsx";
 import { CommandNameField } from "@/components/modals/Command/common/CommandNameField.tsx";
 import { CommandWorkingDirectoryField } from "@/components/modals/Command/common/CommandWorkingDirectoryField.tsx";
+import { errorPatternsToFormValues } from "@/components/modals/Command/common/errorPatterns.ts";
 import {
 	type FormSchemaType,
 	formSchema,
@@ -53,7 +54,7 @@ export const EditCommandModal = ({
 			command: command?.command || "",
 			workingDirectory: command?.workingDirectory || "",
 			link: command?.link || "",
-			errorPatterns: command?.errorPatterns.join("\n") || "",
+			errorPatterns: errorPatternsToFormValues(command?.errorPatterns),
 		},
 	});
 
@@ -70,9 +71,7 @@ export const EditCommandModal = ({
 				command: values.command,
 				workingDirectory: values.workingDirectory,
 				link: values.link,
-				errorPatterns: values.errorPatterns
-					.split("\n")
-					.filter((pattern) => pattern.trim() !== ""),
+				errorPatterns: values.errorPatterns,
 			});
 
 			toast.success(t("toast.command.updateSuccess"));
@@ -1,7 +1,10 @@
-import { useFormContext } from "react-hook-form";
+import { Plus, Trash } from "lucide-react";
+import { useFieldArray, useFormContext } from "react-hook-form";
 import { useTranslation } from "react-i18next";
 
 import type { FormSchemaType } from "@/components/modals/Command/common/formSchema.ts";
+import { Button } from "@/design-system/components/ui/button.tsx";
+import { Checkbox } from "@/design-system/components/ui/checkbox.tsx";
 import {
 	FormControl,
 	FormDescription,
@@ -10,34 +13,149 @@ import {
 	FormLabel,
 	FormMessage,
 } from "@/design-system/components/ui/form.tsx";
-import { Textarea } from "@/design-system/components/ui/textarea.tsx";
+import { Input } from "@/design-system/components/ui/input.tsx";
+import {
+	Select,
+	SelectContent,
+	SelectItem,
+	SelectTrigger,
+	SelectValue,
+} from "@/design-system/components/ui/select.tsx";
 
 export const CommandErrorPatternsField = () => {
 	const { t } = useTranslation();
-	const form = useFormContext<FormSchemaType>();
+	const { control } = useFormContext<FormSchemaType>();
+
+	const { fields, append, remove } = useFieldArray({
+		control,
+		name: "errorPatterns" as const,
+	});
+
+	const addNewPattern = () => {
+		append({ pattern: "", regex: false, severity: "error", label: "" });
+	};
+
+	const removePattern = (index: number) => () => {
+		remove(index);
+	};
 
 	return (
-		<FormField
-			control={form.control}
-			name="errorPatterns"
-			render={({ field }) => (
-				<FormItem>
-					<FormLabel>{t("commandForm.errorPatternsLabel")}</FormLabel>
-					<FormDescription className="text-xs">
-						{t("commandForm.errorPatternsDescription")}
-					</FormDescription>
-					<FormControl>
-						<Textarea
-							autoComplete="off"
-							autoCorrect="off"
-							autoCapitalize="off"
-							placeholder="[nodemon] app crashed"
-							{...field}
-						/>
-					</FormControl>
-					<FormMessage />
-				</FormItem>
-			)}
-		/>
+		<FormItem>
+			<FormLabel>{t("commandForm.errorPatternsLabel")}</FormLabel>
+			<FormDescription className="text-xs">
+				{t("commandForm.errorPatternsDescription")}
+			</FormDescription>
+			<div className="flex flex-col items-center">
+				{fields.length !== 0 && (
+					<div className="flex flex-col gap-2 w-full">
+						{fields.map((field, index) => (
+							<div
+								className="flex flex-row w-full items-start gap-2 justify-between"
+								key={field.id}
+							>
+								<FormField
+									control={control}
+									name={`errorPatterns.${index}.pattern` as const}
+									render={({ field }) => (
+										<FormItem className="flex-1">
+											<FormControl>
+												<Input
+													autoComplete="off"
+													autoCorrect="off"
+													autoCapitalize="off"
+													placeholder="[nodemon] app crashed"
+													{...field}
+												/>
+											</FormControl>
+											<FormMessage />
+										</FormItem>
+									)}
+								/>
+								<FormField
+									control={control}
+									name={`errorPatterns.${index}.label` as const}
+									render={({ field }) => (
+										<FormItem className="w-28">
+											<FormControl>
+												<Input
+													autoComplete="off"
+													placeholder={t(
+														"commandForm.errorPatternLabelPlaceholder",
+													)}
+													{...field}
+												/>
+											</FormControl>
+										</FormItem>
+									)}
+								/>
+								<FormField
+									control={control}
+									name={`errorPatterns.${index}.severity` as const}
+									render={({ field }) => (
+										<FormItem className="w-28">
+											<Select
+												onValueChange={field.onChange}
+												value={field.value}
+											>
+												<FormControl>
+													<SelectTrigger>
+														<SelectValue />
+													</SelectTrigger>
+												</FormControl>
+												<SelectContent>
+													<SelectItem value="error">
+														{t("commandForm.errorPatternSeverityError")}
+													</SelectItem>
+													<SelectItem value="warning">
+														{t("commandForm.errorPatternSeverityWarning")}
+													</SelectItem>
+													<SelectItem value="info">
+														{t("commandForm.errorPatternSeverityInfo")}
+													</SelectItem>
+												</SelectContent>
+											</Select>
+										</FormItem>
+									)}
+								/>
+								<FormField
+									control={control}
+									name={`errorPatterns.${index}.regex` as const}
+									render={({ field }) => (
+										<FormItem className="flex flex-row items-center gap-1 h-9">
+											<FormControl>
+												<Checkbox
+													checked={field.value}
+													onCheckedChange={(checked) =>
+														field.onChange(checked === true)
+													}
+												/>
+											</FormControl>
+											<FormLabel className="text-sm font-normal">
+												{t("commandForm.errorPatternRegexLabel")}
+											</FormLabel>
+										</FormItem>
+									)}
+								/>
+								<Trash
+									size={18}
+									className="text-muted-foreground cursor-pointer hover:text-destructive mt-2.5"
+									onClick={removePattern(index)}
+								/>
+							</div>
+						))}
+					</div>
+				)}
+				<Button
+					size="sm"
+					type="button"
+					variant="ghost"
+					className="mt-2 w-auto"
+					onClick={addNewPattern}
+				>
+					{t("common.add")}
+					<Plus />
+				</Button>
+			</div>
+		</FormItem>
 	);
 };
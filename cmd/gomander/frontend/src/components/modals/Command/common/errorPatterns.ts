@@ -0,0 +1,18 @@
+import type { ErrorPattern } from "@/contracts/types.ts";
+
+export const ERROR_PATTERN_SEVERITIES = ["error", "warning", "info"] as const;
+export type ErrorPatternSeverity = (typeof ERROR_PATTERN_SEVERITIES)[number];
+
+// Patterns saved without a severity are errors, as the backend treats them
+const toSeverity = (severity: string): ErrorPatternSeverity =>
+	ERROR_PATTERN_SEVERITIES.find((s) => s === severity) ?? "error";
+
+export const errorPatternsToFormValues = (
+	patterns: ErrorPattern[] | undefined,
+) =>
+	(patterns ?? []).map((pattern) => ({
+		pattern: pattern.pattern,
+		regex: pattern.regex,
+		severity: toSeverity(pattern.severity),
+		label: pattern.label ?? "",
+	}));
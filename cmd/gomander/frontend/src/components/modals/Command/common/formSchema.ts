@@ -1,5 +1,6 @@
 import { z } from "zod";
 
+import { ERROR_PATTERN_SEVERITIES } from "@/components/modals/Command/common/errorPatterns.ts";
 import i18n from "@/design-system/lib/i18n.ts";
 
 export const formSchema = z.object({
@@ -11,6 +12,15 @@ export const formSchema = z.object({
 	}),
 	workingDirectory: z.string().min(0),
 	link: z.string().min(0),
-	errorPatterns: z.string().min(0),
+	errorPatterns: z.array(
+		z.object({
+			pattern: z.string().min(1, {
+				error: () => i18n.t("commandForm.validation.patternRequired"),
+			}),
+			regex: z.boolean(),
+			severity: z.enum(ERROR_PATTERN_SEVERITIES),
+			label: z.string(),
+		}),
+	),
 });
 export type FormSchemaType = z.infer<typeof formSchema>;
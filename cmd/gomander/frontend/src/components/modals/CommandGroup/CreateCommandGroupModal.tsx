@@ -5,6 +5,7 @@ import { useTranslation } from "react-i18next";
 import { toast } from "sonner";
 
 import { CommandGroupCommandsField } from "@/components/modals/CommandGroup/common/CommandGroupCommandsField/CommandGroupCommandsField.tsx";
+import { CommandGroupModeField } from "@/components/modals/CommandGroup/common/CommandGroupModeField.tsx";
 import { CommandGroupNameField } from "@/components/modals/CommandGroup/common/CommandGroupNameField.tsx";
 import {
 	type FormSchemaType,
@@ -40,6 +41,8 @@ export const CreateCommandGroupModal = ({
 		defaultValues: {
 			name: "",
 			commands: [],
+			mode: "parallel",
+			continueOnFailure: false,
 		},
 	});
 
@@ -55,6 +58,8 @@ export const CreateCommandGroupModal = ({
 				name: values.name,
 				commands: values.commands,
 				position: 0, // Will be set by the backend
+				mode: values.mode,
+				continueOnFailure: values.continueOnFailure,
 			});
 			toast.success(t("toast.commandGroup.createSuccess"));
 		} catch (e) {
@@ -86,6 +91,7 @@ export const CreateCommandGroupModal = ({
 						<div className="space-y-6 mt-4 mb-2">
 							<CommandGroupNameField />
 							<CommandGroupCommandsField />
+							<CommandGroupModeField />
 						</div>
 						<DialogFooter>
 							<DialogClose asChild>
@@ -5,6 +5,7 @@ import { useTranslation } from "react-i18next";
 import { toast } from "sonner";
 
 import { CommandGroupCommandsField } from "@/components/modals/CommandGroup/common/CommandGroupCommandsField/CommandGroupCommandsField.tsx";
+import { CommandGroupModeField } from "@/components/modals/CommandGroup/common/CommandGroupModeField.tsx";
 import { CommandGroupNameField } from "@/components/modals/CommandGroup/common/CommandGroupNameField.tsx";
 import {
 	type FormSchemaType,
@@ -40,6 +41,8 @@ export const EditCommandGroupModal = ({
 		values: {
 			name: commandGroup?.name || "",
 			commands: commandGroup?.commands.map((c) => c.id) || [],
+			mode: commandGroup?.mode === "pipeline" ? "pipeline" : "parallel",
+			continueOnFailure: commandGroup?.continueOnFailure || false,
 		},
 	});
 
@@ -52,6 +55,8 @@ export const EditCommandGroupModal = ({
 			...commandGroup,
 			name: values.name,
 			commands: values.commands,
+			mode: values.mode,
+			continueOnFailure: values.continueOnFailure,
 		};
 
 		try {
@@ -86,6 +91,7 @@ export const EditCommandGroupModal = ({
 						<div className="space-y-6 mt-4 mb-2">
 							<CommandGroupNameField />
 							<CommandGroupCommandsField />
+							<CommandGroupModeField />
 						</div>
 						<DialogFooter>
 							<DialogClose asChild>
@@ -0,0 +1,84 @@
+import { useFormContext } from "react-hook-form";
+import { useTranslation } from "react-i18next";
+
+import type { FormSchemaType } from "@/components/modals/CommandGroup/common/formSchema.ts";
+import { Checkbox } from "@/design-system/components/ui/checkbox.tsx";
+import {
+	FormControl,
+	FormDescription,
+	FormField,
+	FormItem,
+	FormLabel,
+	FormMessage,
+} from "@/design-system/components/ui/form.tsx";
+import {
+	Select,
+	SelectContent,
+	SelectItem,
+	SelectTrigger,
+	SelectValue,
+} from "@/design-system/components/ui/select.tsx";
+
+export const CommandGroupModeField = () => {
+	const { t } = useTranslation();
+	const form = useFormContext<FormSchemaType>();
+	const mode = form.watch("mode");
+
+	return (
+		<div className="space-y-3">
+			<FormField
+				control={form.control}
+				name="mode"
+				render={({ field }) => (
+					<FormItem>
+						<FormLabel>{t("commandGroupForm.modeLabel")}</FormLabel>
+						<Select onValueChange={field.onChange} value={field.value}>
+							<FormControl>
+								<SelectTrigger>
+									<SelectValue />
+								</SelectTrigger>
+							</FormControl>
+							<SelectContent>
+								<SelectItem value="parallel">
+									{t("commandGroupForm.modeParallel")}
+								</SelectItem>
+								<SelectItem value="pipeline">
+									{t("commandGroupForm.modePipeline")}
+								</SelectItem>
+							</SelectContent>
+						</Select>
+						<FormDescription className="text-xs">
+							{t("commandGroupForm.modeDescription")}
+						</FormDescription>
+						<FormMessage />
+					</FormItem>
+				)}
+			/>
+			{mode === "pipeline" && (
+				<FormField
+					control={form.control}
+					name="continueOnFailure"
+					render={({ field }) => (
+						<FormItem className="flex flex-row items-center gap-2">
+							<FormControl>
+								<Checkbox
+									checked={field.value}
+									onCheckedChange={(checked) =>
+										field.onChange(checked === true)
+									}
+									className="mt-0.5"
+								/>
+							</FormControl>
+							<FormLabel className="flex flex-col gap-1 items-start">
+								<span>{t("commandGroupForm.continueOnFailureLabel")}</span>
+								<span className="text-muted-foreground text-sm font-normal">
+									{t("commandGroupForm.continueOnFailureDescription")}
+								</span>
+							</FormLabel>
+						</FormItem>
+					)}
+				/>
+			)}
+		</div>
+	);
+};
@@ -9,5 +9,7 @@ export const formSchema = z.object({
 	commands: z.array(z.string()).min(1, {
 		error: () => i18n.t("commandGroupForm.validation.commandsRequired"),
 	}),
+	mode: z.enum(["parallel", "pipeline"]),
+	continueOnFailure: z.boolean(),
 });
 export type FormSchemaType = z.infer<typeof formSchema>;
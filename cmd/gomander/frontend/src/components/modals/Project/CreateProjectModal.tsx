@@ -49,6 +49,8 @@ export const CreateProjectModal = ({
 			id: crypto.randomUUID(),
 			name: values.name,
 			workingDirectory: values.baseWorkingDirectory,
+			environmentVariables: [],
+			envFiles: [],
 		});
 
 		onSuccess();
@@ -1,4 +1,6 @@
 import { Fragment, useEffect, useRef } from "react";
+import { useTranslation } from "react-i18next";
+import { toast } from "sonner";
 
 import { getCommandGroupSectionOpenLocalStorageKey } from "@/constants/localStorage.ts";
 import { useTheme } from "@/contexts/theme.tsx";
@@ -9,7 +11,7 @@ import {
 	formatLogTimestamp,
 	prependTimestamp,
 } from "@/screens/ExperimentalLogsScreen/helpers.ts";
-import { useCommandStore } from "@/store/commandStore.ts";
+import { commandStore, useCommandStore } from "@/store/commandStore.ts";
 import { terminalStore } from "@/store/terminalStore.ts";
 import { useUserConfigurationStore } from "@/store/userConfigurationStore.ts";
 import { CommandStatus } from "@/types/CommandStatus.ts";
@@ -18,7 +20,13 @@ import { recordCommandsErrors } from "@/useCases/command/recordCommandsErrors.ts
 import { updateCommandStatus } from "@/useCases/command/updateCommandStatus.ts";
 import { XTERM_THEMES } from "../../screens/ExperimentalLogsScreen/components/CommandTerminal.tsx";
 
+const getCommandName = (id: string) => {
+	const { commands } = commandStore.getState();
+	return commands.find((command) => command.id === id)?.name ?? id;
+};
+
 export const EventListenersContainer = () => {
+	const { t } = useTranslation();
 	const addLogs = useCommandStore((state) => state.addLogs);
 	const userConfig = useUserConfigurationStore((state) => state.userConfig);
 	const { theme } = useTheme();
@@ -28,6 +36,10 @@ export const EventListenersContainer = () => {
 	}, [theme]);
 
 	const logsBuffer = useRef(new Map<string, string[]>());
+	// Output waiting to be written to the terminals, in order, with whether it's a raw chunk
+	const terminalBuffer = useRef(
+		new Map<string, { text: string; raw: boolean }[]>(),
+	);
 	const errorBuffer = useRef<string[]>([]);
 
 	useEffect(() => {
@@ -43,18 +55,21 @@ export const EventListenersContainer = () => {
 				logsBuffer.current.clear();
 
 				// Write directly to already-open terminals (bypasses React re-render cycle).
-				// timestamps stay xterm-only.
+				// timestamps stay xterm-only, and raw chunks are written untouched.
 				const ts = formatLogTimestamp(new Date());
 				const { terminals, bufferLogs } = terminalStore.getState();
-				for (const [commandId, lines] of bufferCopy) {
-					const stamped = lines.map((line) => prependTimestamp(line, ts));
+				for (const [commandId, entries] of terminalBuffer.current) {
+					const chunks = entries.map(({ text, raw }) =>
+						raw ? text : `${prependTimestamp(text, ts)}\n`,
+					);
 					const term = terminals.get(commandId);
 					if (term) {
-						for (const line of stamped) term.writeln(line);
+						for (const chunk of chunks) term.write(chunk);
 					} else {
-						bufferLogs(commandId, stamped);
+						bufferLogs(commandId, chunks);
 					}
 				}
+				terminalBuffer.current.clear();
 			}
 		}, 30); // Flush every 30ms
 
@@ -66,18 +81,22 @@ export const EventListenersContainer = () => {
 		eventService.eventsOn(
 			Event.NEW_LOG_ENTRY,
 			(data: EventData[Event.NEW_LOG_ENTRY]) => {
-				const { id, line } = data;
+				const { id, line, raw } = data;
 				if (!logsBuffer.current.has(id)) {
 					logsBuffer.current.set(id, []);
 				}
 				logsBuffer.current.get(id)?.push(line);
+
+				const entries = terminalBuffer.current.get(id) ?? [];
+				entries.push({ text: line, raw: raw === "true" });
+				terminalBuffer.current.set(id, entries);
 			},
 		);
 
 		eventService.eventsOn(
 			Event.PROCESS_FINISHED,
 			(data: EventData[Event.PROCESS_FINISHED]) =>
-				updateCommandStatus(data, CommandStatus.IDLE),
+				updateCommandStatus(data.commandId, CommandStatus.IDLE),
 		);
 
 		eventService.eventsOn(
@@ -99,8 +118,46 @@ export const EventListenersContainer = () => {
 
 		eventService.eventsOn(
 			Event.COMMAND_ERROR_DETECTED,
-			(data: EventData[Event.COMMAND_ERROR_DETECTED]) =>
-				errorBuffer.current.push(data),
+			(data: EventData[Event.COMMAND_ERROR_DETECTED]) => {
+				// Warnings and info matches stay in the logs without marking the command as errored
+				if (data.severity !== "error") {
+					return;
+				}
+				errorBuffer.current.push(data.id);
+			},
+		);
+
+		eventService.eventsOn(
+			Event.PROCESS_RESTARTING,
+			(data: EventData[Event.PROCESS_RESTARTING]) => {
+				const params = {
+					name: getCommandName(data.id),
+					seconds: Math.ceil(data.delayMs / 1000),
+					attempt: data.attempt,
+					maxAttempts: data.maxAttempts,
+				};
+				toast.info(
+					data.maxAttempts > 0
+						? t("toast.command.restarting", params)
+						: t("toast.command.restartingUnlimited", params),
+				);
+			},
+		);
+
+		eventService.eventsOn(
+			Event.PROCESS_READY,
+			(data: EventData[Event.PROCESS_READY]) =>
+				toast.success(
+					t("toast.command.ready", { name: getCommandName(data) }),
+				),
+		);
+
+		eventService.eventsOn(
+			Event.PROCESS_READINESS_TIMEOUT,
+			(data: EventData[Event.PROCESS_READINESS_TIMEOUT]) =>
+				toast.warning(
+					t("toast.command.readinessTimeout", { name: getCommandName(data) }),
+				),
 		);
 
 		// Clean listeners on all events
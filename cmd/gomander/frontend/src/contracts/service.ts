@@ -1,6 +1,7 @@
 import { AskForDirPath, OpenFileFolder } from "../../wailsjs/go/fs/UIFsHelper";
 import {
 	AddCommandController,
+	AdoptOrphanedProcessController,
 	CloseProjectController,
 	CreateCommandGroupController,
 	CreateProjectController,
@@ -12,6 +13,8 @@ import {
 	ExportProjectController,
 	GetAvailableProjectsController,
 	GetCommandGroupsController,
+	GetCommandLogsController,
+	GetCommandRunStatesController,
 	GetCommandsController,
 	GetCurrentProjectController,
 	GetProjectToImportController,
@@ -25,6 +28,7 @@ import {
 	RemoveCommandFromCommandGroupController,
 	ReorderCommandGroupsController,
 	ReorderCommandsController,
+	ResizeCommandTerminalController,
 	RunCommandController,
 	RunCommandGroupController,
 	SaveUserConfigController,
@@ -58,6 +62,8 @@ export const dataService = {
 	stopCommandGroup: StopCommandGroupController,
 	getCommandGroups: GetCommandGroupsController,
 	getCommands: GetCommandsController,
+	getCommandRunStates: GetCommandRunStatesController,
+	getCommandLogs: GetCommandLogsController,
 	getCurrentProject:
 		GetCurrentProjectController as () => Promise<domain.Project | null>,
 	getUserConfig: GetUserConfigController,
@@ -66,6 +72,8 @@ export const dataService = {
 	saveUserConfig: SaveUserConfigController,
 	createProject: CreateProjectController,
 	stopCommand: StopCommandController,
+	adoptOrphanedProcess: AdoptOrphanedProcessController,
+	resizeCommandTerminal: ResizeCommandTerminalController,
 	openProject: OpenProjectController,
 	closeProject: CloseProjectController,
 	deleteProject: DeleteProjectController,
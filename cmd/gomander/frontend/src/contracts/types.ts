@@ -1,14 +1,16 @@
 /** biome-ignore-all lint/style/useLiteralEnumMembers: proxy to wails types */
-import type { domain } from "../../wailsjs/go/models.ts";
+import type { domain, runner } from "../../wailsjs/go/models.ts";
 import { event } from "../../wailsjs/go/models.ts";
 
 // Types
 export type Command = domain.Command;
+export type ErrorPattern = domain.ErrorPattern;
 export type UserConfig = domain.Config;
 export type CommandGroup = domain.CommandGroup;
 export type Project = domain.Project;
 export type ProjectExport = domain.ProjectExportJSONv1;
 export type Localization = domain.Localization;
+export type RunState = runner.RunState;
 
 // Enums
 export enum Event {
@@ -17,15 +19,35 @@ export enum Event {
 	PROCESS_STARTED = event.Event.PROCESS_STARTED,
 	COMMAND_GROUP_DELETED = event.Event.COMMAND_GROUP_DELETED,
 	COMMAND_ERROR_DETECTED = event.Event.COMMAND_ERROR_DETECTED,
+	PROCESS_RESTARTING = event.Event.PROCESS_RESTARTING,
+	PROCESS_READY = event.Event.PROCESS_READY,
+	PROCESS_READINESS_TIMEOUT = event.Event.PROCESS_READINESS_TIMEOUT,
 }
 
 export type EventData = {
 	[Event.NEW_LOG_ENTRY]: {
 		id: string;
 		line: string;
+		// Set to "true" for the chunks of commands running in a terminal, written as they come
+		raw?: string;
 	};
-	[Event.PROCESS_FINISHED]: string;
+	[Event.PROCESS_FINISHED]: RunState;
 	[Event.PROCESS_STARTED]: string;
 	[Event.COMMAND_GROUP_DELETED]: string;
-	[Event.COMMAND_ERROR_DETECTED]: string;
+	[Event.COMMAND_ERROR_DETECTED]: {
+		id: string;
+		line: string;
+		pattern: string;
+		severity: string;
+		label?: string;
+	};
+	[Event.PROCESS_RESTARTING]: {
+		id: string;
+		attempt: number;
+		// 0 when the restarts are not limited
+		maxAttempts: number;
+		delayMs: number;
+	};
+	[Event.PROCESS_READY]: string;
+	[Event.PROCESS_READINESS_TIMEOUT]: string;
 };
@@ -1,8 +1,10 @@
 import { fetchCommandGroups } from "@/queries/fetchCommandGroups.ts";
 import { fetchCommands } from "@/queries/fetchCommands.ts";
 import { fetchProject } from "@/queries/fetchProject.ts";
+import { recoverRunningCommands } from "@/useCases/command/recoverRunningCommands.ts";
 
 export const loadAllProjectData = async () => {
 	await Promise.all([fetchCommands(), fetchCommandGroups()]);
+	await recoverRunningCommands();
 	await fetchProject();
 };
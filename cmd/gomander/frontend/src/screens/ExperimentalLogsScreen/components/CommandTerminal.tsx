@@ -6,7 +6,7 @@ import type { ITheme } from "@xterm/xterm";
 import { useEffect, useRef, useState } from "react";
 
 import { useTheme } from "@/contexts/theme.tsx";
-import { externalBrowserService } from "@/contracts/service.ts";
+import { dataService, externalBrowserService } from "@/contracts/service.ts";
 import { useShortcut } from "@/hooks/useShortcut.ts";
 import { terminalStore } from "@/store/terminalStore.ts";
 import { useTerminalSearch } from "../hooks/useTerminalSearch.ts";
@@ -81,7 +81,7 @@ export const CommandTerminal = ({ commandId }: Props) => {
 		} else {
 			term.open(container);
 			// Backfill logs that arrived before this terminal was opened
-			for (const line of drainPendingLogs(commandId)) term.writeln(line);
+			for (const chunk of drainPendingLogs(commandId)) term.write(chunk);
 		}
 
 		const fit = new FitAddon();
@@ -92,7 +92,15 @@ export const CommandTerminal = ({ commandId }: Props) => {
 		term.loadAddon(links);
 		term.loadAddon(search);
 
-		fit.fit();
+		// Commands running in a terminal get the size of the one displaying them
+		const fitAndResize = () => {
+			fit.fit();
+			dataService
+				.resizeCommandTerminal(commandId, { cols: term.cols, rows: term.rows })
+				.catch(() => {});
+		};
+
+		fitAndResize();
 		fitRef.current = fit;
 		searchRef.current = search;
 
@@ -102,7 +110,7 @@ export const CommandTerminal = ({ commandId }: Props) => {
 			},
 		);
 
-		const ro = new ResizeObserver(() => fitRef.current?.fit());
+		const ro = new ResizeObserver(fitAndResize);
 		ro.observe(container);
 
 		return () => {
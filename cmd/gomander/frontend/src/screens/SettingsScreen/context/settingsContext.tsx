@@ -122,6 +122,8 @@ export const SettingsContextProvider = ({
 			environmentPaths: userConfig.environmentPaths,
 			logLineLimit: userConfig.logLineLimit,
 			locale: i18n.language,
+			integrationsLanAccess: userConfig.integrationsLanAccess,
+			detachedProcesses: userConfig.detachedProcesses,
 		},
 	});
 
@@ -19,6 +19,8 @@ export const userSettingsSchema = z.object({
 		.max(5000, {
 			error: () => i18n.t("userSettingsForm.validation.logLimitMax"),
 		}),
+	integrationsLanAccess: z.boolean(),
+	detachedProcesses: z.boolean(),
 });
 
 export type UserSettingsSchemaType = z.infer<typeof userSettingsSchema>;
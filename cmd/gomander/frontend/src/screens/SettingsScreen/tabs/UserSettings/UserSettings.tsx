@@ -9,6 +9,7 @@ import {
 	CardHeader,
 	CardTitle,
 } from "@/design-system/components/ui/card.tsx";
+import { Checkbox } from "@/design-system/components/ui/checkbox.tsx";
 import {
 	Form,
 	FormControl,
@@ -29,6 +30,7 @@ import {
 import { useSettingsContext } from "@/screens/SettingsScreen/context/settingsContext.tsx";
 import { EnvironmentPathsField } from "@/screens/SettingsScreen/tabs/UserSettings/components/EnvironmentPathsField.tsx";
 import { EnvironmentPathsInfoDialog } from "@/screens/SettingsScreen/tabs/UserSettings/components/EnvironmentPathsInfoDialog.tsx";
+import { IntegrationsSettings } from "@/screens/SettingsScreen/tabs/UserSettings/components/IntegrationsSettings.tsx";
 
 export const UserSettings = () => {
 	const { t } = useTranslation();
@@ -154,8 +156,32 @@ export const UserSettings = () => {
 									</FormItem>
 								)}
 							/>
+							<FormField
+								control={userSettingsForm.control}
+								name="detachedProcesses"
+								render={({ field }) => (
+									<FormItem className="flex flex-row items-center gap-2">
+										<FormControl>
+											<Checkbox
+												checked={field.value}
+												onCheckedChange={(checked) =>
+													field.onChange(checked === true)
+												}
+												className="mt-0.5"
+											/>
+										</FormControl>
+										<FormLabel className="flex flex-col gap-1 items-start">
+											<span>{t("userSettingsForm.detachedProcessesLabel")}</span>
+											<span className="text-muted-foreground text-sm font-normal">
+												{t("userSettingsForm.detachedProcessesDescription")}
+											</span>
+										</FormLabel>
+									</FormItem>
+								)}
+							/>
 						</CardContent>
 					</Card>
+					<IntegrationsSettings />
 				</div>
 			</form>
 		</Form>
@@ -0,0 +1,99 @@
+import { Copy, Plug } from "lucide-react";
+import { useTranslation } from "react-i18next";
+import { toast } from "sonner";
+
+import { Button } from "@/design-system/components/ui/button.tsx";
+import {
+	Card,
+	CardContent,
+	CardDescription,
+	CardHeader,
+	CardTitle,
+} from "@/design-system/components/ui/card.tsx";
+import { Checkbox } from "@/design-system/components/ui/checkbox.tsx";
+import {
+	FormControl,
+	FormDescription,
+	FormField,
+	FormItem,
+	FormLabel,
+} from "@/design-system/components/ui/form.tsx";
+import { Input } from "@/design-system/components/ui/input.tsx";
+import { useSettingsContext } from "@/screens/SettingsScreen/context/settingsContext.tsx";
+import { useUserConfigurationStore } from "@/store/userConfigurationStore.ts";
+
+export const IntegrationsSettings = () => {
+	const { t } = useTranslation();
+	const { userSettingsForm } = useSettingsContext();
+	const integrationsToken = useUserConfigurationStore(
+		(state) => state.userConfig.integrationsToken,
+	);
+
+	const handleCopyToken = async () => {
+		await navigator.clipboard.writeText(integrationsToken);
+		toast.success(t("userSettingsForm.integrationsTokenCopied"));
+	};
+
+	return (
+		<Card>
+			<CardHeader>
+				<CardTitle className="flex items-center space-x-2">
+					<Plug size={20} />
+					<span>{t("userSettingsForm.integrationsTitle")}</span>
+				</CardTitle>
+				<CardDescription>
+					{t("userSettingsForm.integrationsDescription")}
+				</CardDescription>
+			</CardHeader>
+			<CardContent className="space-y-3">
+				<FormItem>
+					<FormLabel>{t("userSettingsForm.integrationsTokenLabel")}</FormLabel>
+					<div className="flex flex-row items-center gap-2">
+						<Input
+							readOnly
+							type="password"
+							value={integrationsToken}
+							className="font-mono"
+						/>
+						<Button
+							type="button"
+							size="icon"
+							variant="outline"
+							title={t("userSettingsForm.integrationsTokenCopy")}
+							disabled={!integrationsToken}
+							onClick={handleCopyToken}
+						>
+							<Copy />
+						</Button>
+					</div>
+					<FormDescription className="text-xs">
+						{t("userSettingsForm.integrationsTokenDescription")}
+					</FormDescription>
+				</FormItem>
+				<FormField
+					control={userSettingsForm.control}
+					name="integrationsLanAccess"
+					render={({ field }) => (
+						<FormItem className="flex flex-row items-center gap-2">
+							<FormControl>
+								<Checkbox
+									checked={field.value}
+									onCheckedChange={(checked) =>
+										field.onChange(checked === true)
+									}
+									className="mt-0.5"
+								/>
+							</FormControl>
+							<FormLabel className="flex flex-col gap-1 items-start">
+								<span>{t("userSettingsForm.integrationsLanAccessLabel")}</span>
+								<span className="text-muted-foreground text-sm font-normal">
+									{t("userSettingsForm.integrationsLanAccessDescription")}
+								</span>
+							</FormLabel>
+						</FormItem>
+					)}
+				/>
+			</CardContent>
+		</Card>
+	);
+};
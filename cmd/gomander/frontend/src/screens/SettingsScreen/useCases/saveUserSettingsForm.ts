@@ -31,11 +31,14 @@ export const saveUserSettingsForm = async (
 
 	try {
 		await changeLanguage(formData.locale);
+		// The settings the form doesn't edit keep their current value
 		await saveUserConfig({
-			lastOpenedProjectId: userConfig.lastOpenedProjectId,
+			...userConfig,
 			environmentPaths: formData.environmentPaths,
 			logLineLimit: formData.logLineLimit,
 			locale: formData.locale,
+			integrationsLanAccess: formData.integrationsLanAccess,
+			detachedProcesses: formData.detachedProcesses,
 		});
 		toast.success(i18n.t("toast.settings.userSaveSuccess"));
 	} catch (e) {
@@ -17,6 +17,9 @@ export const userConfigurationStore = createStore<UserConfigurationStore>()(
 			lastOpenedProjectId: "",
 			logLineLimit: 100,
 			locale: "en",
+			integrationsToken: "",
+			integrationsLanAccess: false,
+			detachedProcesses: false,
 		},
 		setUserConfig: (config: UserConfig) => {
 			set({ userConfig: config, isLoaded: true });
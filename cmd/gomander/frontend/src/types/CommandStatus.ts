@@ -1,4 +1,5 @@
 export enum CommandStatus {
 	IDLE,
 	RUNNING,
+	ORPHANED,
 }
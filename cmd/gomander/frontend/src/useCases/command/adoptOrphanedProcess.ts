@@ -0,0 +1,5 @@
+import { dataService } from "@/contracts/service.ts";
+
+export const adoptOrphanedProcess = async (commandId: string) => {
+	await dataService.adoptOrphanedProcess(commandId);
+};
@@ -0,0 +1,70 @@
+import { dataService } from "@/contracts/service.ts";
+import { commandStore } from "@/store/commandStore.ts";
+import { terminalStore } from "@/store/terminalStore.ts";
+import { userConfigurationStore } from "@/store/userConfigurationStore.ts";
+import { CommandStatus } from "@/types/CommandStatus.ts";
+
+const ACTIVE_STATUSES = ["starting", "running", "stopping"];
+const ORPHANED_STATUS = "orphaned";
+
+// Commands left running by the supervisor while the app was closed are marked as running again,
+// with the tail of the output they logged meanwhile. The ones left running by a crash are marked as
+// orphaned, their output isn't available
+export const recoverRunningCommands = async () => {
+	const runStates = Object.values(await dataService.getCommandRunStates());
+	const { commandsStatus, setCommandsStatus } = commandStore.getState();
+	const { logLineLimit } = userConfigurationStore.getState().userConfig;
+
+	const isIdle = (commandId: string) =>
+		commandsStatus[commandId] === CommandStatus.IDLE;
+
+	const recoveredCommandIds = runStates
+		.filter((runState) => ACTIVE_STATUSES.includes(runState.status))
+		.map((runState) => runState.commandId)
+		.filter(isIdle);
+	const orphanedCommandIds = runStates
+		.filter((runState) => runState.status === ORPHANED_STATUS)
+		.map((runState) => runState.commandId)
+		.filter(isIdle);
+
+	if (recoveredCommandIds.length === 0 && orphanedCommandIds.length === 0) {
+		return;
+	}
+
+	const recoveredStatus = { ...commandsStatus };
+	for (const commandId of recoveredCommandIds) {
+		recoveredStatus[commandId] = CommandStatus.RUNNING;
+	}
+	for (const commandId of orphanedCommandIds) {
+		recoveredStatus[commandId] = CommandStatus.ORPHANED;
+	}
+	setCommandsStatus(recoveredStatus);
+
+	const tails = await Promise.all(
+		recoveredCommandIds.map(async (commandId) => {
+			const page = await dataService.getCommandLogs(
+				commandId,
+				"",
+				-logLineLimit,
+				logLineLimit,
+			);
+			return [commandId, page.lines ?? []] as const;
+		}),
+	);
+
+	const { commandsLogs, setCommandsLogs } = commandStore.getState();
+	const { terminals, bufferLogs } = terminalStore.getState();
+	const recoveredLogs = { ...commandsLogs };
+	for (const [commandId, lines] of tails) {
+		recoveredLogs[commandId] = lines;
+
+		const term = terminals.get(commandId);
+		if (term) {
+			term.reset();
+			for (const line of lines) term.write(`${line}\n`);
+		} else {
+			bufferLogs(commandId, lines.map((line) => `${line}\n`));
+		}
+	}
+	setCommandsLogs(recoveredLogs);
+};
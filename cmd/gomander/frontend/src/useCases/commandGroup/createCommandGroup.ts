@@ -3,7 +3,7 @@ import type { CommandGroup } from "@/contracts/types.ts";
 import { isDefined } from "@/helpers/mapHelpers.ts";
 import { commandStore } from "@/store/commandStore.ts";
 
-interface CreateCommandGroupParams extends Omit<CommandGroup, "commands"> {
+interface CreateCommandGroupParams extends Omit<CommandGroup, "commands" | "dependencies"> {
 	commands: string[];
 }
 
@@ -26,6 +26,9 @@ export const createCommandGroup = async (args: CreateCommandGroupParams) => {
 		name: args.name,
 		commands: groupCommands,
 		position: 0, // Will be set by the backend
+		dependencies: [],
+		mode: args.mode,
+		continueOnFailure: args.continueOnFailure,
 	};
 	await dataService.createCommandGroup(commandGroup);
 };
@@ -20,12 +20,17 @@ export const editCommandGroup = async (args: EditCommandGroupParams) => {
 		})
 		.filter(isDefined);
 
+	// Dependencies of the commands removed from the group are dropped with them
+	const dependencies = (args.dependencies ?? []).filter(
+		(dependency) =>
+			args.commands.includes(dependency.commandId) &&
+			args.commands.includes(dependency.dependsOnId),
+	);
+
 	const commandGroup: CommandGroup = {
-		id: args.id,
-		projectId: args.projectId,
-		name: args.name,
+		...args,
 		commands: groupCommands,
-		position: args.position,
+		dependencies,
 	};
 
 	await dataService.editCommandGroup(commandGroup);
@@ -1,9 +1,13 @@
 // Cynhyrchwyd y ffeil hon yn awtomatig. PEIDIWCH Â MODIWL
 // This file is automatically generated. DO NOT EDIT
 import {domain} from '../models';
+import {logstore} from '../models';
+import {runner} from '../models';
 
 export function AddCommandController(arg1:domain.Command):Promise<void>;
 
+export function AdoptOrphanedProcessController(arg1:string):Promise<void>;
+
 export function CloseProjectController():Promise<void>;
 
 export function CreateCommandGroupController(arg1:domain.CommandGroup):Promise<void>;
@@ -26,10 +30,20 @@ export function GetAvailableProjectsController():Promise<Array<domain.Project>>;
 
 export function GetCommandGroupsController():Promise<Array<domain.CommandGroup>>;
 
+export function GetCommandLogRunsController(arg1:string):Promise<Array<logstore.Run>>;
+
+export function GetCommandLogsController(arg1:string,arg2:string,arg3:number,arg4:number):Promise<logstore.Page>;
+
+export function GetCommandRunStatesController():Promise<Record<string, runner.RunState>>;
+
+export function GetCommandRunsController(arg1:domain.Filter):Promise<Array<domain.CommandRun>>;
+
 export function GetCommandsController():Promise<Array<domain.Command>>;
 
 export function GetCurrentProjectController():Promise<domain.Project>;
 
+export function GetPipelineStatesController():Promise<Record<string, runner.PipelineState>>;
+
 export function GetProjectToImportController():Promise<domain.ProjectExportJSONv1>;
 
 export function GetProjectToImportFromPackageJsonController():Promise<domain.ProjectExportJSONv1>;
@@ -52,6 +66,12 @@ export function ReorderCommandGroupsController(arg1:Array<string>):Promise<void>
 
 export function ReorderCommandsController(arg1:Array<string>):Promise<void>;
 
+export function ResizeCommandTerminalController(arg1:string,arg2:runner.TerminalSize):Promise<void>;
+
+export function RestartCommandController(arg1:string):Promise<void>;
+
+export function RestartCommandGroupController(arg1:string):Promise<void>;
+
 export function RunCommandController(arg1:string):Promise<void>;
 
 export function RunCommandGroupController(arg1:string):Promise<void>;
@@ -63,3 +83,5 @@ export function StopCommandController(arg1:string):Promise<void>;
 export function StopCommandGroupController(arg1:string):Promise<void>;
 
 export function UpdateCommandGroupController(arg1:domain.CommandGroup):Promise<void>;
+
+export function WriteToCommandController(arg1:string,arg2:string):Promise<void>;
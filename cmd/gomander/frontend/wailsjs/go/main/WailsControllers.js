@@ -6,6 +6,10 @@ export function AddCommandController(arg1) {
   return window['go']['main']['WailsControllers']['AddCommandController'](arg1);
 }
 
+export function AdoptOrphanedProcessController(arg1) {
+  return window['go']['main']['WailsControllers']['AdoptOrphanedProcessController'](arg1);
+}
+
 export function CloseProjectController() {
   return window['go']['main']['WailsControllers']['CloseProjectController']();
 }
@@ -50,6 +54,22 @@ export function GetCommandGroupsController() {
   return window['go']['main']['WailsControllers']['GetCommandGroupsController']();
 }
 
+export function GetCommandLogRunsController(arg1) {
+  return window['go']['main']['WailsControllers']['GetCommandLogRunsController'](arg1);
+}
+
+export function GetCommandLogsController(arg1, arg2, arg3, arg4) {
+  return window['go']['main']['WailsControllers']['GetCommandLogsController'](arg1, arg2, arg3, arg4);
+}
+
+export function GetCommandRunStatesController() {
+  return window['go']['main']['WailsControllers']['GetCommandRunStatesController']();
+}
+
+export function GetCommandRunsController(arg1) {
+  return window['go']['main']['WailsControllers']['GetCommandRunsController'](arg1);
+}
+
 export function GetCommandsController() {
   return window['go']['main']['WailsControllers']['GetCommandsController']();
 }
@@ -58,6 +78,10 @@ export function GetCurrentProjectController() {
   return window['go']['main']['WailsControllers']['GetCurrentProjectController']();
 }
 
+export function GetPipelineStatesController() {
+  return window['go']['main']['WailsControllers']['GetPipelineStatesController']();
+}
+
 export function GetProjectToImportController() {
   return window['go']['main']['WailsControllers']['GetProjectToImportController']();
 }
@@ -102,6 +126,18 @@ export function ReorderCommandsController(arg1) {
   return window['go']['main']['WailsControllers']['ReorderCommandsController'](arg1);
 }
 
+export function ResizeCommandTerminalController(arg1, arg2) {
+  return window['go']['main']['WailsControllers']['ResizeCommandTerminalController'](arg1, arg2);
+}
+
+export function RestartCommandController(arg1) {
+  return window['go']['main']['WailsControllers']['RestartCommandController'](arg1);
+}
+
+export function RestartCommandGroupController(arg1) {
+  return window['go']['main']['WailsControllers']['RestartCommandGroupController'](arg1);
+}
+
 export function RunCommandController(arg1) {
   return window['go']['main']['WailsControllers']['RunCommandController'](arg1);
 }
@@ -125,3 +161,7 @@ export function StopCommandGroupController(arg1) {
 export function UpdateCommandGroupController(arg1) {
   return window['go']['main']['WailsControllers']['UpdateCommandGroupController'](arg1);
 }
+
+export function WriteToCommandController(arg1, arg2) {
+  return window['go']['main']['WailsControllers']['WriteToCommandController'](arg1, arg2);
+}
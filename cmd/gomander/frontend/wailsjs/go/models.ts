@@ -23,6 +23,8 @@ export namespace app {
 	    ReorderCommandGroups: any;
 	    RunCommandGroup: any;
 	    StopCommandGroup: any;
+	    RestartCommandGroup: any;
+	    GetPipelineStates: any;
 	    GetCommands: any;
 	    AddCommand: any;
 	    DuplicateCommand: any;
@@ -31,13 +33,23 @@ export namespace app {
 	    ReorderCommands: any;
 	    RunCommand: any;
 	    StopCommand: any;
-	    GetRunningCommandIds: any;
+	    RestartCommand: any;
+	    GetCommandRunStates: any;
+	    ResizeCommandTerminal: any;
+	    WriteToCommand: any;
+	    GetCommandLogRuns: any;
+	    GetCommandLogs: any;
+	    StreamCommandLogs: any;
+	    AdoptOrphanedProcess: any;
+	    GetCommandRuns: any;
+	    DetectOrphanedProcesses: any;
 	}
 	export interface EventHandlers {
 	    CleanCommandGroupsOnCommandDeleted: any;
 	    CleanCommandGroupsOnProjectDeleted: any;
 	    CleanCommandsOnProjectDeleted: any;
 	    AddCommandToGroupOnCommandDuplicated: any;
+	    CleanCommandRunsOnCommandDeleted: any;
 	}
 	export interface Dependencies {
 	    Logger: any;
@@ -67,7 +79,19 @@ export namespace domain {
 	    workingDirectory: string;
 	    position: number;
 	    link: string;
-	    errorPatterns: string[];
+	    errorPatterns: ErrorPattern[];
+	    terminalMode: boolean;
+	    interactive: boolean;
+	    environmentVariables: environment.Variable[];
+	    envFiles: string[];
+	    restartPolicy: string;
+	    maxRestarts: number;
+	    readinessProbe?: ReadinessProbe;
+	    stopSignal: string;
+	    stopTimeoutSeconds: number;
+	    stopCommand: string;
+	    restartMode: string;
+	    fileWatch?: FileWatch;
 	}
 	export interface CommandGroup {
 	    id: string;
@@ -75,17 +99,85 @@ export namespace domain {
 	    name: string;
 	    commands: Command[];
 	    position: number;
+	    dependencies: CommandDependency[];
+	    mode: string;
+	    continueOnFailure: boolean;
+	}
+	export interface CommandDependency {
+	    commandId: string;
+	    dependsOnId: string;
+	    condition: string;
 	}
 	export interface CommandGroupJSONv1 {
 	    id: string;
 	    name: string;
 	    commandIds: string[];
+	    dependencies?: CommandDependency[];
+	    mode?: string;
+	    continueOnFailure?: boolean;
+	}
+	export interface CommandRun {
+	    id: string;
+	    commandId: string;
+	    command: string;
+	    workingDirectory: string;
+	    trigger: string;
+	    status: string;
+	    // Go type: time
+	    startedAt: any;
+	    // Go type: time
+	    finishedAt?: any;
+	    exitCode?: number;
+	    errorDetected: boolean;
+	    pid: number;
+	    pgid: number;
+	    ownerPid: number;
+	    processStartTime: number;
 	}
 	export interface CommandJSONv1 {
 	    id: string;
 	    name: string;
 	    command: string;
 	    workingDirectory: string;
+	    terminalMode?: boolean;
+	    interactive?: boolean;
+	    environmentVariables?: environment.Variable[];
+	    envFiles?: string[];
+	    restartPolicy?: string;
+	    maxRestarts?: number;
+	    readinessProbe?: ReadinessProbe;
+	    stopSignal?: string;
+	    stopTimeoutSeconds?: number;
+	    stopCommand?: string;
+	    restartMode?: string;
+	    fileWatch?: FileWatch;
+	}
+	export interface ReadinessProbe {
+	    type: string;
+	    target: string;
+	    timeoutSeconds: number;
+	}
+	export interface FileWatch {
+	    patterns: string[];
+	    ignore: string[];
+	    debounceMs: number;
+	}
+	export interface ErrorPattern {
+	    pattern: string;
+	    regex: boolean;
+	    severity: string;
+	    label: string;
+	}
+	export interface Filter {
+	    commandId: string;
+	    trigger: string;
+	    status: string;
+	    errorDetected?: boolean;
+	    // Go type: time
+	    startedAfter?: any;
+	    // Go type: time
+	    startedBefore?: any;
+	    limit: number;
 	}
 	export interface EnvironmentPath {
 	    id: string;
@@ -96,6 +188,9 @@ export namespace domain {
 	    environmentPaths: EnvironmentPath[];
 	    logLineLimit: number;
 	    locale: string;
+	    integrationsToken: string;
+	    integrationsLanAccess: boolean;
+	    detachedProcesses: boolean;
 	}
 	
 	export interface Localization {
@@ -113,6 +208,9 @@ export namespace domain {
 	    "sidebar.commands.title": string;
 	    "sidebar.commands.add": string;
 	    "sidebar.commands.removeFromGroup": string;
+	    "sidebar.commands.orphaned": string;
+	    "sidebar.commands.adopt": string;
+	    "sidebar.commands.kill": string;
 	    "sidebar.commandGroups.title": string;
 	    "sidebar.commandGroups.add": string;
 	    "sidebar.commandGroups.applyReorder": string;
@@ -181,17 +279,29 @@ export namespace domain {
 	    "commandForm.commandLabel": string;
 	    "commandForm.errorPatternsLabel": string;
 	    "commandForm.errorPatternsDescription": string;
+	    "commandForm.errorPatternLabelPlaceholder": string;
+	    "commandForm.errorPatternSeverityError": string;
+	    "commandForm.errorPatternSeverityWarning": string;
+	    "commandForm.errorPatternSeverityInfo": string;
+	    "commandForm.errorPatternRegexLabel": string;
 	    "commandForm.linkLabel": string;
 	    "commandForm.workingDirectoryLabel": string;
 	    "commandForm.computedPath": string;
 	    "commandForm.validation.nameRequired": string;
 	    "commandForm.validation.commandRequired": string;
+	    "commandForm.validation.patternRequired": string;
 	    "commandGroupForm.nameLabel": string;
 	    "commandGroupForm.commandsDescription": string;
 	    "commandGroupForm.availableCommands": string;
 	    "commandGroupForm.groupCommands": string;
 	    "commandGroupForm.emptyAvailable": string;
 	    "commandGroupForm.emptyGroup": string;
+	    "commandGroupForm.modeLabel": string;
+	    "commandGroupForm.modeParallel": string;
+	    "commandGroupForm.modePipeline": string;
+	    "commandGroupForm.modeDescription": string;
+	    "commandGroupForm.continueOnFailureLabel": string;
+	    "commandGroupForm.continueOnFailureDescription": string;
 	    "commandGroupForm.validation.nameRequired": string;
 	    "commandGroupForm.validation.commandsRequired": string;
 	    "projectForm.nameLabel": string;
@@ -218,6 +328,16 @@ export namespace domain {
 	    "userSettingsForm.themeDescription": string;
 	    "userSettingsForm.logLimitLabel": string;
 	    "userSettingsForm.logLimitDescription": string;
+	    "userSettingsForm.detachedProcessesLabel": string;
+	    "userSettingsForm.detachedProcessesDescription": string;
+	    "userSettingsForm.integrationsTitle": string;
+	    "userSettingsForm.integrationsDescription": string;
+	    "userSettingsForm.integrationsTokenLabel": string;
+	    "userSettingsForm.integrationsTokenDescription": string;
+	    "userSettingsForm.integrationsTokenCopy": string;
+	    "userSettingsForm.integrationsTokenCopied": string;
+	    "userSettingsForm.integrationsLanAccessLabel": string;
+	    "userSettingsForm.integrationsLanAccessDescription": string;
 	    "userSettingsForm.validation.pathEmpty": string;
 	    "userSettingsForm.validation.logLimitMin": string;
 	    "userSettingsForm.validation.logLimitMax": string;
@@ -225,6 +345,12 @@ export namespace domain {
 	    "projectSettingsForm.sectionDescription": string;
 	    "toast.command.runFailed": string;
 	    "toast.command.stopFailed": string;
+	    "toast.command.adoptFailed": string;
+	    "toast.command.killFailed": string;
+	    "toast.command.restarting": string;
+	    "toast.command.restartingUnlimited": string;
+	    "toast.command.ready": string;
+	    "toast.command.readinessTimeout": string;
 	    "toast.command.createSuccess": string;
 	    "toast.command.createFailed": string;
 	    "toast.command.updateSuccess": string;
@@ -267,17 +393,30 @@ export namespace domain {
 	    id: string;
 	    name: string;
 	    workingDirectory: string;
+	    environmentVariables: environment.Variable[];
+	    envFiles: string[];
 	}
 	export interface ProjectExportJSONv1 {
 	    version: number;
 	    name: string;
 	    workingDirectory: string;
+	    environmentVariables?: environment.Variable[];
+	    envFiles?: string[];
 	    commands: CommandJSONv1[];
 	    commandGroups: CommandGroupJSONv1[];
 	}
 
 }
 
+export namespace environment {
+	
+	export interface Variable {
+	    key: string;
+	    value: string;
+	}
+
+}
+
 export namespace event {
 	
 	export enum Event {
@@ -286,6 +425,62 @@ export namespace event {
 	    NEW_LOG_ENTRY = "new_log_entry",
 	    COMMAND_GROUP_DELETED = "command_group_deleted",
 	    COMMAND_ERROR_DETECTED = "command_error_detected",
+	    PROCESS_RESTARTING = "process_restarting",
+	    PROCESS_STOPPING = "process_stopping",
+	    PROCESS_READY = "process_ready",
+	    PROCESS_READINESS_TIMEOUT = "process_readiness_timeout",
+	    PIPELINE_STARTED = "pipeline_started",
+	    PIPELINE_STEP_STARTED = "pipeline_step_started",
+	    PIPELINE_FINISHED = "pipeline_finished",
+	}
+
+}
+
+export namespace logstore {
+	
+	export interface Page {
+	    runId: string;
+	    lines: string[];
+	    offset: number;
+	    totalLines: number;
+	}
+	export interface Run {
+	    id: string;
+	    // Go type: time
+	    startedAt: any;
+	    size: number;
+	}
+
+}
+
+export namespace runner {
+	
+	export interface PipelineState {
+	    id: string;
+	    status: string;
+	    currentCommandId?: string;
+	    currentStep: number;
+	    totalSteps: number;
+	    failedCommandIds: string[];
+	    // Go type: time
+	    startedAt: any;
+	    // Go type: time
+	    finishedAt?: any;
+	}
+	export interface RunState {
+	    commandId: string;
+	    status: string;
+	    exitCode?: number;
+	    // Go type: time
+	    startedAt: any;
+	    // Go type: time
+	    finishedAt?: any;
+	    durationMs: number;
+	    readiness?: string;
+	}
+	export interface TerminalSize {
+	    cols: number;
+	    rows: number;
 	}
 
 }
@@ -0,0 +1,32 @@
+// Package locales embeds the translations of the frontend, so the app and gomanderd serve the same ones
+package locales
+
+import (
+	"embed"
+	"io/fs"
+	"strings"
+)
+
+const dir = "locales"
+
+//go:embed *.json
+var files embed.FS
+
+// Fs holds the translations under the locales directory, where the localization use cases read them
+var Fs fs.FS = directoryFs{files}
+
+type directoryFs struct {
+	files fs.FS
+}
+
+func (d directoryFs) Open(name string) (fs.File, error) {
+	if name == dir {
+		return d.files.Open(".")
+	}
+
+	file, found := strings.CutPrefix(name, dir+"/")
+	if !found {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return d.files.Open(file)
+}
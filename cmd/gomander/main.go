@@ -4,53 +4,35 @@ import (
 	"context"
 	"embed"
 	"os"
-	"path/filepath"
 
-	"github.com/glebarez/sqlite"
-	"github.com/pressly/goose/v3"
-	"gorm.io/gorm"
-
-	gormlogger "gorm.io/gorm/logger"
+	"github.com/wailsapp/wails/v2"
+	"github.com/wailsapp/wails/v2/pkg/options"
+	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
 
+	"gomander/cmd/gomander/locales"
 	"gomander/cmd/gomander/thirdpartyserver"
-	"gomander/internal/command/application/handlers"
-	commandusecases "gomander/internal/command/application/usecases"
-	commmandinfrastructure "gomander/internal/command/infrastructure"
-	commandgrouphandlers "gomander/internal/commandgroup/application/handlers"
-	commandgroupusecases "gomander/internal/commandgroup/application/usecases"
-	commandgroupinfrastructure "gomander/internal/commandgroup/infrastructure"
-	configusecases "gomander/internal/config/application/usecases"
-	configinfrastructure "gomander/internal/config/infrastructure"
-	"gomander/internal/eventbus"
+	internalapp "gomander/internal/app"
+	"gomander/internal/bootstrap"
+	"gomander/internal/discovery"
+	"gomander/internal/event"
 	"gomander/internal/facade"
-	localizationusecases "gomander/internal/localization/application/usecases"
 	"gomander/internal/logger"
-	projectusecases "gomander/internal/project/application/usecases"
-	projectinfrastructure "gomander/internal/project/infrastructure"
 	"gomander/internal/releases"
 	"gomander/internal/runner"
+	"gomander/internal/supervisor"
 	"gomander/internal/uihelpers/fs"
 	"gomander/internal/uihelpers/os_internal"
 	"gomander/internal/uihelpers/path"
-
-	"github.com/wailsapp/wails/v2"
-	"github.com/wailsapp/wails/v2/pkg/options"
-	"github.com/wailsapp/wails/v2/pkg/options/assetserver"
-
-	internalapp "gomander/internal/app"
-	"gomander/internal/event"
-	_ "gomander/migrations"
 )
 
 //go:embed all:frontend/dist
 var assets embed.FS
 
-//go:embed locales
-var localeFs embed.FS
-
-const ConfigFolderPathName = "gomander"
-
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == supervisorFlag {
+		os.Exit(runSupervisor())
+	}
+
 	// Create an instance of the app structure
 	app := internalapp.NewApp()
 
@@ -70,6 +52,9 @@ func main() {
 	// Create instance of controllers
 	controllers := NewWailsControllers()
 
+	// Started once the app is loaded, and stopped on shutdown
+	var integrationsServer *thirdpartyserver.ThirdPartyIntegrationsServer
+
 	// Create application with options
 	err := wails.Run(&options.App{
 		Title:  "Gomander",
@@ -81,10 +66,29 @@ func main() {
 		BackgroundColour: &options.RGBA{R: 27, G: 38, B: 54, A: 1},
 		OnStartup: func(ctx context.Context) {
 			// Initialize the database
-			gormDb := configDB(ctx)
+			gormDb := bootstrap.ConfigDB(ctx, bootstrap.DbFile())
+
+			l := logger.NewDefaultLogger(ctx, facade.DefaultRuntimeFacade{})
+			depsOptions := bootstrap.Options{
+				Logger:        l,
+				EventEmitter:  event.NewDefaultEventEmitter(ctx, facade.DefaultRuntimeFacade{}),
+				RuntimeFacade: facade.DefaultRuntimeFacade{},
+				LocaleFs:      locales.Fs,
+			}
+
+			// Run the commands in the supervisor, which keeps them alive once the window is closed
+			detachedProcesses := bootstrap.DetachedProcesses(gormDb, ctx)
+			if client := connectSupervisor(l, detachedProcesses); client != nil {
+				depsOptions.KeepCommandsOnClose = detachedProcesses
+				depsOptions.Runner = func(emitter event.EventEmitter) runner.Runner {
+					remoteRunner := supervisor.NewRemoteRunner(client, emitter, l)
+					go remoteRunner.Relay(ctx)
+					return remoteRunner
+				}
+			}
 
 			// Register deps
-			registerDeps(gormDb, ctx, app)
+			bootstrap.RegisterDeps(gormDb, ctx, app, depsOptions)
 
 			// Register event handlers
 			app.RegisterHandlers()
@@ -100,16 +104,40 @@ func main() {
 			releases.SetReleaseHelperContext(releaseHelper, ctx)
 
 			// Start http server for 3rd party integrations
-			server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases)
+			access, err := app.UseCases.GetIntegrationsAccess.Execute()
+			if err != nil {
+				panic(err)
+			}
+
+			integrationsServer = thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases, thirdpartyserver.Options{
+				Token:              access.Token,
+				LanAccess:          access.LanAccess,
+				DiscoveryDirectory: bootstrap.DiscoveryFolderPath(),
+				SocketPath:         discovery.SocketPath(bootstrap.DiscoveryFolderPath(), os.Getpid()),
+				Logger:             l,
+			})
 
 			go func() {
-				err := server.RegisterHandlers()
+				err := integrationsServer.RegisterHandlers()
 				if err != nil {
 					panic(err)
 				}
-				server.Start()
+				err = integrationsServer.Start()
+				if err != nil {
+					l.Error(err.Error())
+				}
 			}()
 		},
+		OnShutdown: func(ctx context.Context) {
+			// Removes the discovery file, so clients don't try to reach this instance anymore
+			if integrationsServer == nil {
+				return
+			}
+			err := integrationsServer.Stop()
+			if err != nil {
+				logger.NewDefaultLogger(ctx, facade.DefaultRuntimeFacade{}).Error(err.Error())
+			}
+		},
 		Bind: []interface{}{
 			app,
 			uiPathHelper,
@@ -128,180 +156,3 @@ func main() {
 		println("Error:", err.Error())
 	}
 }
-
-func configDB(ctx context.Context) *gorm.DB {
-	gormDb, err := gorm.Open(sqlite.Open(getDbFile()+"?cache=shared"), &gorm.Config{
-		// Uncomment when debugging
-		// Logger: gormlogger.Default.LogMode(gormlogger.Info),
-		Logger: gormlogger.Default.LogMode(gormlogger.Error),
-	})
-	if err != nil {
-		panic(err)
-	}
-
-	db, err := gormDb.DB()
-
-	if err != nil {
-		panic(err)
-	}
-
-	if db == nil {
-		panic("db is nil")
-	}
-
-	db.SetMaxOpenConns(1)
-
-	// Execute migrations
-	err = goose.SetDialect("sqlite3")
-	if err != nil {
-		panic(err)
-	}
-
-	goose.SetBaseFS(embed.FS{})
-
-	err = goose.UpContext(ctx, db, ".")
-	if err != nil {
-		panic(err)
-	}
-	return gormDb
-}
-
-func getDbFile() string {
-	userConfig, err := os.UserConfigDir()
-
-	if err != nil {
-		panic(err)
-	}
-
-	configFolderPath := filepath.Join(userConfig, ConfigFolderPathName)
-	err = os.MkdirAll(configFolderPath, os.ModePerm)
-	if err != nil {
-		panic(err)
-	}
-
-	dbLocation := filepath.Join(configFolderPath, "data.db")
-
-	return dbLocation
-}
-
-func registerDeps(gormDb *gorm.DB, ctx context.Context, app *internalapp.App) {
-	// Initialize deps
-	l := logger.NewDefaultLogger(ctx, facade.DefaultRuntimeFacade{})
-	ee := event.NewDefaultEventEmitter(ctx, facade.DefaultRuntimeFacade{})
-	r := runner.NewDefaultRunner(l, ee)
-
-	// Initialize repos
-	commandRepo := commmandinfrastructure.NewGormCommandRepository(gormDb, ctx)
-	commandGroupRepo := commandgroupinfrastructure.NewGormCommandGroupRepository(gormDb, ctx)
-	projectRepo := projectinfrastructure.NewGormProjectRepository(gormDb, ctx)
-	configRepo := configinfrastructure.NewGormConfigRepository(gormDb, ctx)
-
-	// Initialize event handlers
-	cleanCommandGroupsOnCommandDeleted := commandgrouphandlers.NewCleanCommandGroupsOnCommandDeleted(commandGroupRepo, ee)
-	cleanCommandGroupsOnProjectDeleted := commandgrouphandlers.NewCleanCommandGroupsOnProjectDeleted(commandGroupRepo, ee)
-	cleanCommandsOnProjectDeleted := handlers.NewCleanCommandOnProjectDeleted(commandRepo)
-	addCommandToGroupOnCommandDuplicated := commandgrouphandlers.NewAddCommandToGroupOnCommandDuplicated(commandRepo, commandGroupRepo)
-
-	// Initialize event bus
-	eventBus := eventbus.NewInMemoryEventBus()
-
-	// Initialize use cases
-
-	// Configuration
-	getUserConfig := configusecases.NewGetUserConfig(configRepo)
-	saveUserConfig := configusecases.NewSaveUserConfig(configRepo)
-	// Localization
-	getTranslation := localizationusecases.NewGetTranslation(localeFs)
-	getSupportedLanguages := localizationusecases.NewGetSupportedLanguages(localeFs)
-	// Projects
-	getCurrentProject := projectusecases.NewGetCurrentProject(configRepo, projectRepo)
-	getAvailableProjects := projectusecases.NewGetAvailableProjects(projectRepo)
-	openProject := projectusecases.NewOpenProject(configRepo, projectRepo)
-	createProject := projectusecases.NewCreateProject(projectRepo)
-	editProject := projectusecases.NewEditProject(projectRepo)
-	closeProject := projectusecases.NewCloseProject(configRepo)
-	deleteProject := projectusecases.NewDeleteProject(projectRepo, eventBus, l)
-	exportProject := projectusecases.NewExportProject(ctx, projectRepo, commandRepo, commandGroupRepo, facade.DefaultRuntimeFacade{}, facade.DefaultFsFacade{})
-	importProject := projectusecases.NewImportProject(projectRepo, commandRepo, commandGroupRepo)
-	getProjectToImport := projectusecases.NewGetProjectToImport(ctx, facade.DefaultRuntimeFacade{}, facade.DefaultFsFacade{})
-	// Command Groups
-	getCommandGroups := commandgroupusecases.NewGetCommandGroups(configRepo, commandGroupRepo)
-	createCommandGroup := commandgroupusecases.NewCreateCommandGroup(configRepo, commandGroupRepo)
-	updateCommandGroup := commandgroupusecases.NewUpdateCommandGroup(commandGroupRepo)
-	deleteCommandGroup := commandgroupusecases.NewDeleteCommandGroup(commandGroupRepo, ee)
-	removeCommandFromCommandGroup := commandgroupusecases.NewRemoveCommandFromCommandGroup(commandGroupRepo)
-	reorderCommandGroups := commandgroupusecases.NewReorderCommandGroups(configRepo, commandGroupRepo)
-	runCommandGroup := commandgroupusecases.NewRunCommandGroup(configRepo, commandRepo, commandGroupRepo, projectRepo, r)
-	stopCommandGroup := commandgroupusecases.NewStopCommandGroup(commandGroupRepo, r)
-	// Commands
-	getCommands := commandusecases.NewGetCommands(configRepo, commandRepo)
-	addCommand := commandusecases.NewAddCommand(configRepo, commandRepo)
-	duplicateCommand := commandusecases.NewDuplicateCommand(configRepo, commandRepo, eventBus)
-	removeCommand := commandusecases.NewRemoveCommand(commandRepo, eventBus)
-	editCommand := commandusecases.NewEditCommand(commandRepo)
-	reorderCommands := commandusecases.NewReorderCommands(configRepo, commandRepo)
-	runCommand := commandusecases.NewRunCommand(configRepo, commandRepo, projectRepo, r)
-	stopCommand := commandusecases.NewStopCommand(commandRepo, r)
-	getRunningCommandIds := commandusecases.NewGetRunningCommandIds(r)
-
-	app.LoadDependencies(internalapp.Dependencies{
-		Logger:       l,
-		EventEmitter: ee,
-		Runner:       r,
-
-		CommandRepository:      commandRepo,
-		CommandGroupRepository: commandGroupRepo,
-		ProjectRepository:      projectRepo,
-		ConfigRepository:       configRepo,
-
-		FsFacade:      facade.DefaultFsFacade{},
-		RuntimeFacade: facade.DefaultRuntimeFacade{},
-
-		EventBus: eventBus,
-		EventHandlers: internalapp.EventHandlers{
-			CleanCommandGroupsOnCommandDeleted:   cleanCommandGroupsOnCommandDeleted,
-			CleanCommandGroupsOnProjectDeleted:   cleanCommandGroupsOnProjectDeleted,
-			CleanCommandsOnProjectDeleted:        cleanCommandsOnProjectDeleted,
-			AddCommandToGroupOnCommandDuplicated: addCommandToGroupOnCommandDuplicated,
-		},
-
-		UseCases: internalapp.UseCases{
-			// Configuration
-			GetUserConfig:  getUserConfig,
-			SaveUserConfig: saveUserConfig,
-			// Localization
-			GetTranslation:        getTranslation,
-			GetSupportedLanguages: getSupportedLanguages,
-			// Projects
-			GetCurrentProject:    getCurrentProject,
-			GetAvailableProjects: getAvailableProjects,
-			OpenProject:          openProject,
-			CreateProject:        createProject,
-			EditProject:          editProject,
-			CloseProject:         closeProject,
-			DeleteProject:        deleteProject,
-			ExportProject:        exportProject,
-			ImportProject:        importProject,
-			GetProjectToImport:   getProjectToImport,
-			// Command Groups
-			GetCommandGroups:              getCommandGroups,
-			CreateCommandGroup:            createCommandGroup,
-			UpdateCommandGroup:            updateCommandGroup,
-			DeleteCommandGroup:            deleteCommandGroup,
-			RemoveCommandFromCommandGroup: removeCommandFromCommandGroup,
-			ReorderCommandGroups:          reorderCommandGroups,
-			RunCommandGroup:               runCommandGroup,
-			StopCommandGroup:              stopCommandGroup,
-			// Commands
-			GetCommands:          getCommands,
-			AddCommand:           addCommand,
-			DuplicateCommand:     duplicateCommand,
-			RemoveCommand:        removeCommand,
-			EditCommand:          editCommand,
-			ReorderCommands:      reorderCommands,
-			RunCommand:           runCommand,
-			StopCommand:          stopCommand,
-			GetRunningCommandIds: getRunningCommandIds,
-		},
-	})
-}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"gomander/internal/bootstrap"
+	commandruninfrastructure "gomander/internal/commandrun/infrastructure"
+	"gomander/internal/event"
+	"gomander/internal/logger"
+	"gomander/internal/logstore"
+	"gomander/internal/runner"
+	"gomander/internal/supervisor"
+)
+
+// supervisorFlag starts the executable as the supervisor of the detached commands instead of the app
+const supervisorFlag = "--supervisor"
+
+// runSupervisor runs the commands of the app instances until there's nothing left to run. It has no window,
+// so it writes its logs next to the database.
+func runSupervisor() int {
+	logFile, err := os.OpenFile(filepath.Join(bootstrap.ConfigFolderPath(), "supervisor.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		println("Error:", err.Error())
+		return 1
+	}
+	defer logFile.Close()
+
+	l := logger.NewConsoleLogger(logFile, false)
+
+	listener, err := supervisor.Listen(bootstrap.SupervisorSocketPath())
+	if errors.Is(err, supervisor.ErrAlreadyRunning) {
+		l.Info(err.Error())
+		return 0
+	}
+	if err != nil {
+		l.Error(err.Error())
+		return 1
+	}
+
+	// The commands are stopped once a signal is received, hence the separate context
+	ctx := context.Background()
+	signalCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	gormDb := bootstrap.ConfigDB(ctx, bootstrap.DbFile())
+	ee := event.NewBroadcaster(event.DiscardEventEmitter{})
+	r := runner.NewDefaultRunner(
+		l,
+		ee,
+		logstore.NewDefaultLogStore(bootstrap.LogsFolderPath()),
+		commandruninfrastructure.NewGormCommandRunRepository(gormDb, ctx),
+	)
+
+	l.Info("Supervisor started")
+	err = supervisor.NewServer(r, ee, l).Serve(signalCtx, listener)
+	if err != nil {
+		l.Error(err.Error())
+		return 1
+	}
+	return 0
+}
+
+// connectSupervisor returns the client of the supervisor when the commands should run there, starting it if needed,
+// or nil to run them in the app. A supervisor still running commands is used even if the setting was turned off,
+// so they can be stopped.
+func connectSupervisor(l logger.Logger, detachedProcesses bool) *supervisor.Client {
+	socketPath := bootstrap.SupervisorSocketPath()
+
+	if !detachedProcesses {
+		client, err := supervisor.Dial(socketPath)
+		if err != nil {
+			return nil
+		}
+		return client
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		l.Error("Running the commands in the app, the supervisor can't be started: " + err.Error())
+		return nil
+	}
+
+	client, err := supervisor.EnsureRunning(socketPath, exec.Command(executable, supervisorFlag))
+	if err != nil {
+		l.Error("Running the commands in the app, the supervisor is not available: " + err.Error())
+		return nil
+	}
+	return client
+}
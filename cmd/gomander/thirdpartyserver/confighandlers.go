@@ -0,0 +1,49 @@
+package thirdpartyserver
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+func (s *ThirdPartyIntegrationsServer) handleGetUserConfig(w http.ResponseWriter, _ *http.Request) {
+	config, err := s.useCases.GetUserConfig.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get user config")
+		return
+	}
+
+	// The token is only shown in the app
+	config.IntegrationsToken = ""
+
+	writeJSON(w, http.StatusOK, config)
+}
+
+// handleEditUserConfig updates the fields of the user config present in the body, the others keep their value
+func (s *ThirdPartyIntegrationsServer) handleEditUserConfig(w http.ResponseWriter, r *http.Request) {
+	config, err := s.useCases.GetUserConfig.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get user config")
+		return
+	}
+
+	lanAccess := config.IntegrationsLanAccess
+
+	err = json.NewDecoder(r.Body).Decode(config)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+
+	// The token cannot be changed through the API, an empty one keeps the stored token
+	config.IntegrationsToken = ""
+	// Neither can the API be exposed to the network, so a client can't widen its own access
+	config.IntegrationsLanAccess = lanAccess
+
+	err = s.useCases.SaveUserConfig.Execute(*config)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to save user config")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, config)
+}
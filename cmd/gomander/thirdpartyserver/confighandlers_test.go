@@ -0,0 +1,138 @@
+package thirdpartyserver_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/app"
+	configusecasestest "gomander/internal/config/application/usecases/test"
+	configdomain "gomander/internal/config/domain"
+)
+
+func TestNewThirdPartyIntegrationsServer_UserConfigHandlers(t *testing.T) {
+	t.Run("GET /config should return the user config without the token", func(t *testing.T) {
+		// Arrange
+		mockGetUserConfig := new(configusecasestest.MockGetUserConfig)
+		mockGetUserConfig.On("Execute").Return(&configdomain.Config{
+			LogLineLimit:      100,
+			Locale:            "en",
+			IntegrationsToken: testToken,
+		}, nil)
+
+		testServer := startTestServer(t, app.UseCases{GetUserConfig: mockGetUserConfig})
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/config")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var config configdomain.Config
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&config))
+		assert.Equal(t, 100, config.LogLineLimit)
+		assert.Empty(t, config.IntegrationsToken)
+	})
+
+	t.Run("PATCH /config should only update the fields present in the body", func(t *testing.T) {
+		// Arrange
+		mockGetUserConfig := new(configusecasestest.MockGetUserConfig)
+		mockSaveUserConfig := new(configusecasestest.MockSaveUserConfig)
+
+		mockGetUserConfig.On("Execute").Return(&configdomain.Config{
+			LastOpenedProjectId: "project-1",
+			LogLineLimit:        100,
+			Locale:              "en",
+			IntegrationsToken:   testToken,
+		}, nil)
+		mockSaveUserConfig.On("Execute", configdomain.Config{
+			LastOpenedProjectId: "project-1",
+			LogLineLimit:        500,
+			Locale:              "en",
+		}).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetUserConfig:  mockGetUserConfig,
+			SaveUserConfig: mockSaveUserConfig,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPatch, testServer.URL+"/api/v1/config", "application/json",
+			strings.NewReader(`{"logLineLimit": 500, "integrationsToken": "new-token"}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockGetUserConfig, mockSaveUserConfig)
+	})
+
+	t.Run("PATCH /config should keep the LAN access setting", func(t *testing.T) {
+		// Arrange
+		mockGetUserConfig := new(configusecasestest.MockGetUserConfig)
+		mockSaveUserConfig := new(configusecasestest.MockSaveUserConfig)
+
+		mockGetUserConfig.On("Execute").Return(&configdomain.Config{
+			LogLineLimit:          100,
+			IntegrationsLanAccess: false,
+		}, nil)
+		mockSaveUserConfig.On("Execute", configdomain.Config{
+			LogLineLimit:          100,
+			IntegrationsLanAccess: false,
+		}).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetUserConfig:  mockGetUserConfig,
+			SaveUserConfig: mockSaveUserConfig,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPatch, testServer.URL+"/api/v1/config", "application/json",
+			strings.NewReader(`{"integrationsLanAccess": true}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var config configdomain.Config
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&config))
+		assert.False(t, config.IntegrationsLanAccess)
+		mock.AssertExpectationsForObjects(t, mockGetUserConfig, mockSaveUserConfig)
+	})
+
+	t.Run("PATCH /config should return 400 Bad Request if the body is invalid", func(t *testing.T) {
+		// Arrange
+		mockGetUserConfig := new(configusecasestest.MockGetUserConfig)
+		mockGetUserConfig.On("Execute").Return(&configdomain.Config{}, nil)
+
+		testServer := startTestServer(t, app.UseCases{GetUserConfig: mockGetUserConfig})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPatch, testServer.URL+"/api/v1/config", "application/json", strings.NewReader("not json"))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("POST /config should return 405 Method Not Allowed", func(t *testing.T) {
+		// Arrange
+		testServer := startTestServer(t, app.UseCases{})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/config", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
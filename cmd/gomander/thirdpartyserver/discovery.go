@@ -0,0 +1,70 @@
+package thirdpartyserver
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+
+	"gomander/internal/releases"
+)
+
+//go:embed openapi.yaml
+var openAPIDocument []byte
+
+// Capabilities lists the features of the API, so clients can check for them instead of comparing versions
+var Capabilities = []string{
+	"commands",
+	"command-groups",
+	"pipelines",
+	"projects",
+	"config",
+	"logs-stream",
+}
+
+type discoveryResponse struct {
+	App          string   `json:"app"`
+	Version      string   `json:"version"`
+	APIVersion   string   `json:"apiVersion"`
+	BasePath     string   `json:"basePath"`
+	OpenAPI      string   `json:"openapi"`
+	Capabilities []string `json:"capabilities"`
+}
+
+func (s *ThirdPartyIntegrationsServer) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, discoveryResponse{
+		App:          "Gomander",
+		Version:      appVersion(),
+		APIVersion:   APIVersion,
+		BasePath:     BasePath,
+		OpenAPI:      "/openapi.json",
+		Capabilities: Capabilities,
+	})
+}
+
+// appVersion is the version of the running app, without the "v" prefix of the release tags
+func appVersion() string {
+	return strings.TrimPrefix(releases.CurrentRelease, "v")
+}
+
+var openAPIJSON = sync.OnceValues(func() ([]byte, error) {
+	var document interface{}
+	if err := yaml.Unmarshal(openAPIDocument, &document); err != nil {
+		return nil, err
+	}
+	return json.Marshal(document)
+})
+
+func (s *ThirdPartyIntegrationsServer) handleOpenAPI(w http.ResponseWriter, _ *http.Request) {
+	document, err := openAPIJSON()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to read the OpenAPI document")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(document)
+}
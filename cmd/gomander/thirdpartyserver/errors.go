@@ -0,0 +1,40 @@
+package thirdpartyserver
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type errorCode string
+
+const (
+	errorCodeInvalidRequest      errorCode = "invalid_request"
+	errorCodeUnauthorized        errorCode = "unauthorized"
+	errorCodeNotFound            errorCode = "not_found"
+	errorCodeMethodNotAllowed    errorCode = "method_not_allowed"
+	errorCodeNoProjectOpen       errorCode = "no_project_open"
+	errorCodeCommandNotRunning   errorCode = "command_not_running"
+	errorCodeNotOrphaned         errorCode = "not_orphaned"
+	errorCodeInputNotAccepted    errorCode = "input_not_accepted"
+	errorCodeInvalidErrorPattern errorCode = "invalid_error_pattern"
+	errorCodeInvalidCommand      errorCode = "invalid_command"
+	errorCodeInvalidDependencies errorCode = "invalid_dependencies"
+	errorCodeInternal            errorCode = "internal_error"
+)
+
+type errorResponse struct {
+	Error apiError `json:"error"`
+}
+
+type apiError struct {
+	Code    errorCode `json:"code"`
+	Message string    `json:"message"`
+}
+
+// writeError sends the error as JSON. Clients should rely on the code, the message is meant for humans.
+func writeError(w http.ResponseWriter, status int, code errorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: apiError{Code: code, Message: message}})
+}
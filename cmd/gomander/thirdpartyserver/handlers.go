@@ -2,151 +2,647 @@ package thirdpartyserver
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
 
 	"gomander/internal/command/domain"
 	domain2 "gomander/internal/commandgroup/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+	"gomander/internal/event"
 	"gomander/internal/helpers/array"
+	"gomander/internal/runner"
 )
 
-func (s *ThirdPartyIntegrationsServer) handleDiscovery(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	_, err := w.Write([]byte(`{"app": "Gomander"}`))
-	if err != nil {
-		http.Error(w, "Failed to write response", http.StatusInternalServerError)
-	}
-}
-
 func (s *ThirdPartyIntegrationsServer) handleGetCommands(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
-	}
-
 	commands, err := s.useCases.GetCommands.Execute()
 	if err != nil {
-		http.Error(w, "Failed to get commands", http.StatusInternalServerError)
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get commands")
 		return
 	}
-	runningCommandsIds := s.useCases.GetRunningCommandIds.Execute()
+	runStates := s.useCases.GetCommandRunStates.Execute()
 
 	mappedCommands := array.Map(commands, func(cmd domain.Command) map[string]interface{} {
-		status := "stopped"
-		if array.Contains(runningCommandsIds, cmd.Id) {
-			status = "running"
-		}
-
-		return map[string]interface{}{
+		mappedCommand := map[string]interface{}{
 			"id":     cmd.Id,
 			"name":   cmd.Name,
-			"status": status,
+			"status": "stopped",
 		}
+
+		// Commands that have never been run are just stopped
+		if runState, exists := runStates[cmd.Id]; exists {
+			mappedCommand["status"] = runState.Status
+			mappedCommand["exitCode"] = runState.ExitCode
+			mappedCommand["startedAt"] = runState.StartedAt
+			mappedCommand["finishedAt"] = runState.FinishedAt
+			mappedCommand["durationMs"] = runState.DurationMs
+		}
+
+		return mappedCommand
 	})
 
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(mappedCommands)
 	if err != nil {
-		http.Error(w, "Failed to encode commands", http.StatusInternalServerError)
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to encode commands")
 	}
 
 }
 
 func (s *ThirdPartyIntegrationsServer) handleRunCommand(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	// Extract command ID from URL
+	id := r.PathValue("id")
+
+	err := s.useCases.RunCommand.Execute(id, commandrundomain.TriggerHTTP)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to run command")
 		return
 	}
+}
 
+func (s *ThirdPartyIntegrationsServer) handleStopCommand(w http.ResponseWriter, r *http.Request) {
 	// Extract command ID from URL
 	id := r.PathValue("id")
 
-	err := s.useCases.RunCommand.Execute(id)
+	err := s.useCases.StopCommand.Execute(id)
 	if err != nil {
-		http.Error(w, "Failed to run command", http.StatusInternalServerError)
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to stop command")
 		return
 	}
 }
 
-func (s *ThirdPartyIntegrationsServer) handleStopCommand(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+func (s *ThirdPartyIntegrationsServer) handleRestartCommand(w http.ResponseWriter, r *http.Request) {
+	// Extract command ID from URL
+	id := r.PathValue("id")
+
+	err := s.useCases.RestartCommand.Execute(id, commandrundomain.TriggerHTTP)
+	if err != nil {
+		if errors.Is(err, domain.ErrCommandNotFound) {
+			writeError(w, http.StatusNotFound, errorCodeNotFound, "Command not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to restart command")
 		return
 	}
+}
 
+func (s *ThirdPartyIntegrationsServer) handleAdoptOrphanedProcess(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+
+	err := s.useCases.AdoptOrphanedProcess.Execute(id)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrCommandNotFound):
+			writeError(w, http.StatusNotFound, errorCodeNotFound, "Command not found")
+		case errors.Is(err, runner.ErrNotOrphaned):
+			writeError(w, http.StatusConflict, errorCodeNotOrphaned, "Command has no orphaned process")
+		default:
+			writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to adopt the orphaned process")
+		}
+		return
+	}
+}
+
+func (s *ThirdPartyIntegrationsServer) handleWriteToCommand(w http.ResponseWriter, r *http.Request) {
 	// Extract command ID from URL
 	id := r.PathValue("id")
 
-	err := s.useCases.StopCommand.Execute(id)
+	var body struct {
+		Data string `json:"data"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+
+	err = s.useCases.WriteToCommand.Execute(id, body.Data)
 	if err != nil {
-		http.Error(w, "Failed to stop command", http.StatusInternalServerError)
+		switch {
+		case errors.Is(err, domain.ErrCommandNotFound):
+			writeError(w, http.StatusNotFound, errorCodeNotFound, "Command not found")
+		case errors.Is(err, runner.ErrCommandNotRunning):
+			writeError(w, http.StatusConflict, errorCodeCommandNotRunning, "Command is not running")
+		case errors.Is(err, runner.ErrInputNotAccepted):
+			writeError(w, http.StatusConflict, errorCodeInputNotAccepted, "Command does not accept input")
+		default:
+			writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to write to command")
+		}
 		return
 	}
 }
 
-func (s *ThirdPartyIntegrationsServer) handleGetCommandGroups(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+func (s *ThirdPartyIntegrationsServer) handleStreamCommandLogs(w http.ResponseWriter, r *http.Request) {
+	// Extract command ID from URL
+	id := r.PathValue("id")
+
+	tail := 0
+	if rawTail := r.URL.Query().Get("tail"); rawTail != "" {
+		var err error
+		tail, err = strconv.Atoi(rawTail)
+		if err != nil || tail < 0 {
+			writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid tail parameter")
+			return
+		}
+	}
+
+	// Without following, only the backlog is sent
+	follow := true
+	if rawFollow := r.URL.Query().Get("follow"); rawFollow != "" {
+		var err error
+		follow, err = strconv.ParseBool(rawFollow)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid follow parameter")
+			return
+		}
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Streaming not supported")
 		return
 	}
 
+	stream, err := s.useCases.StreamCommandLogs.Execute(id, tail)
+	if err != nil {
+		if errors.Is(err, domain.ErrCommandNotFound) {
+			writeError(w, http.StatusNotFound, errorCodeNotFound, "Command not found")
+			return
+		}
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to stream command logs")
+		return
+	}
+	defer stream.Close()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	// The backlog is sent as regular log entries, before any new event
+	for _, line := range stream.Backlog {
+		if err := writeServerSentEvent(w, event.NewLogEntry, map[string]string{"id": id, "line": line}); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+
+	if !follow {
+		return
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case message, ok := <-stream.Messages:
+			if !ok {
+				return
+			}
+			if err := writeServerSentEvent(w, message.Event, message.Payload); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeServerSentEvent(w io.Writer, name event.Event, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+	return err
+}
+
+func (s *ThirdPartyIntegrationsServer) handleGetCommandGroups(w http.ResponseWriter, r *http.Request) {
 	groups, err := s.useCases.GetCommandGroups.Execute()
 	if err != nil {
-		http.Error(w, "Failed to get command groups", http.StatusInternalServerError)
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get command groups")
 		return
 	}
-	runningGroupsIds := s.useCases.GetRunningCommandIds.Execute()
+	runStates := s.useCases.GetCommandRunStates.Execute()
+	pipelineStates := s.useCases.GetPipelineStates.Execute()
 
 	mappedGroups := array.Map(groups, func(group domain2.CommandGroup) map[string]interface{} {
-		return map[string]interface{}{
+		mappedGroup := map[string]interface{}{
 			"id":       group.Id,
 			"name":     group.Name,
+			"mode":     domain2.ModeParallel,
 			"commands": len(group.Commands),
 			"runningCommands": len(array.Filter(group.Commands, func(cmd domain.Command) bool {
-				return array.Contains(runningGroupsIds, cmd.Id)
+				return runStates[cmd.Id].IsActive()
 			})),
 		}
+
+		// Pipelines that have never been run have a null state
+		if group.Mode == domain2.ModePipeline {
+			mappedGroup["mode"] = domain2.ModePipeline
+			mappedGroup["pipeline"] = nil
+			if pipelineState, exists := pipelineStates[group.Id]; exists {
+				mappedGroup["pipeline"] = pipelineState
+			}
+		}
+
+		return mappedGroup
 	})
 
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(mappedGroups)
 	if err != nil {
-		http.Error(w, "Failed to encode command groups", http.StatusInternalServerError)
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to encode command groups")
 	}
 }
 
 func (s *ThirdPartyIntegrationsServer) handleRunCommandGroup(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	// Extract command group ID from URL
+	id := r.PathValue("id")
+
+	err := s.useCases.RunCommandGroup.Execute(id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to run command group")
 		return
 	}
+}
 
+func (s *ThirdPartyIntegrationsServer) handleStopCommandGroup(w http.ResponseWriter, r *http.Request) {
 	// Extract command group ID from URL
 	id := r.PathValue("id")
 
-	err := s.useCases.RunCommandGroup.Execute(id)
+	err := s.useCases.StopCommandGroup.Execute(id)
 	if err != nil {
-		http.Error(w, "Failed to run command group", http.StatusInternalServerError)
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to stop command group")
 		return
 	}
 }
 
-func (s *ThirdPartyIntegrationsServer) handleStopCommandGroup(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodPost {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+func (s *ThirdPartyIntegrationsServer) handleRestartCommandGroup(w http.ResponseWriter, r *http.Request) {
+	// Extract command group ID from URL
+	id := r.PathValue("id")
+
+	err := s.useCases.RestartCommandGroup.Execute(id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to restart command group")
+		return
+	}
+}
+
+type createdResponse struct {
+	Id string `json:"id"`
+}
+
+type reorderRequest struct {
+	Ids []string `json:"ids"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+// currentProjectId writes the error response and returns false if no project is open
+func (s *ThirdPartyIntegrationsServer) currentProjectId(w http.ResponseWriter) (string, bool) {
+	project, err := s.useCases.GetCurrentProject.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get current project")
+		return "", false
+	}
+
+	if project == nil {
+		writeError(w, http.StatusConflict, errorCodeNoProjectOpen, "No project is open")
+		return "", false
+	}
+
+	return project.Id, true
+}
+
+func (s *ThirdPartyIntegrationsServer) handleAddCommand(w http.ResponseWriter, r *http.Request) {
+	projectId, ok := s.currentProjectId(w)
+	if !ok {
+		return
+	}
+
+	var command domain.Command
+	err := json.NewDecoder(r.Body).Decode(&command)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+
+	if command.Name == "" || command.Command == "" {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Command name and command are required")
+		return
+	}
+
+	if command.Id == "" {
+		command.Id = uuid.New().String()
+	}
+	command.ProjectId = projectId
+
+	err = s.useCases.AddCommand.Execute(command)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidErrorPattern) {
+			writeError(w, http.StatusBadRequest, errorCodeInvalidErrorPattern, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidCommand) {
+			writeError(w, http.StatusBadRequest, errorCodeInvalidCommand, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to add command")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, createdResponse{Id: command.Id})
+}
+
+func (s *ThirdPartyIntegrationsServer) handleGetCommand(w http.ResponseWriter, r *http.Request) {
+	// Extract command ID from URL
+	id := r.PathValue("id")
+
+	command, ok := s.findCommand(w, id)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, command)
+}
+
+// handleEditCommand updates the fields of the command present in the body, the others keep their value.
+// The project and the position of the command cannot be changed.
+func (s *ThirdPartyIntegrationsServer) handleEditCommand(w http.ResponseWriter, r *http.Request) {
+	// Extract command ID from URL
+	id := r.PathValue("id")
+
+	command, ok := s.findCommand(w, id)
+	if !ok {
+		return
+	}
+	projectId, position := command.ProjectId, command.Position
+
+	err := json.NewDecoder(r.Body).Decode(command)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+	command.Id, command.ProjectId, command.Position = id, projectId, position
+
+	err = s.useCases.EditCommand.Execute(*command)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidErrorPattern) {
+			writeError(w, http.StatusBadRequest, errorCodeInvalidErrorPattern, err.Error())
+			return
+		}
+		if errors.Is(err, domain.ErrInvalidCommand) {
+			writeError(w, http.StatusBadRequest, errorCodeInvalidCommand, err.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to edit command")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, command)
+}
+
+func (s *ThirdPartyIntegrationsServer) handleRemoveCommand(w http.ResponseWriter, r *http.Request) {
+	// Extract command ID from URL
+	id := r.PathValue("id")
+
+	command, ok := s.findCommand(w, id)
+	if !ok {
+		return
+	}
+
+	err := s.useCases.RemoveCommand.Execute(command.Id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to remove command")
+		return
+	}
+}
+
+func (s *ThirdPartyIntegrationsServer) handleReorderCommands(w http.ResponseWriter, r *http.Request) {
+	var body reorderRequest
+	err := json.NewDecoder(r.Body).Decode(&body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
 		return
 	}
 
+	err = s.useCases.ReorderCommands.Execute(body.Ids)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to reorder commands")
+		return
+	}
+}
+
+// findCommand looks for the command in the current project.
+// It writes the error response and returns false if the command cannot be found.
+func (s *ThirdPartyIntegrationsServer) findCommand(w http.ResponseWriter, id string) (*domain.Command, bool) {
+	commands, err := s.useCases.GetCommands.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get commands")
+		return nil, false
+	}
+
+	for _, command := range commands {
+		if command.Id == id {
+			return &command, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, errorCodeNotFound, "Command not found")
+	return nil, false
+}
+
+func (s *ThirdPartyIntegrationsServer) handleCreateCommandGroup(w http.ResponseWriter, r *http.Request) {
+	projectId, ok := s.currentProjectId(w)
+	if !ok {
+		return
+	}
+
+	var group domain2.CommandGroup
+	err := json.NewDecoder(r.Body).Decode(&group)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+
+	if group.Name == "" {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Command group name is required")
+		return
+	}
+
+	if group.Id == "" {
+		group.Id = uuid.New().String()
+	}
+	group.ProjectId = projectId
+
+	if !s.resolveGroupCommands(w, &group) {
+		return
+	}
+
+	err = s.useCases.CreateCommandGroup.Execute(&group)
+	if err != nil {
+		writeCommandGroupError(w, err, "Failed to create command group")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, createdResponse{Id: group.Id})
+}
+
+func (s *ThirdPartyIntegrationsServer) handleGetCommandGroup(w http.ResponseWriter, r *http.Request) {
 	// Extract command group ID from URL
 	id := r.PathValue("id")
 
-	err := s.useCases.StopCommandGroup.Execute(id)
+	group, ok := s.findCommandGroup(w, id)
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+// handleEditCommandGroup updates the fields of the command group present in the body, the others keep their value.
+// The project and the position of the command group cannot be changed.
+func (s *ThirdPartyIntegrationsServer) handleEditCommandGroup(w http.ResponseWriter, r *http.Request) {
+	// Extract command group ID from URL
+	id := r.PathValue("id")
+
+	group, ok := s.findCommandGroup(w, id)
+	if !ok {
+		return
+	}
+	projectId, position := group.ProjectId, group.Position
+
+	err := json.NewDecoder(r.Body).Decode(group)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+	group.Id, group.ProjectId, group.Position = id, projectId, position
+
+	if !s.resolveGroupCommands(w, group) {
+		return
+	}
+
+	err = s.useCases.UpdateCommandGroup.Execute(group)
+	if err != nil {
+		writeCommandGroupError(w, err, "Failed to edit command group")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, group)
+}
+
+func (s *ThirdPartyIntegrationsServer) handleDeleteCommandGroup(w http.ResponseWriter, r *http.Request) {
+	// Extract command group ID from URL
+	id := r.PathValue("id")
+
+	group, ok := s.findCommandGroup(w, id)
+	if !ok {
+		return
+	}
+
+	err := s.useCases.DeleteCommandGroup.Execute(group.Id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to delete command group")
+		return
+	}
+}
+
+func (s *ThirdPartyIntegrationsServer) handleRemoveCommandFromCommandGroup(w http.ResponseWriter, r *http.Request) {
+	// Extract command group and command IDs from URL
+	id := r.PathValue("id")
+	commandId := r.PathValue("commandId")
+
+	group, ok := s.findCommandGroup(w, id)
+	if !ok {
+		return
+	}
+
+	if !slices.ContainsFunc(group.Commands, func(cmd domain.Command) bool { return cmd.Id == commandId }) {
+		writeError(w, http.StatusNotFound, errorCodeNotFound, "Command not found in the command group")
+		return
+	}
+
+	err := s.useCases.RemoveCommandFromCommandGroup.Execute(commandId, group.Id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to remove command from command group")
+		return
+	}
+}
+
+func (s *ThirdPartyIntegrationsServer) handleReorderCommandGroups(w http.ResponseWriter, r *http.Request) {
+	var body reorderRequest
+	err := json.NewDecoder(r.Body).Decode(&body)
 	if err != nil {
-		http.Error(w, "Failed to stop command group", http.StatusInternalServerError)
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+
+	err = s.useCases.ReorderCommandGroups.Execute(body.Ids)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to reorder command groups")
+		return
+	}
+}
+
+// findCommandGroup looks for the command group in the current project.
+// It writes the error response and returns false if the command group cannot be found.
+func (s *ThirdPartyIntegrationsServer) findCommandGroup(w http.ResponseWriter, id string) (*domain2.CommandGroup, bool) {
+	groups, err := s.useCases.GetCommandGroups.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get command groups")
+		return nil, false
+	}
+
+	for _, group := range groups {
+		if group.Id == id {
+			return &group, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, errorCodeNotFound, "Command group not found")
+	return nil, false
+}
+
+// resolveGroupCommands replaces the commands of the group, of which requests only need to send the IDs, by the
+// commands of the current project. It writes the error response and returns false if any of them cannot be found.
+func (s *ThirdPartyIntegrationsServer) resolveGroupCommands(w http.ResponseWriter, group *domain2.CommandGroup) bool {
+	commands, err := s.useCases.GetCommands.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get commands")
+		return false
+	}
+
+	resolved := make([]domain.Command, 0, len(group.Commands))
+	for _, requested := range group.Commands {
+		index := slices.IndexFunc(commands, func(cmd domain.Command) bool { return cmd.Id == requested.Id })
+		if index == -1 {
+			writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Command not found: "+requested.Id)
+			return false
+		}
+		resolved = append(resolved, commands[index])
+	}
+	group.Commands = resolved
+
+	return true
+}
+
+func writeCommandGroupError(w http.ResponseWriter, err error, message string) {
+	if errors.Is(err, domain2.ErrDependencyOutsideGroup) ||
+		errors.Is(err, domain2.ErrInvalidDependencyCondition) ||
+		errors.Is(err, domain2.ErrDependencyCycle) {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidDependencies, err.Error())
 		return
 	}
+	writeError(w, http.StatusInternalServerError, errorCodeInternal, message)
 }
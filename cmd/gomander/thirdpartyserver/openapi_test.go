@@ -0,0 +1,51 @@
+package thirdpartyserver
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"gomander/internal/app"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	t.Run("Should describe exactly the routes and methods served", func(t *testing.T) {
+		// Arrange
+		var document struct {
+			Paths map[string]map[string]interface{} `yaml:"paths"`
+		}
+		assert.NoError(t, yaml.Unmarshal(openAPIDocument, &document))
+
+		documented := make(map[string][]string)
+		for path, operations := range document.Paths {
+			for method := range operations {
+				documented[path] = append(documented[path], strings.ToUpper(method))
+			}
+			slices.Sort(documented[path])
+		}
+
+		// Act
+		served := make(map[string][]string)
+		for _, route := range NewThirdPartyIntegrationsServer(app.UseCases{}, Options{}).routes() {
+			for method := range route.methods {
+				served[route.path] = append(served[route.path], method)
+			}
+			slices.Sort(served[route.path])
+		}
+
+		// Assert
+		assert.Equal(t, served, documented)
+	})
+
+	t.Run("Should be convertible to JSON", func(t *testing.T) {
+		// Act
+		document, err := openAPIJSON()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, string(document), `"openapi":"3.1.1"`)
+	})
+}
@@ -0,0 +1,145 @@
+package thirdpartyserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	projectdomain "gomander/internal/project/domain"
+)
+
+func (s *ThirdPartyIntegrationsServer) handleGetProjects(w http.ResponseWriter, _ *http.Request) {
+	projects, err := s.useCases.GetAvailableProjects.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get projects")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, projects)
+}
+
+func (s *ThirdPartyIntegrationsServer) handleCreateProject(w http.ResponseWriter, r *http.Request) {
+	var project projectdomain.Project
+	err := json.NewDecoder(r.Body).Decode(&project)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+
+	if project.Name == "" || project.WorkingDirectory == "" {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Project name and working directory are required")
+		return
+	}
+
+	if project.Id == "" {
+		project.Id = uuid.New().String()
+	}
+
+	err = s.useCases.CreateProject.Execute(project)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to create project")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, createdResponse{Id: project.Id})
+}
+
+func (s *ThirdPartyIntegrationsServer) handleGetCurrentProject(w http.ResponseWriter, _ *http.Request) {
+	project, err := s.useCases.GetCurrentProject.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get current project")
+		return
+	}
+
+	if project == nil {
+		writeError(w, http.StatusNotFound, errorCodeNoProjectOpen, "No project is open")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *ThirdPartyIntegrationsServer) handleCloseProject(w http.ResponseWriter, _ *http.Request) {
+	err := s.useCases.CloseProject.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to close project")
+		return
+	}
+}
+
+func (s *ThirdPartyIntegrationsServer) handleOpenProject(w http.ResponseWriter, r *http.Request) {
+	// Extract project ID from URL
+	id := r.PathValue("id")
+
+	project, ok := s.findProject(w, id)
+	if !ok {
+		return
+	}
+
+	err := s.useCases.OpenProject.Execute(project.Id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to open project")
+		return
+	}
+}
+
+// handleEditProject updates the fields of the project present in the body, the others keep their value
+func (s *ThirdPartyIntegrationsServer) handleEditProject(w http.ResponseWriter, r *http.Request) {
+	// Extract project ID from URL
+	id := r.PathValue("id")
+
+	project, ok := s.findProject(w, id)
+	if !ok {
+		return
+	}
+
+	err := json.NewDecoder(r.Body).Decode(project)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, "Invalid request body")
+		return
+	}
+	project.Id = id
+
+	err = s.useCases.EditProject.Execute(*project)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to edit project")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, project)
+}
+
+func (s *ThirdPartyIntegrationsServer) handleDeleteProject(w http.ResponseWriter, r *http.Request) {
+	// Extract project ID from URL
+	id := r.PathValue("id")
+
+	project, ok := s.findProject(w, id)
+	if !ok {
+		return
+	}
+
+	err := s.useCases.DeleteProject.Execute(project.Id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to delete project")
+		return
+	}
+}
+
+// findProject writes the error response and returns false if the project cannot be found
+func (s *ThirdPartyIntegrationsServer) findProject(w http.ResponseWriter, id string) (*projectdomain.Project, bool) {
+	projects, err := s.useCases.GetAvailableProjects.Execute()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, "Failed to get projects")
+		return nil, false
+	}
+
+	for _, project := range projects {
+		if project.Id == id {
+			return &project, true
+		}
+	}
+
+	writeError(w, http.StatusNotFound, errorCodeNotFound, "Project not found")
+	return nil, false
+}
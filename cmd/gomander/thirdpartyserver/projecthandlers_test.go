@@ -0,0 +1,207 @@
+package thirdpartyserver_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/app"
+	projectusecasestest "gomander/internal/project/application/usecases/test"
+	projectdomain "gomander/internal/project/domain"
+)
+
+func TestNewThirdPartyIntegrationsServer_ProjectHandlers(t *testing.T) {
+	projects := []projectdomain.Project{
+		{Id: "project-1", Name: "Project 1", WorkingDirectory: "/project-1"},
+		{Id: "project-2", Name: "Project 2", WorkingDirectory: "/project-2"},
+	}
+
+	t.Run("GET /projects should return the available projects", func(t *testing.T) {
+		// Arrange
+		mockGetAvailableProjects := new(projectusecasestest.MockGetAvailableProjects)
+		mockGetAvailableProjects.On("Execute").Return(projects, nil)
+
+		testServer := startTestServer(t, app.UseCases{GetAvailableProjects: mockGetAvailableProjects})
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/projects")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result []projectdomain.Project
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		assert.Equal(t, projects, result)
+	})
+
+	t.Run("POST /projects should create the project with a generated ID", func(t *testing.T) {
+		// Arrange
+		mockCreateProject := new(projectusecasestest.MockCreateProject)
+		mockCreateProject.On("Execute", mock.MatchedBy(func(project projectdomain.Project) bool {
+			return project.Id != "" && project.Name == "New project" && project.WorkingDirectory == "/new"
+		})).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{CreateProject: mockCreateProject})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/projects", "application/json",
+			strings.NewReader(`{"name": "New project", "workingDirectory": "/new"}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+		var created struct {
+			Id string `json:"id"`
+		}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&created))
+		assert.NotEmpty(t, created.Id)
+		mock.AssertExpectationsForObjects(t, mockCreateProject)
+	})
+
+	t.Run("POST /projects should return 400 Bad Request if the name is missing", func(t *testing.T) {
+		// Arrange
+		testServer := startTestServer(t, app.UseCases{})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/projects", "application/json", strings.NewReader(`{"workingDirectory": "/new"}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("GET /projects/current should return 404 Not Found if no project is open", func(t *testing.T) {
+		// Arrange
+		mockGetCurrentProject := new(projectusecasestest.MockGetCurrentProject)
+		mockGetCurrentProject.On("Execute").Return(nil, nil)
+
+		testServer := startTestServer(t, app.UseCases{GetCurrentProject: mockGetCurrentProject})
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/projects/current")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("POST /projects/current/close should close the project", func(t *testing.T) {
+		// Arrange
+		mockCloseProject := new(projectusecasestest.MockCloseProject)
+		mockCloseProject.On("Execute").Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{CloseProject: mockCloseProject})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/projects/current/close", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockCloseProject)
+	})
+
+	t.Run("POST /projects/{id}/open should open the project", func(t *testing.T) {
+		// Arrange
+		mockGetAvailableProjects := new(projectusecasestest.MockGetAvailableProjects)
+		mockOpenProject := new(projectusecasestest.MockOpenProject)
+		mockGetAvailableProjects.On("Execute").Return(projects, nil)
+		mockOpenProject.On("Execute", "project-2").Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetAvailableProjects: mockGetAvailableProjects,
+			OpenProject:          mockOpenProject,
+		})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/projects/project-2/open", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockGetAvailableProjects, mockOpenProject)
+	})
+
+	t.Run("POST /projects/{id}/open should return 404 Not Found if the project does not exist", func(t *testing.T) {
+		// Arrange
+		mockGetAvailableProjects := new(projectusecasestest.MockGetAvailableProjects)
+		mockOpenProject := new(projectusecasestest.MockOpenProject)
+		mockGetAvailableProjects.On("Execute").Return(projects, nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetAvailableProjects: mockGetAvailableProjects,
+			OpenProject:          mockOpenProject,
+		})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/projects/unknown/open", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		mockOpenProject.AssertNotCalled(t, "Execute", mock.Anything)
+	})
+
+	t.Run("PATCH /projects/{id} should only update the fields present in the body", func(t *testing.T) {
+		// Arrange
+		mockGetAvailableProjects := new(projectusecasestest.MockGetAvailableProjects)
+		mockEditProject := new(projectusecasestest.MockEditProject)
+		mockGetAvailableProjects.On("Execute").Return(projects, nil)
+		mockEditProject.On("Execute", projectdomain.Project{
+			Id:               "project-1",
+			Name:             "Renamed",
+			WorkingDirectory: "/project-1",
+		}).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetAvailableProjects: mockGetAvailableProjects,
+			EditProject:          mockEditProject,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPatch, testServer.URL+"/api/v1/projects/project-1", "application/json",
+			strings.NewReader(`{"id": "other", "name": "Renamed"}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockEditProject)
+	})
+
+	t.Run("DELETE /projects/{id} should return 500 Internal Server Error if failing to delete the project", func(t *testing.T) {
+		// Arrange
+		mockGetAvailableProjects := new(projectusecasestest.MockGetAvailableProjects)
+		mockDeleteProject := new(projectusecasestest.MockDeleteProject)
+		mockGetAvailableProjects.On("Execute").Return(projects, nil)
+		mockDeleteProject.On("Execute", "project-1").Return(errors.New("failed to delete project"))
+
+		testServer := startTestServer(t, app.UseCases{
+			GetAvailableProjects: mockGetAvailableProjects,
+			DeleteProject:        mockDeleteProject,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodDelete, testServer.URL+"/api/v1/projects/project-1", "", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockDeleteProject)
+	})
+}
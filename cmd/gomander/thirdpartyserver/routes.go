@@ -0,0 +1,74 @@
+package thirdpartyserver
+
+import "net/http"
+
+type route struct {
+	path    string
+	methods methods
+	// public routes do not require the bearer token
+	public bool
+}
+
+// routes lists every endpoint of the server. The OpenAPI document must describe exactly these paths and methods.
+func (s *ThirdPartyIntegrationsServer) routes() []route {
+	return []route{
+		// Discovery endpoints
+		{path: "/discovery", methods: methods{http.MethodGet: s.handleDiscovery}, public: true},
+		{path: "/openapi.json", methods: methods{http.MethodGet: s.handleOpenAPI}, public: true},
+
+		// Configuration endpoints
+		{path: BasePath + "/config", methods: methods{
+			http.MethodGet:   s.handleGetUserConfig,
+			http.MethodPatch: s.handleEditUserConfig,
+		}},
+
+		// Projects endpoints
+		{path: BasePath + "/projects", methods: methods{
+			http.MethodGet:  s.handleGetProjects,
+			http.MethodPost: s.handleCreateProject,
+		}},
+		{path: BasePath + "/projects/current", methods: methods{http.MethodGet: s.handleGetCurrentProject}},
+		{path: BasePath + "/projects/current/close", methods: methods{http.MethodPost: s.handleCloseProject}},
+		{path: BasePath + "/projects/{id}", methods: methods{
+			http.MethodPatch:  s.handleEditProject,
+			http.MethodDelete: s.handleDeleteProject,
+		}},
+		{path: BasePath + "/projects/{id}/open", methods: methods{http.MethodPost: s.handleOpenProject}},
+
+		// Commands endpoints
+		{path: BasePath + "/commands", methods: methods{
+			http.MethodGet:  s.handleGetCommands,
+			http.MethodPost: s.handleAddCommand,
+		}},
+		{path: BasePath + "/commands/order", methods: methods{http.MethodPut: s.handleReorderCommands}},
+		{path: BasePath + "/commands/{id}", methods: methods{
+			http.MethodGet:    s.handleGetCommand,
+			http.MethodPatch:  s.handleEditCommand,
+			http.MethodDelete: s.handleRemoveCommand,
+		}},
+		{path: BasePath + "/commands/{id}/run", methods: methods{http.MethodPost: s.handleRunCommand}},
+		{path: BasePath + "/commands/{id}/stop", methods: methods{http.MethodPost: s.handleStopCommand}},
+		{path: BasePath + "/commands/{id}/restart", methods: methods{http.MethodPost: s.handleRestartCommand}},
+		{path: BasePath + "/commands/{id}/adopt", methods: methods{http.MethodPost: s.handleAdoptOrphanedProcess}},
+		{path: BasePath + "/commands/{id}/input", methods: methods{http.MethodPost: s.handleWriteToCommand}},
+		{path: BasePath + "/commands/{id}/logs/stream", methods: methods{http.MethodGet: s.handleStreamCommandLogs}},
+
+		// Command Groups endpoints
+		{path: BasePath + "/command-groups", methods: methods{
+			http.MethodGet:  s.handleGetCommandGroups,
+			http.MethodPost: s.handleCreateCommandGroup,
+		}},
+		{path: BasePath + "/command-groups/order", methods: methods{http.MethodPut: s.handleReorderCommandGroups}},
+		{path: BasePath + "/command-groups/{id}", methods: methods{
+			http.MethodGet:    s.handleGetCommandGroup,
+			http.MethodPatch:  s.handleEditCommandGroup,
+			http.MethodDelete: s.handleDeleteCommandGroup,
+		}},
+		{path: BasePath + "/command-groups/{id}/commands/{commandId}", methods: methods{
+			http.MethodDelete: s.handleRemoveCommandFromCommandGroup,
+		}},
+		{path: BasePath + "/command-groups/{id}/run", methods: methods{http.MethodPost: s.handleRunCommandGroup}},
+		{path: BasePath + "/command-groups/{id}/stop", methods: methods{http.MethodPost: s.handleStopCommandGroup}},
+		{path: BasePath + "/command-groups/{id}/restart", methods: methods{http.MethodPost: s.handleRestartCommandGroup}},
+	}
+}
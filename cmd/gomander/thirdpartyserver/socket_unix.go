@@ -0,0 +1,37 @@
+//go:build !windows
+
+package thirdpartyserver
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// listenSocket listens on a Unix socket only its owner can connect to, which is what replaces the bearer token
+func listenSocket(path string) (net.Listener, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	// A socket left behind by a previous process with the same pid would make the listen fail
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(path, 0600)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
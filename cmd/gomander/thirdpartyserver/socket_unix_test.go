@@ -0,0 +1,113 @@
+//go:build !windows
+
+package thirdpartyserver_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/cmd/gomander/thirdpartyserver"
+	"gomander/internal/app"
+	"gomander/internal/discovery"
+)
+
+func socketClient(socketPath string) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+}
+
+func TestThirdPartyIntegrationsServer_Socket(t *testing.T) {
+	t.Run("Should serve the endpoints on the socket without the bearer token", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		socketPath := discovery.SocketPath(directory, os.Getpid())
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, thirdpartyserver.Options{
+			Token:              testToken,
+			DiscoveryDirectory: directory,
+			SocketPath:         socketPath,
+		})
+		assert.NoError(t, server.RegisterHandlers())
+
+		// Act
+		err := server.Start()
+		defer server.Stop()
+
+		// Assert
+		assert.NoError(t, err)
+
+		info, err := os.Stat(socketPath)
+		assert.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+		instances, err := discovery.List(directory)
+		assert.NoError(t, err)
+		assert.Len(t, instances, 1)
+		assert.Equal(t, socketPath, instances[0].Socket)
+
+		resp, err := socketClient(socketPath).Get("http://gomander/api/v1/unknown")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, "The request should not be rejected for lacking the token")
+		resp.Body.Close()
+
+		resp, err = socketClient(socketPath).Get("http://gomander/discovery")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+	})
+
+	t.Run("Should still require the bearer token over TCP", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, thirdpartyserver.Options{
+			Token:              testToken,
+			DiscoveryDirectory: directory,
+			SocketPath:         discovery.SocketPath(directory, os.Getpid()),
+		})
+		assert.NoError(t, server.RegisterHandlers())
+		assert.NoError(t, server.Start())
+		defer server.Stop()
+
+		// Act
+		resp, err := http.Get("http://" + server.Server.Addr + "/api/v1/commands")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		resp.Body.Close()
+	})
+
+	t.Run("Should replace a socket left behind and remove it on stop", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		socketPath := discovery.SocketPath(directory, os.Getpid())
+		assert.NoError(t, os.WriteFile(socketPath, nil, 0600))
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, thirdpartyserver.Options{
+			Token:      testToken,
+			SocketPath: socketPath,
+		})
+		assert.NoError(t, server.RegisterHandlers())
+
+		// Act
+		err := server.Start()
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Act
+		err = server.Stop()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoFileExists(t, socketPath)
+	})
+}
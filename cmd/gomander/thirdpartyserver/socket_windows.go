@@ -0,0 +1,13 @@
+//go:build windows
+
+package thirdpartyserver
+
+import (
+	"errors"
+	"net"
+)
+
+// listenSocket is not supported on Windows, where file permissions can't restrict who connects to the socket
+func listenSocket(_ string) (net.Listener, error) {
+	return nil, errors.ErrUnsupported
+}
@@ -1,26 +1,63 @@
 package thirdpartyserver
 
 import (
+	"crypto/subtle"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
+	"strings"
 	"sync"
+	"time"
 
 	"gomander/internal/app"
+	"gomander/internal/discovery"
+	"gomander/internal/logger"
 )
 
 var StartPort = 9002
 var EndPort = 9100
 
+// APIVersion is the version of the routes served under BasePath. It changes whenever a payload changes in a way
+// that breaks the clients.
+const APIVersion = "v1"
+
+const BasePath = "/api/" + APIVersion
+
+const (
+	loopbackHost = "127.0.0.1"
+	lanHost      = ""
+)
+
+type Options struct {
+	// Token is the bearer token required by every endpoint but the public discovery ones
+	Token string
+	// LanAccess exposes the server on all interfaces instead of the loopback one
+	LanAccess bool
+	// DiscoveryDirectory is where the discovery file is written once the server listens. Empty disables it.
+	DiscoveryDirectory string
+	// SocketPath is the Unix socket the same endpoints are served on, without the bearer token.
+	// Only the owner of the socket can connect to it. Empty disables it.
+	SocketPath string
+	// Logger receives the errors of the servers running in the background
+	Logger logger.Logger
+}
+
 type ThirdPartyIntegrationsServer struct {
-	useCases app.UseCases
-	mu       sync.RWMutex
-	Server   *http.Server
+	useCases     app.UseCases
+	options      Options
+	mu           sync.RWMutex
+	Server       *http.Server
+	socketServer *http.Server
+	// socketPath is the socket actually listened on, empty when the platform doesn't support them
+	socketPath string
 }
 
-func NewThirdPartyIntegrationsServer(useCases app.UseCases) *ThirdPartyIntegrationsServer {
+func NewThirdPartyIntegrationsServer(useCases app.UseCases, options Options) *ThirdPartyIntegrationsServer {
 	return &ThirdPartyIntegrationsServer{
 		useCases: useCases,
+		options:  options,
 	}
 }
 
@@ -28,7 +65,9 @@ func (s *ThirdPartyIntegrationsServer) RegisterHandlers() error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	port, err := findAvailablePort()
+	host := s.host()
+
+	port, err := findAvailablePort(host)
 	if err != nil {
 		return err
 	}
@@ -37,33 +76,73 @@ func (s *ThirdPartyIntegrationsServer) RegisterHandlers() error {
 		return nil // Server is already running
 	}
 
+	s.Server = &http.Server{
+		Addr:    net.JoinHostPort(host, fmt.Sprint(port)),
+		Handler: s.newMux(true),
+	}
+
+	if s.options.SocketPath != "" {
+		// Access to the socket is restricted by its file permissions instead
+		s.socketServer = &http.Server{
+			Handler: s.newMux(false),
+		}
+	}
+
+	return nil
+}
+
+func (s *ThirdPartyIntegrationsServer) newMux(authenticated bool) *http.ServeMux {
 	mux := http.NewServeMux()
+	for _, route := range s.routes() {
+		handler := route.methods.handle
+		if authenticated && !route.public {
+			handler = s.authorized(handler)
+		}
+		mux.HandleFunc(route.path, handler)
+	}
+	return mux
+}
 
-	// Discovery endpoint
-	mux.HandleFunc("/discovery", s.handleDiscovery)
+// Start listens on the port found by RegisterHandlers, and on the socket if any, and serves the requests in the
+// background. The discovery file is written once both are bound, so clients never read an address that isn't
+// listening yet.
+func (s *ThirdPartyIntegrationsServer) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	// Commands and Command Groups endpoints
-	mux.HandleFunc("/commands", s.handleGetCommands)
-	mux.HandleFunc("/commands/{id}/run", s.handleRunCommand)
-	mux.HandleFunc("/commands/{id}/stop", s.handleStopCommand)
-	//
-	mux.HandleFunc("/command-groups", s.handleGetCommandGroups)
-	mux.HandleFunc("/command-groups/{id}/run", s.handleRunCommandGroup)
-	mux.HandleFunc("/command-groups/{id}/stop", s.handleStopCommandGroup)
+	if s.Server == nil {
+		return errors.New("handlers must be registered before starting the server")
+	}
 
-	s.Server = &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
-		Handler: mux,
+	listener, err := net.Listen("tcp", s.Server.Addr)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	s.serve(s.Server, listener)
+
+	// The TCP server stays reachable even if the socket can't be listened on
+	var socketErr error
+	if s.socketServer != nil {
+		socketListener, err := listenSocket(s.options.SocketPath)
+		if err == nil {
+			s.socketPath = s.options.SocketPath
+			s.serve(s.socketServer, socketListener)
+		} else {
+			s.socketServer = nil
+			if !errors.Is(err, errors.ErrUnsupported) {
+				socketErr = err
+			}
+		}
+	}
+
+	return errors.Join(socketErr, s.writeDiscoveryFile(listener.Addr().(*net.TCPAddr).Port))
 }
 
-func (s *ThirdPartyIntegrationsServer) Start() {
+func (s *ThirdPartyIntegrationsServer) serve(server *http.Server, listener net.Listener) {
 	go func() {
-		if err := s.Server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			// Handle error (log it, etc.)
-			println(err.Error())
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			s.options.Logger.Error(err.Error())
 		}
 	}()
 }
@@ -78,12 +157,90 @@ func (s *ThirdPartyIntegrationsServer) Stop() error {
 
 	err := s.Server.Close()
 	s.Server = nil
-	return err
+
+	if s.socketServer != nil {
+		err = errors.Join(err, s.socketServer.Close())
+		// The listener removes the socket file once closed, but it may not be tracked by the server yet
+		removeErr := os.Remove(s.socketPath)
+		if removeErr != nil && !errors.Is(removeErr, os.ErrNotExist) {
+			err = errors.Join(err, removeErr)
+		}
+		s.socketServer = nil
+		s.socketPath = ""
+	}
+
+	return errors.Join(err, s.removeDiscoveryFile())
+}
+
+// writeDiscoveryFile publishes the port and the socket of the server. The files left behind by instances that
+// didn't stop cleanly are cleaned up on the way.
+func (s *ThirdPartyIntegrationsServer) writeDiscoveryFile(port int) error {
+	if s.options.DiscoveryDirectory == "" {
+		return nil
+	}
+
+	_, err := discovery.List(s.options.DiscoveryDirectory)
+	if err != nil {
+		return err
+	}
+
+	return discovery.Write(s.options.DiscoveryDirectory, discovery.Instance{
+		Pid:        os.Getpid(),
+		Port:       port,
+		Socket:     s.socketPath,
+		Token:      s.options.Token,
+		Version:    appVersion(),
+		APIVersion: APIVersion,
+		BasePath:   BasePath,
+		StartedAt:  time.Now().UTC(),
+	})
+}
+
+func (s *ThirdPartyIntegrationsServer) removeDiscoveryFile() error {
+	if s.options.DiscoveryDirectory == "" {
+		return nil
+	}
+
+	return discovery.Remove(s.options.DiscoveryDirectory, os.Getpid())
+}
+
+func (s *ThirdPartyIntegrationsServer) host() string {
+	if s.options.LanAccess {
+		return lanHost
+	}
+	return loopbackHost
+}
+
+// authorized rejects the requests not carrying the configured bearer token
+func (s *ThirdPartyIntegrationsServer) authorized(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || s.options.Token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.options.Token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errorCodeUnauthorized, "Unauthorized")
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// methods dispatches the requests of a path to the handler of their method
+type methods map[string]http.HandlerFunc
+
+func (m methods) handle(w http.ResponseWriter, r *http.Request) {
+	handler, ok := m[r.Method]
+	if !ok {
+		writeError(w, http.StatusMethodNotAllowed, errorCodeMethodNotAllowed, "Method not allowed")
+		return
+	}
+
+	handler(w, r)
 }
 
-func findAvailablePort() (int, error) {
+func findAvailablePort(host string) (int, error) {
 	for port := StartPort; port <= EndPort; port++ {
-		ln, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		ln, err := net.Listen("tcp", net.JoinHostPort(host, fmt.Sprint(port)))
 		if err != nil {
 			continue // Port is in use
 		}
@@ -6,6 +6,8 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,16 +16,57 @@ import (
 
 	"gomander/cmd/gomander/thirdpartyserver"
 	"gomander/internal/app"
+	commandusecases "gomander/internal/command/application/usecases"
 	commandusecasestest "gomander/internal/command/application/usecases/test"
 	commanddomain "gomander/internal/command/domain"
 	commandgroupusecasestest "gomander/internal/commandgroup/application/usecases/test"
 	commandgroupdomain "gomander/internal/commandgroup/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+	"gomander/internal/discovery"
+	"gomander/internal/event"
+	projectusecasestest "gomander/internal/project/application/usecases/test"
+	projectdomain "gomander/internal/project/domain"
+	"gomander/internal/runner"
 )
 
+const testToken = "test-token"
+
+var serverOptions = thirdpartyserver.Options{Token: testToken}
+
+func authorizedGet(url string) (*http.Response, error) {
+	return authorizedRequest(http.MethodGet, url, "", nil)
+}
+
+func authorizedPost(url, contentType string, body io.Reader) (*http.Response, error) {
+	return authorizedRequest(http.MethodPost, url, contentType, body)
+}
+
+func authorizedRequest(method, url, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Authorization", "Bearer "+testToken)
+	return http.DefaultClient.Do(req)
+}
+
+func startTestServer(t *testing.T, useCases app.UseCases) *httptest.Server {
+	server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+	assert.NoError(t, server.RegisterHandlers())
+
+	testServer := httptest.NewServer(server.Server.Handler)
+	t.Cleanup(testServer.Close)
+
+	return testServer
+}
+
 func TestNewThirdPartyIntegrationsServer_DiscoveryHandler(t *testing.T) {
 	t.Run("GET /discovery should return discovery info", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -40,11 +83,18 @@ func TestNewThirdPartyIntegrationsServer_DiscoveryHandler(t *testing.T) {
 
 		body, err := io.ReadAll(resp.Body)
 		assert.NoError(t, err)
-		assert.JSONEq(t, `{"app": "Gomander"}`, string(body))
+		assert.JSONEq(t, `{
+			"app": "Gomander",
+			"version": "1.4.0",
+			"apiVersion": "v1",
+			"basePath": "/api/v1",
+			"openapi": "/openapi.json",
+			"capabilities": ["commands", "command-groups", "pipelines", "projects", "config", "logs-stream"]
+		}`, string(body))
 	})
 	t.Run("POST /discovery should return 405 Method Not Allowed", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -61,11 +111,67 @@ func TestNewThirdPartyIntegrationsServer_DiscoveryHandler(t *testing.T) {
 	})
 }
 
+func TestNewThirdPartyIntegrationsServer_OpenAPIHandler(t *testing.T) {
+	t.Run("GET /openapi.json should return the OpenAPI document without requiring the token", func(t *testing.T) {
+		// Arrange
+		testServer := startTestServer(t, app.UseCases{})
+
+		// Act
+		resp, err := http.Get(testServer.URL + "/openapi.json")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		var document map[string]interface{}
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&document))
+		assert.Contains(t, document["paths"], "/api/v1/commands")
+	})
+}
+
+func TestNewThirdPartyIntegrationsServer_Errors(t *testing.T) {
+	t.Run("Should return the errors as JSON with a code and a message", func(t *testing.T) {
+		// Arrange
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockGetCommands.On("Execute").Return([]commanddomain.Command{}, nil)
+
+		testServer := startTestServer(t, app.UseCases{GetCommands: mockGetCommands})
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/unknown")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"error": {"code": "not_found", "message": "Command not found"}}`, string(body))
+	})
+
+	t.Run("Should not serve the routes outside the versioned base path", func(t *testing.T) {
+		// Arrange
+		testServer := startTestServer(t, app.UseCases{})
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/commands")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}
+
 func TestNewThirdPartyIntegrationsServer_GetCommandsHandler(t *testing.T) {
 	t.Run("GET /commands should return commands list with status", func(t *testing.T) {
 		// Arrange
 		mockGetCommands := new(commandusecasestest.MockGetCommands)
-		mockGetRunningCommandIds := new(commandusecasestest.MockGetRunningCommandIds)
+		mockGetCommandRunStates := new(commandusecasestest.MockGetCommandRunStates)
 
 		commands := []commanddomain.Command{
 			{
@@ -76,19 +182,36 @@ func TestNewThirdPartyIntegrationsServer_GetCommandsHandler(t *testing.T) {
 				Id:   "cmd-2",
 				Name: "Command 2",
 			},
+			{
+				Id:   "cmd-3",
+				Name: "Command 3",
+			},
 		}
 
-		runningCommandIds := []string{"cmd-1"}
+		startedAt := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+		finishedAt := startedAt.Add(3 * time.Second)
+		exitCode := 1
+		runStates := map[string]runner.RunState{
+			"cmd-1": {CommandId: "cmd-1", Status: runner.RunStatusRunning, StartedAt: startedAt, DurationMs: 1000},
+			"cmd-2": {
+				CommandId:  "cmd-2",
+				Status:     runner.RunStatusExitedError,
+				ExitCode:   &exitCode,
+				StartedAt:  startedAt,
+				FinishedAt: &finishedAt,
+				DurationMs: 3000,
+			},
+		}
 
 		mockGetCommands.On("Execute").Return(commands, nil)
-		mockGetRunningCommandIds.On("Execute").Return(runningCommandIds)
+		mockGetCommandRunStates.On("Execute").Return(runStates)
 
 		useCases := app.UseCases{
-			GetCommands:          mockGetCommands,
-			GetRunningCommandIds: mockGetRunningCommandIds,
+			GetCommands:         mockGetCommands,
+			GetCommandRunStates: mockGetCommandRunStates,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -96,7 +219,7 @@ func TestNewThirdPartyIntegrationsServer_GetCommandsHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Get(testServer.URL + "/commands")
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands")
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -111,23 +234,36 @@ func TestNewThirdPartyIntegrationsServer_GetCommandsHandler(t *testing.T) {
 
 		assert.Equal(t, result, []map[string]interface{}{
 			{
-				"id":     "cmd-1",
-				"name":   "Command 1",
-				"status": "running",
+				"id":         "cmd-1",
+				"name":       "Command 1",
+				"status":     "running",
+				"exitCode":   nil,
+				"startedAt":  "2026-01-01T10:00:00Z",
+				"finishedAt": nil,
+				"durationMs": float64(1000),
 			},
 			{
-				"id":     "cmd-2",
-				"name":   "Command 2",
+				"id":         "cmd-2",
+				"name":       "Command 2",
+				"status":     "exited-error",
+				"exitCode":   float64(1),
+				"startedAt":  "2026-01-01T10:00:00Z",
+				"finishedAt": "2026-01-01T10:00:03Z",
+				"durationMs": float64(3000),
+			},
+			{
+				"id":     "cmd-3",
+				"name":   "Command 3",
 				"status": "stopped",
 			},
 		})
 
-		mock.AssertExpectationsForObjects(t, mockGetRunningCommandIds, mockGetCommands)
+		mock.AssertExpectationsForObjects(t, mockGetCommandRunStates, mockGetCommands)
 	})
 
-	t.Run("POST /commands should return 405 Method Not Allowed", func(t *testing.T) {
+	t.Run("DELETE /commands should return 405 Method Not Allowed", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -135,7 +271,7 @@ func TestNewThirdPartyIntegrationsServer_GetCommandsHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/commands", "application/json", nil)
+		resp, err := authorizedRequest(http.MethodDelete, testServer.URL+"/api/v1/commands", "", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -154,7 +290,7 @@ func TestNewThirdPartyIntegrationsServer_GetCommandsHandler(t *testing.T) {
 			GetCommands: mockGetCommands,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -162,7 +298,7 @@ func TestNewThirdPartyIntegrationsServer_GetCommandsHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Get(testServer.URL + "/commands")
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands")
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -179,13 +315,13 @@ func TestNewThirdPartyIntegrationsServer_RunCommandHandler(t *testing.T) {
 		mockRunCommand := new(commandusecasestest.MockRunCommands)
 		commandId := "cmd-1"
 
-		mockRunCommand.On("Execute", commandId).Return(nil)
+		mockRunCommand.On("Execute", commandId, commandrundomain.TriggerHTTP).Return(nil)
 
 		useCases := app.UseCases{
 			RunCommand: mockRunCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -193,7 +329,7 @@ func TestNewThirdPartyIntegrationsServer_RunCommandHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/commands/"+commandId+"/run", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/run", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -204,7 +340,7 @@ func TestNewThirdPartyIntegrationsServer_RunCommandHandler(t *testing.T) {
 
 	t.Run("GET /commands/{id}/run should return 405 Method Not Allowed", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -212,7 +348,7 @@ func TestNewThirdPartyIntegrationsServer_RunCommandHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Get(testServer.URL + "/commands/cmd-1/run")
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/cmd-1/run")
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -226,13 +362,13 @@ func TestNewThirdPartyIntegrationsServer_RunCommandHandler(t *testing.T) {
 		commandId := "cmd-1"
 		expectedError := fmt.Errorf("failed to run command")
 
-		mockRunCommand.On("Execute", commandId).Return(expectedError)
+		mockRunCommand.On("Execute", commandId, commandrundomain.TriggerHTTP).Return(expectedError)
 
 		useCases := app.UseCases{
 			RunCommand: mockRunCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -240,7 +376,7 @@ func TestNewThirdPartyIntegrationsServer_RunCommandHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/commands/"+commandId+"/run", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/run", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -263,7 +399,7 @@ func TestNewThirdPartyIntegrationsServer_StopCommandHandler(t *testing.T) {
 			StopCommand: mockStopCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -271,7 +407,7 @@ func TestNewThirdPartyIntegrationsServer_StopCommandHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/commands/"+commandId+"/stop", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/stop", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -282,7 +418,7 @@ func TestNewThirdPartyIntegrationsServer_StopCommandHandler(t *testing.T) {
 
 	t.Run("GET /commands/{id}/stop should return 405 Method Not Allowed", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -290,7 +426,7 @@ func TestNewThirdPartyIntegrationsServer_StopCommandHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Get(testServer.URL + "/commands/cmd-1/stop")
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/cmd-1/stop")
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -310,7 +446,7 @@ func TestNewThirdPartyIntegrationsServer_StopCommandHandler(t *testing.T) {
 			StopCommand: mockStopCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -318,7 +454,7 @@ func TestNewThirdPartyIntegrationsServer_StopCommandHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/commands/"+commandId+"/stop", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/stop", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
@@ -328,20 +464,20 @@ func TestNewThirdPartyIntegrationsServer_StopCommandHandler(t *testing.T) {
 	})
 }
 
-// Test Run Command Group Handler
-func TestNewThirdPartyIntegrationsServer_RunCommandGroupHandler(t *testing.T) {
-	t.Run("POST /command-groups/{id}/run should run the command group", func(t *testing.T) {
+// Test Adopt Orphaned Process Handler
+func TestNewThirdPartyIntegrationsServer_RestartCommandHandler(t *testing.T) {
+	t.Run("POST /commands/{id}/restart should restart the command", func(t *testing.T) {
 		// Arrange
-		mockRunCommandGroup := new(commandgroupusecasestest.MockRunCommandGroup)
-		groupId := "group-1"
+		mockRestartCommand := new(commandusecasestest.MockRestartCommand)
+		commandId := "cmd-1"
 
-		mockRunCommandGroup.On("Execute", groupId).Return(nil)
+		mockRestartCommand.On("Execute", commandId, commandrundomain.TriggerHTTP).Return(nil)
 
 		useCases := app.UseCases{
-			RunCommandGroup: mockRunCommandGroup,
+			RestartCommand: mockRestartCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -349,18 +485,27 @@ func TestNewThirdPartyIntegrationsServer_RunCommandGroupHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/command-groups/"+groupId+"/run", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/restart", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
-		mock.AssertExpectationsForObjects(t, mockRunCommandGroup)
+		mock.AssertExpectationsForObjects(t, mockRestartCommand)
 	})
 
-	t.Run("GET /command-groups/{id}/run should return 405 Method Not Allowed", func(t *testing.T) {
+	t.Run("Should return 404 when the command to restart doesn't exist", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		mockRestartCommand := new(commandusecasestest.MockRestartCommand)
+		commandId := "cmd-1"
+
+		mockRestartCommand.On("Execute", commandId, commandrundomain.TriggerHTTP).Return(commanddomain.ErrCommandNotFound)
+
+		useCases := app.UseCases{
+			RestartCommand: mockRestartCommand,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -368,27 +513,27 @@ func TestNewThirdPartyIntegrationsServer_RunCommandGroupHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Get(testServer.URL + "/command-groups/group-1/run")
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/restart", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
-		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockRestartCommand)
 	})
 
-	t.Run("Should return 500 when RunCommandGroup returns error", func(t *testing.T) {
+	t.Run("Should return 500 when RestartCommand returns error", func(t *testing.T) {
 		// Arrange
-		mockRunCommandGroup := new(commandgroupusecasestest.MockRunCommandGroup)
-		groupId := "group-1"
-		expectedError := fmt.Errorf("failed to run command group")
+		mockRestartCommand := new(commandusecasestest.MockRestartCommand)
+		commandId := "cmd-1"
 
-		mockRunCommandGroup.On("Execute", groupId).Return(expectedError)
+		mockRestartCommand.On("Execute", commandId, commandrundomain.TriggerHTTP).Return(fmt.Errorf("failed to restart command"))
 
 		useCases := app.UseCases{
-			RunCommandGroup: mockRunCommandGroup,
+			RestartCommand: mockRestartCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -396,30 +541,29 @@ func TestNewThirdPartyIntegrationsServer_RunCommandGroupHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/command-groups/"+groupId+"/run", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/restart", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
-		mockRunCommandGroup.AssertExpectations(t)
+		mock.AssertExpectationsForObjects(t, mockRestartCommand)
 	})
 }
 
-// Test Stop Command Group Handler
-func TestNewThirdPartyIntegrationsServer_StopCommandGroupHandler(t *testing.T) {
-	t.Run("POST /command-groups/{id}/stop should stop the command group", func(t *testing.T) {
+func TestNewThirdPartyIntegrationsServer_AdoptOrphanedProcessHandler(t *testing.T) {
+	t.Run("POST /commands/{id}/adopt should adopt the orphaned process", func(t *testing.T) {
 		// Arrange
-		mockStopCommandGroup := new(commandgroupusecasestest.MockStopCommandGroup)
-		groupId := "group-1"
+		mockAdoptOrphanedProcess := new(commandusecasestest.MockAdoptOrphanedProcess)
+		commandId := "cmd-1"
 
-		mockStopCommandGroup.On("Execute", groupId).Return(nil)
+		mockAdoptOrphanedProcess.On("Execute", commandId).Return(nil)
 
 		useCases := app.UseCases{
-			StopCommandGroup: mockStopCommandGroup,
+			AdoptOrphanedProcess: mockAdoptOrphanedProcess,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -427,18 +571,27 @@ func TestNewThirdPartyIntegrationsServer_StopCommandGroupHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/command-groups/"+groupId+"/stop", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/adopt", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
 		assert.Equal(t, http.StatusOK, resp.StatusCode)
-		mock.AssertExpectationsForObjects(t, mockStopCommandGroup)
+		mock.AssertExpectationsForObjects(t, mockAdoptOrphanedProcess)
 	})
 
-	t.Run("GET /command-groups/{id}/stop should return 405 Method Not Allowed", func(t *testing.T) {
+	t.Run("Should return 409 when the command has no orphaned process", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		mockAdoptOrphanedProcess := new(commandusecasestest.MockAdoptOrphanedProcess)
+		commandId := "cmd-1"
+
+		mockAdoptOrphanedProcess.On("Execute", commandId).Return(runner.ErrNotOrphaned)
+
+		useCases := app.UseCases{
+			AdoptOrphanedProcess: mockAdoptOrphanedProcess,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -446,27 +599,30 @@ func TestNewThirdPartyIntegrationsServer_StopCommandGroupHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Get(testServer.URL + "/command-groups/group-1/stop")
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/adopt", "application/json", nil)
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
-		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		mockAdoptOrphanedProcess.AssertExpectations(t)
 	})
+}
 
-	t.Run("Should return 500 when StopCommandGroup returns error", func(t *testing.T) {
+// Test Write To Command Handler
+func TestNewThirdPartyIntegrationsServer_WriteToCommandHandler(t *testing.T) {
+	t.Run("POST /commands/{id}/input should write the data to the command", func(t *testing.T) {
 		// Arrange
-		mockStopCommandGroup := new(commandgroupusecasestest.MockStopCommandGroup)
-		groupId := "group-1"
-		expectedError := fmt.Errorf("failed to stop command group")
+		mockWriteToCommand := new(commandusecasestest.MockWriteToCommand)
+		commandId := "cmd-1"
 
-		mockStopCommandGroup.On("Execute", groupId).Return(expectedError)
+		mockWriteToCommand.On("Execute", commandId, "r\n").Return(nil)
 
 		useCases := app.UseCases{
-			StopCommandGroup: mockStopCommandGroup,
+			WriteToCommand: mockWriteToCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -474,51 +630,63 @@ func TestNewThirdPartyIntegrationsServer_StopCommandGroupHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/command-groups/"+groupId+"/stop", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/input", "application/json", strings.NewReader(`{"data": "r\n"}`))
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
-		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
-		mockStopCommandGroup.AssertExpectations(t)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockWriteToCommand)
 	})
-}
 
-// Test Get Command Groups Handler
-func TestNewThirdPartyIntegrationsServer_GetCommandGroupsHandler(t *testing.T) {
-	t.Run("GET /command-groups should return command groups list with running commands info", func(t *testing.T) {
+	t.Run("GET /commands/{id}/input should return 405 Method Not Allowed", func(t *testing.T) {
 		// Arrange
-		mockGetCommandGroups := new(commandgroupusecasestest.MockGetCommandGroups)
-		mockGetRunningCommandIds := new(commandusecasestest.MockGetRunningCommandIds)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
 
-		cmd1 := commanddomain.Command{Id: "cmd-1", Name: "Command 1", Command: "echo 1"}
-		cmd2 := commanddomain.Command{Id: "cmd-2", Name: "Command 2", Command: "echo 2"}
-		cmd3 := commanddomain.Command{Id: "cmd-3", Name: "Command 3", Command: "echo 3"}
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
 
-		groups := []commandgroupdomain.CommandGroup{
-			{
-				Id:       "group-1",
-				Name:     "Group 1",
-				Commands: []commanddomain.Command{cmd1, cmd2},
-			},
-			{
-				Id:       "group-2",
-				Name:     "Group 2",
-				Commands: []commanddomain.Command{cmd2, cmd3},
-			},
-		}
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/cmd-1/input")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
 
-		runningCommandIds := []string{"cmd-1", "cmd-3"}
+	t.Run("Should return 400 when the body is invalid", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
 
-		mockGetCommandGroups.On("Execute").Return(groups, nil)
-		mockGetRunningCommandIds.On("Execute").Return(runningCommandIds)
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/cmd-1/input", "application/json", strings.NewReader("not json"))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("Should return 409 when the command is not running", func(t *testing.T) {
+		// Arrange
+		mockWriteToCommand := new(commandusecasestest.MockWriteToCommand)
+		commandId := "cmd-1"
+
+		mockWriteToCommand.On("Execute", commandId, "r").Return(runner.ErrCommandNotRunning)
 
 		useCases := app.UseCases{
-			GetCommandGroups:     mockGetCommandGroups,
-			GetRunningCommandIds: mockGetRunningCommandIds,
+			WriteToCommand: mockWriteToCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -526,41 +694,59 @@ func TestNewThirdPartyIntegrationsServer_GetCommandGroupsHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Get(testServer.URL + "/command-groups")
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/input", "application/json", strings.NewReader(`{"data": "r"}`))
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
-		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		mockWriteToCommand.AssertExpectations(t)
+	})
 
-		var result []map[string]interface{}
-		body, err := io.ReadAll(resp.Body)
+	t.Run("Should return 409 when the command does not accept input", func(t *testing.T) {
+		// Arrange
+		mockWriteToCommand := new(commandusecasestest.MockWriteToCommand)
+		commandId := "cmd-1"
+
+		mockWriteToCommand.On("Execute", commandId, "r").Return(runner.ErrInputNotAccepted)
+
+		useCases := app.UseCases{
+			WriteToCommand: mockWriteToCommand,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
 		assert.NoError(t, err)
-		err = json.Unmarshal(body, &result)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/input", "application/json", strings.NewReader(`{"data": "r"}`))
 		assert.NoError(t, err)
+		defer resp.Body.Close()
 
-		// First group should have 2 commands with 1 running
-		assert.Equal(t, result, []map[string]interface{}{
-			{
-				"id":              "group-1",
-				"name":            "Group 1",
-				"commands":        float64(2),
-				"runningCommands": float64(1),
-			},
-			{
-				"id":              "group-2",
-				"name":            "Group 2",
-				"commands":        float64(2),
-				"runningCommands": float64(1),
-			},
-		})
+		// Assert
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
 
-		mock.AssertExpectationsForObjects(t, mockGetCommandGroups, mockGetRunningCommandIds)
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"error": {"code": "input_not_accepted", "message": "Command does not accept input"}}`, string(body))
+		mockWriteToCommand.AssertExpectations(t)
 	})
 
-	t.Run("POST /command-groups should return 405 Method Not Allowed", func(t *testing.T) {
+	t.Run("Should return 404 when the command does not exist", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		mockWriteToCommand := new(commandusecasestest.MockWriteToCommand)
+		commandId := "cmd-1"
+
+		mockWriteToCommand.On("Execute", commandId, "r").Return(commanddomain.ErrCommandNotFound)
+
+		useCases := app.UseCases{
+			WriteToCommand: mockWriteToCommand,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -568,26 +754,27 @@ func TestNewThirdPartyIntegrationsServer_GetCommandGroupsHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Post(testServer.URL+"/command-groups", "application/json", nil)
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/input", "application/json", strings.NewReader(`{"data": "r"}`))
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
-		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		mockWriteToCommand.AssertExpectations(t)
 	})
 
-	t.Run("Should return 500 when GetCommandGroups returns error", func(t *testing.T) {
+	t.Run("Should return 500 when WriteToCommand returns error", func(t *testing.T) {
 		// Arrange
-		mockGetCommandGroups := new(commandgroupusecasestest.MockGetCommandGroups)
-		expectedError := fmt.Errorf("failed to get command groups")
+		mockWriteToCommand := new(commandusecasestest.MockWriteToCommand)
+		commandId := "cmd-1"
 
-		mockGetCommandGroups.On("Execute").Return([]commandgroupdomain.CommandGroup{}, expectedError)
+		mockWriteToCommand.On("Execute", commandId, "r").Return(fmt.Errorf("failed to write"))
 
 		useCases := app.UseCases{
-			GetCommandGroups: mockGetCommandGroups,
+			WriteToCommand: mockWriteToCommand,
 		}
 
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases)
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
@@ -595,47 +782,1120 @@ func TestNewThirdPartyIntegrationsServer_GetCommandGroupsHandler(t *testing.T) {
 		defer testServer.Close()
 
 		// Act
-		resp, err := http.Get(testServer.URL + "/command-groups")
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/"+commandId+"/input", "application/json", strings.NewReader(`{"data": "r"}`))
 		assert.NoError(t, err)
 		defer resp.Body.Close()
 
 		// Assert
 		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
-		mockGetCommandGroups.AssertExpectations(t)
+		mockWriteToCommand.AssertExpectations(t)
 	})
 }
 
-func TestThirdPartyIntegrationsServer_StartAndStop(t *testing.T) {
-	t.Run("Should start and stop server without errors", func(t *testing.T) {
+// Test Stream Command Logs Handler
+func TestNewThirdPartyIntegrationsServer_StreamCommandLogsHandler(t *testing.T) {
+	t.Run("GET /commands/{id}/logs/stream should send the backlog and the events of the command", func(t *testing.T) {
 		// Arrange
-		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{})
+		mockStreamCommandLogs := new(commandusecasestest.MockStreamCommandLogs)
+		commandId := "cmd-1"
+
+		messages := make(chan event.Message, 2)
+		messages <- event.Message{Event: event.NewLogEntry, Payload: map[string]string{"id": commandId, "line": "c"}}
+		messages <- event.Message{Event: event.ProcessFinished, Payload: runner.RunState{CommandId: commandId, Status: runner.RunStatusExitedOk}}
+		close(messages)
+
+		mockStreamCommandLogs.On("Execute", commandId, 2).Return(&commandusecases.LogStream{
+			Backlog:  []string{"a", "b"},
+			Messages: messages,
+			Close:    func() {},
+		}, nil)
+
+		useCases := app.UseCases{
+			StreamCommandLogs: mockStreamCommandLogs,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
 		err := server.RegisterHandlers()
 		assert.NoError(t, err)
 
-		// Act - Start the server
-		server.Start()
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
 
-		// Wait a bit for server to fully start
-		time.Sleep(100 * time.Millisecond)
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/" + commandId + "/logs/stream?tail=2")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
 
-		// Assert - Check if server is running by making a request to the discovery endpoint
-		var resp *http.Response
-		var requestErr error
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
 
-		assert.Eventually(t, func() bool {
-			serverAddr := server.Server.Addr
-			resp, requestErr = http.Get(fmt.Sprintf("http://%s/discovery", serverAddr))
-			return requestErr == nil
-		}, 500*time.Millisecond, 100*time.Millisecond, "Server should respond to /discovery requests")
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "event: new_log_entry\ndata: {\"id\":\"cmd-1\",\"line\":\"a\"}\n\n"+
+			"event: new_log_entry\ndata: {\"id\":\"cmd-1\",\"line\":\"b\"}\n\n"+
+			"event: new_log_entry\ndata: {\"id\":\"cmd-1\",\"line\":\"c\"}\n\n"+
+			"event: process_finished\ndata: {\"commandId\":\"cmd-1\",\"status\":\"exited-ok\",\"startedAt\":\"0001-01-01T00:00:00Z\",\"durationMs\":0}\n\n",
+			string(body))
+		mockStreamCommandLogs.AssertExpectations(t)
+	})
 
-		assert.NoError(t, requestErr)
-		assert.Equal(t, http.StatusOK, resp.StatusCode)
-		resp.Body.Close()
+	t.Run("GET /commands/{id}/logs/stream should only send the backlog when not following", func(t *testing.T) {
+		// Arrange
+		mockStreamCommandLogs := new(commandusecasestest.MockStreamCommandLogs)
+		commandId := "cmd-1"
 
-		// Act - Stop the server
-		err = server.Stop()
+		closed := false
+		mockStreamCommandLogs.On("Execute", commandId, 1).Return(&commandusecases.LogStream{
+			Backlog:  []string{"a"},
+			Messages: make(chan event.Message),
+			Close:    func() { closed = true },
+		}, nil)
 
-		// Assert - Server stopped without error
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{StreamCommandLogs: mockStreamCommandLogs}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/" + commandId + "/logs/stream?tail=1&follow=false")
 		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, "event: new_log_entry\ndata: {\"id\":\"cmd-1\",\"line\":\"a\"}\n\n", string(body))
+		assert.True(t, closed)
+		mockStreamCommandLogs.AssertExpectations(t)
+	})
+
+	t.Run("GET /commands/{id}/logs/stream should close the stream when the client disconnects", func(t *testing.T) {
+		// Arrange
+		mockStreamCommandLogs := new(commandusecasestest.MockStreamCommandLogs)
+		commandId := "cmd-1"
+
+		closed := make(chan struct{})
+		mockStreamCommandLogs.On("Execute", commandId, 0).Return(&commandusecases.LogStream{
+			Backlog:  []string{},
+			Messages: make(chan event.Message),
+			Close:    func() { close(closed) },
+		}, nil)
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{StreamCommandLogs: mockStreamCommandLogs}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/" + commandId + "/logs/stream")
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+
+		// Assert
+		assert.Eventually(t, func() bool {
+			select {
+			case <-closed:
+				return true
+			default:
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("GET /commands/{id}/logs/stream should return 404 if the command does not exist", func(t *testing.T) {
+		// Arrange
+		mockStreamCommandLogs := new(commandusecasestest.MockStreamCommandLogs)
+		mockStreamCommandLogs.On("Execute", "cmd-1", 0).Return(nil, commanddomain.ErrCommandNotFound)
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{StreamCommandLogs: mockStreamCommandLogs}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/cmd-1/logs/stream")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("GET /commands/{id}/logs/stream should return 400 Bad Request if the tail is invalid", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/cmd-1/logs/stream?tail=-1")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("GET /commands/{id}/logs/stream should return 400 Bad Request if follow is invalid", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/cmd-1/logs/stream?follow=maybe")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("POST /commands/{id}/logs/stream should return 405 Method Not Allowed", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands/cmd-1/logs/stream", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+}
+
+// Test Run Command Group Handler
+func TestNewThirdPartyIntegrationsServer_RunCommandGroupHandler(t *testing.T) {
+	t.Run("POST /command-groups/{id}/run should run the command group", func(t *testing.T) {
+		// Arrange
+		mockRunCommandGroup := new(commandgroupusecasestest.MockRunCommandGroup)
+		groupId := "group-1"
+
+		mockRunCommandGroup.On("Execute", groupId).Return(nil)
+
+		useCases := app.UseCases{
+			RunCommandGroup: mockRunCommandGroup,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/command-groups/"+groupId+"/run", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockRunCommandGroup)
+	})
+
+	t.Run("GET /command-groups/{id}/run should return 405 Method Not Allowed", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/command-groups/group-1/run")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+
+	t.Run("Should return 500 when RunCommandGroup returns error", func(t *testing.T) {
+		// Arrange
+		mockRunCommandGroup := new(commandgroupusecasestest.MockRunCommandGroup)
+		groupId := "group-1"
+		expectedError := fmt.Errorf("failed to run command group")
+
+		mockRunCommandGroup.On("Execute", groupId).Return(expectedError)
+
+		useCases := app.UseCases{
+			RunCommandGroup: mockRunCommandGroup,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/command-groups/"+groupId+"/run", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		mockRunCommandGroup.AssertExpectations(t)
+	})
+}
+
+// Test Stop Command Group Handler
+func TestNewThirdPartyIntegrationsServer_StopCommandGroupHandler(t *testing.T) {
+	t.Run("POST /command-groups/{id}/stop should stop the command group", func(t *testing.T) {
+		// Arrange
+		mockStopCommandGroup := new(commandgroupusecasestest.MockStopCommandGroup)
+		groupId := "group-1"
+
+		mockStopCommandGroup.On("Execute", groupId).Return(nil)
+
+		useCases := app.UseCases{
+			StopCommandGroup: mockStopCommandGroup,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/command-groups/"+groupId+"/stop", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockStopCommandGroup)
+	})
+
+	t.Run("GET /command-groups/{id}/stop should return 405 Method Not Allowed", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/command-groups/group-1/stop")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+
+	t.Run("Should return 500 when StopCommandGroup returns error", func(t *testing.T) {
+		// Arrange
+		mockStopCommandGroup := new(commandgroupusecasestest.MockStopCommandGroup)
+		groupId := "group-1"
+		expectedError := fmt.Errorf("failed to stop command group")
+
+		mockStopCommandGroup.On("Execute", groupId).Return(expectedError)
+
+		useCases := app.UseCases{
+			StopCommandGroup: mockStopCommandGroup,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/command-groups/"+groupId+"/stop", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		mockStopCommandGroup.AssertExpectations(t)
+	})
+}
+
+// Test Get Command Groups Handler
+func TestNewThirdPartyIntegrationsServer_RestartCommandGroupHandler(t *testing.T) {
+	t.Run("POST /command-groups/{id}/restart should restart the command group", func(t *testing.T) {
+		// Arrange
+		mockRestartCommandGroup := new(commandgroupusecasestest.MockRestartCommandGroup)
+		groupId := "group-1"
+
+		mockRestartCommandGroup.On("Execute", groupId).Return(nil)
+
+		useCases := app.UseCases{
+			RestartCommandGroup: mockRestartCommandGroup,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/command-groups/"+groupId+"/restart", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockRestartCommandGroup)
+	})
+
+	t.Run("Should return 500 when RestartCommandGroup returns error", func(t *testing.T) {
+		// Arrange
+		mockRestartCommandGroup := new(commandgroupusecasestest.MockRestartCommandGroup)
+		groupId := "group-1"
+
+		mockRestartCommandGroup.On("Execute", groupId).Return(fmt.Errorf("failed to restart command group"))
+
+		useCases := app.UseCases{
+			RestartCommandGroup: mockRestartCommandGroup,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/command-groups/"+groupId+"/restart", "application/json", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockRestartCommandGroup)
+	})
+}
+
+func TestNewThirdPartyIntegrationsServer_GetCommandGroupsHandler(t *testing.T) {
+	t.Run("GET /command-groups should return command groups list with running commands info", func(t *testing.T) {
+		// Arrange
+		mockGetCommandGroups := new(commandgroupusecasestest.MockGetCommandGroups)
+		mockGetCommandRunStates := new(commandusecasestest.MockGetCommandRunStates)
+		mockGetPipelineStates := new(commandgroupusecasestest.MockGetPipelineStates)
+
+		cmd1 := commanddomain.Command{Id: "cmd-1", Name: "Command 1", Command: "echo 1"}
+		cmd2 := commanddomain.Command{Id: "cmd-2", Name: "Command 2", Command: "echo 2"}
+		cmd3 := commanddomain.Command{Id: "cmd-3", Name: "Command 3", Command: "echo 3"}
+
+		groups := []commandgroupdomain.CommandGroup{
+			{
+				Id:       "group-1",
+				Name:     "Group 1",
+				Commands: []commanddomain.Command{cmd1, cmd2},
+			},
+			{
+				Id:       "group-2",
+				Name:     "Group 2",
+				Commands: []commanddomain.Command{cmd2, cmd3},
+				Mode:     commandgroupdomain.ModePipeline,
+			},
+			{
+				Id:       "group-3",
+				Name:     "Group 3",
+				Commands: []commanddomain.Command{cmd3},
+				Mode:     commandgroupdomain.ModePipeline,
+			},
+		}
+
+		pipelineStartedAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+		pipelineStates := map[string]runner.PipelineState{
+			"group-2": {
+				Id:               "group-2",
+				Status:           runner.PipelineStatusRunning,
+				CurrentCommandId: "cmd-3",
+				CurrentStep:      2,
+				TotalSteps:       2,
+				FailedCommandIds: []string{},
+				StartedAt:        pipelineStartedAt,
+			},
+		}
+
+		runStates := map[string]runner.RunState{
+			"cmd-1": {CommandId: "cmd-1", Status: runner.RunStatusRunning},
+			"cmd-2": {CommandId: "cmd-2", Status: runner.RunStatusExitedOk},
+			"cmd-3": {CommandId: "cmd-3", Status: runner.RunStatusStopping},
+		}
+
+		mockGetCommandGroups.On("Execute").Return(groups, nil)
+		mockGetCommandRunStates.On("Execute").Return(runStates)
+		mockGetPipelineStates.On("Execute").Return(pipelineStates)
+
+		useCases := app.UseCases{
+			GetCommandGroups:    mockGetCommandGroups,
+			GetCommandRunStates: mockGetCommandRunStates,
+			GetPipelineStates:   mockGetPipelineStates,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/command-groups")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var result []map[string]interface{}
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		err = json.Unmarshal(body, &result)
+		assert.NoError(t, err)
+
+		// First group should have 2 commands with 1 running
+		assert.Equal(t, result, []map[string]interface{}{
+			{
+				"id":              "group-1",
+				"name":            "Group 1",
+				"mode":            "parallel",
+				"commands":        float64(2),
+				"runningCommands": float64(1),
+			},
+			{
+				"id":              "group-2",
+				"name":            "Group 2",
+				"mode":            "pipeline",
+				"commands":        float64(2),
+				"runningCommands": float64(1),
+				"pipeline": map[string]interface{}{
+					"id":               "group-2",
+					"status":           "running",
+					"currentCommandId": "cmd-3",
+					"currentStep":      float64(2),
+					"totalSteps":       float64(2),
+					"failedCommandIds": []interface{}{},
+					"startedAt":        "2026-10-17T12:00:00Z",
+				},
+			},
+			{
+				"id":              "group-3",
+				"name":            "Group 3",
+				"mode":            "pipeline",
+				"commands":        float64(1),
+				"runningCommands": float64(1),
+				"pipeline":        nil,
+			},
+		})
+
+		mock.AssertExpectationsForObjects(t, mockGetCommandGroups, mockGetCommandRunStates, mockGetPipelineStates)
+	})
+
+	t.Run("DELETE /command-groups should return 405 Method Not Allowed", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedRequest(http.MethodDelete, testServer.URL+"/api/v1/command-groups", "", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+
+	t.Run("Should return 500 when GetCommandGroups returns error", func(t *testing.T) {
+		// Arrange
+		mockGetCommandGroups := new(commandgroupusecasestest.MockGetCommandGroups)
+		expectedError := fmt.Errorf("failed to get command groups")
+
+		mockGetCommandGroups.On("Execute").Return([]commandgroupdomain.CommandGroup{}, expectedError)
+
+		useCases := app.UseCases{
+			GetCommandGroups: mockGetCommandGroups,
+		}
+
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(useCases, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/command-groups")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		mockGetCommandGroups.AssertExpectations(t)
+	})
+}
+
+func TestThirdPartyIntegrationsServer_StartAndStop(t *testing.T) {
+	t.Run("Should start and stop server without errors", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		// Act - Start the server
+		err = server.Start()
+		assert.NoError(t, err)
+
+		// Wait a bit for server to fully start
+		time.Sleep(100 * time.Millisecond)
+
+		// Assert - Check if server is running by making a request to the discovery endpoint
+		var resp *http.Response
+		var requestErr error
+
+		assert.Eventually(t, func() bool {
+			serverAddr := server.Server.Addr
+			resp, requestErr = http.Get(fmt.Sprintf("http://%s/discovery", serverAddr))
+			return requestErr == nil
+		}, 500*time.Millisecond, 100*time.Millisecond, "Server should respond to /discovery requests")
+
+		assert.NoError(t, requestErr)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+
+		// Act - Stop the server
+		err = server.Stop()
+
+		// Assert - Server stopped without error
+		assert.NoError(t, err)
+	})
+}
+
+func TestThirdPartyIntegrationsServer_DiscoveryFile(t *testing.T) {
+	t.Run("Should write the discovery file on start and remove it on stop", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, thirdpartyserver.Options{
+			Token:              testToken,
+			DiscoveryDirectory: directory,
+		})
+		assert.NoError(t, server.RegisterHandlers())
+
+		// Act
+		err := server.Start()
+
+		// Assert
+		assert.NoError(t, err)
+
+		instances, err := discovery.List(directory)
+		assert.NoError(t, err)
+		assert.Len(t, instances, 1)
+		assert.Equal(t, os.Getpid(), instances[0].Pid)
+		assert.Equal(t, testToken, instances[0].Token)
+		assert.Equal(t, thirdpartyserver.APIVersion, instances[0].APIVersion)
+		assert.Equal(t, thirdpartyserver.BasePath, instances[0].BasePath)
+
+		resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/discovery", instances[0].Port))
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+
+		// Act
+		err = server.Stop()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoFileExists(t, discovery.FilePath(directory, os.Getpid()))
+	})
+
+	t.Run("Should fail to start when the handlers are not registered", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+
+		// Act
+		err := server.Start()
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestThirdPartyIntegrationsServer_Authentication(t *testing.T) {
+	t.Run("Should reject requests without the bearer token", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		// Act
+		resp, err := http.Get(testServer.URL + "/api/v1/commands")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+		assert.Equal(t, "Bearer", resp.Header.Get("WWW-Authenticate"))
+	})
+
+	t.Run("Should reject requests with a wrong bearer token", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		req, err := http.NewRequest(http.MethodPost, testServer.URL+"/api/v1/command-groups/group-1/run", nil)
+		assert.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer wrong-token")
+
+		// Act
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	t.Run("Should reject every request if no token is configured", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, thirdpartyserver.Options{})
+		err := server.RegisterHandlers()
+		assert.NoError(t, err)
+
+		testServer := httptest.NewServer(server.Server.Handler)
+		defer testServer.Close()
+
+		req, err := http.NewRequest(http.MethodGet, testServer.URL+"/api/v1/commands", nil)
+		assert.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer ")
+
+		// Act
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
+
+func TestThirdPartyIntegrationsServer_Binding(t *testing.T) {
+	t.Run("Should bind to the loopback interface by default", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, serverOptions)
+
+		// Act
+		err := server.RegisterHandlers()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(server.Server.Addr, "127.0.0.1:"))
+	})
+
+	t.Run("Should bind to all interfaces if LAN access is allowed", func(t *testing.T) {
+		// Arrange
+		server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases{}, thirdpartyserver.Options{Token: testToken, LanAccess: true})
+
+		// Act
+		err := server.RegisterHandlers()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(server.Server.Addr, ":"))
+	})
+}
+
+func TestNewThirdPartyIntegrationsServer_CommandCrudHandlers(t *testing.T) {
+	currentProject := &projectdomain.Project{Id: "project-1", Name: "Project 1"}
+	commands := []commanddomain.Command{
+		{Id: "cmd-1", ProjectId: "project-1", Name: "Command 1", Command: "echo 1", Position: 0},
+		{Id: "cmd-2", ProjectId: "project-1", Name: "Command 2", Command: "echo 2", Position: 1},
+	}
+
+	t.Run("POST /commands should add the command to the current project", func(t *testing.T) {
+		// Arrange
+		mockGetCurrentProject := new(projectusecasestest.MockGetCurrentProject)
+		mockAddCommand := new(commandusecasestest.MockAddCommand)
+		mockGetCurrentProject.On("Execute").Return(currentProject, nil)
+		mockAddCommand.On("Execute", mock.MatchedBy(func(command commanddomain.Command) bool {
+			return command.Id != "" && command.ProjectId == "project-1" && command.Name == "Build" && command.Command == "make"
+		})).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCurrentProject: mockGetCurrentProject,
+			AddCommand:        mockAddCommand,
+		})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands", "application/json",
+			strings.NewReader(`{"name": "Build", "command": "make", "projectId": "other"}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockGetCurrentProject, mockAddCommand)
+	})
+
+	t.Run("POST /commands should return 409 Conflict if no project is open", func(t *testing.T) {
+		// Arrange
+		mockGetCurrentProject := new(projectusecasestest.MockGetCurrentProject)
+		mockGetCurrentProject.On("Execute").Return(nil, nil)
+
+		testServer := startTestServer(t, app.UseCases{GetCurrentProject: mockGetCurrentProject})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands", "application/json", strings.NewReader(`{"name": "Build", "command": "make"}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	})
+
+	t.Run("POST /commands should return 400 Bad Request if an error pattern is invalid", func(t *testing.T) {
+		// Arrange
+		mockGetCurrentProject := new(projectusecasestest.MockGetCurrentProject)
+		mockAddCommand := new(commandusecasestest.MockAddCommand)
+		mockGetCurrentProject.On("Execute").Return(currentProject, nil)
+		mockAddCommand.On("Execute", mock.Anything).Return(fmt.Errorf("%w: the pattern is empty", commanddomain.ErrInvalidErrorPattern))
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCurrentProject: mockGetCurrentProject,
+			AddCommand:        mockAddCommand,
+		})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/commands", "application/json",
+			strings.NewReader(`{"name": "Build", "command": "make", "errorPatterns": [{"pattern": ""}]}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("GET /commands/{id} should return the command", func(t *testing.T) {
+		// Arrange
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockGetCommands.On("Execute").Return(commands, nil)
+
+		testServer := startTestServer(t, app.UseCases{GetCommands: mockGetCommands})
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/cmd-2")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var command commanddomain.Command
+		assert.NoError(t, json.NewDecoder(resp.Body).Decode(&command))
+		assert.Equal(t, "Command 2", command.Name)
+	})
+
+	t.Run("GET /commands/{id} should return 404 Not Found if the command is not in the current project", func(t *testing.T) {
+		// Arrange
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockGetCommands.On("Execute").Return(commands, nil)
+
+		testServer := startTestServer(t, app.UseCases{GetCommands: mockGetCommands})
+
+		// Act
+		resp, err := authorizedGet(testServer.URL + "/api/v1/commands/unknown")
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	t.Run("PATCH /commands/{id} should update the fields present in the body, keeping the project and position", func(t *testing.T) {
+		// Arrange
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockEditCommand := new(commandusecasestest.MockEditCommand)
+		mockGetCommands.On("Execute").Return(commands, nil)
+		mockEditCommand.On("Execute", commanddomain.Command{
+			Id:        "cmd-2",
+			ProjectId: "project-1",
+			Name:      "Command 2",
+			Command:   "echo two",
+			Position:  1,
+		}).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCommands: mockGetCommands,
+			EditCommand: mockEditCommand,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPatch, testServer.URL+"/api/v1/commands/cmd-2", "application/json",
+			strings.NewReader(`{"command": "echo two", "projectId": "other", "position": 5}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockEditCommand)
+	})
+
+	t.Run("PATCH /commands/{id} should return 400 Bad Request if an option is invalid", func(t *testing.T) {
+		// Arrange
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockEditCommand := new(commandusecasestest.MockEditCommand)
+		mockGetCommands.On("Execute").Return(commands, nil)
+		mockEditCommand.On("Execute", mock.Anything).Return(fmt.Errorf("%w: unknown stop signal \"SIGKILL\"", commanddomain.ErrInvalidCommand))
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCommands: mockGetCommands,
+			EditCommand: mockEditCommand,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPatch, testServer.URL+"/api/v1/commands/cmd-2", "application/json",
+			strings.NewReader(`{"stopSignal": "SIGKILL"}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"error": {"code": "invalid_command", "message": "invalid command: unknown stop signal \"SIGKILL\""}}`, string(body))
+	})
+
+	t.Run("DELETE /commands/{id} should remove the command", func(t *testing.T) {
+		// Arrange
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockRemoveCommand := new(commandusecasestest.MockRemoveCommand)
+		mockGetCommands.On("Execute").Return(commands, nil)
+		mockRemoveCommand.On("Execute", "cmd-1").Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCommands:   mockGetCommands,
+			RemoveCommand: mockRemoveCommand,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodDelete, testServer.URL+"/api/v1/commands/cmd-1", "", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockRemoveCommand)
+	})
+
+	t.Run("PUT /commands/order should reorder the commands", func(t *testing.T) {
+		// Arrange
+		mockReorderCommands := new(commandusecasestest.MockReorderCommands)
+		mockReorderCommands.On("Execute", []string{"cmd-2", "cmd-1"}).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{ReorderCommands: mockReorderCommands})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPut, testServer.URL+"/api/v1/commands/order", "application/json",
+			strings.NewReader(`{"ids": ["cmd-2", "cmd-1"]}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockReorderCommands)
+	})
+}
+
+func TestNewThirdPartyIntegrationsServer_CommandGroupCrudHandlers(t *testing.T) {
+	currentProject := &projectdomain.Project{Id: "project-1", Name: "Project 1"}
+	commands := []commanddomain.Command{
+		{Id: "cmd-1", ProjectId: "project-1", Name: "Command 1"},
+		{Id: "cmd-2", ProjectId: "project-1", Name: "Command 2"},
+	}
+	groups := []commandgroupdomain.CommandGroup{
+		{Id: "group-1", ProjectId: "project-1", Name: "Group 1", Commands: commands, Position: 2},
+	}
+
+	t.Run("POST /command-groups should create the group with the commands of the current project", func(t *testing.T) {
+		// Arrange
+		mockGetCurrentProject := new(projectusecasestest.MockGetCurrentProject)
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockCreateCommandGroup := new(commandgroupusecasestest.MockCreateCommandGroup)
+		mockGetCurrentProject.On("Execute").Return(currentProject, nil)
+		mockGetCommands.On("Execute").Return(commands, nil)
+		mockCreateCommandGroup.On("Execute", mock.MatchedBy(func(group *commandgroupdomain.CommandGroup) bool {
+			return group.Id != "" && group.ProjectId == "project-1" &&
+				len(group.Commands) == 1 && group.Commands[0].Name == "Command 2"
+		})).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCurrentProject:  mockGetCurrentProject,
+			GetCommands:        mockGetCommands,
+			CreateCommandGroup: mockCreateCommandGroup,
+		})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/command-groups", "application/json",
+			strings.NewReader(`{"name": "Group", "commands": [{"id": "cmd-2"}]}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockCreateCommandGroup)
+	})
+
+	t.Run("POST /command-groups should return 400 Bad Request if a command does not exist", func(t *testing.T) {
+		// Arrange
+		mockGetCurrentProject := new(projectusecasestest.MockGetCurrentProject)
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockCreateCommandGroup := new(commandgroupusecasestest.MockCreateCommandGroup)
+		mockGetCurrentProject.On("Execute").Return(currentProject, nil)
+		mockGetCommands.On("Execute").Return(commands, nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCurrentProject:  mockGetCurrentProject,
+			GetCommands:        mockGetCommands,
+			CreateCommandGroup: mockCreateCommandGroup,
+		})
+
+		// Act
+		resp, err := authorizedPost(testServer.URL+"/api/v1/command-groups", "application/json",
+			strings.NewReader(`{"name": "Group", "commands": [{"id": "unknown"}]}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		mockCreateCommandGroup.AssertNotCalled(t, "Execute", mock.Anything)
+	})
+
+	t.Run("PATCH /command-groups/{id} should return 400 Bad Request if the dependencies are invalid", func(t *testing.T) {
+		// Arrange
+		mockGetCommandGroups := new(commandgroupusecasestest.MockGetCommandGroups)
+		mockGetCommands := new(commandusecasestest.MockGetCommands)
+		mockUpdateCommandGroup := new(commandgroupusecasestest.MockUpdateCommandGroup)
+		mockGetCommandGroups.On("Execute").Return(groups, nil)
+		mockGetCommands.On("Execute").Return(commands, nil)
+		mockUpdateCommandGroup.On("Execute", mock.MatchedBy(func(group *commandgroupdomain.CommandGroup) bool {
+			return group.Id == "group-1" && group.Name == "Group 1" && group.Position == 2 && group.Mode == commandgroupdomain.ModePipeline
+		})).Return(commandgroupdomain.ErrDependencyCycle)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCommandGroups:   mockGetCommandGroups,
+			GetCommands:        mockGetCommands,
+			UpdateCommandGroup: mockUpdateCommandGroup,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPatch, testServer.URL+"/api/v1/command-groups/group-1", "application/json",
+			strings.NewReader(`{"mode": "pipeline"}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockUpdateCommandGroup)
+	})
+
+	t.Run("DELETE /command-groups/{id} should delete the group", func(t *testing.T) {
+		// Arrange
+		mockGetCommandGroups := new(commandgroupusecasestest.MockGetCommandGroups)
+		mockDeleteCommandGroup := new(commandgroupusecasestest.MockDeleteCommandGroup)
+		mockGetCommandGroups.On("Execute").Return(groups, nil)
+		mockDeleteCommandGroup.On("Execute", "group-1").Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCommandGroups:   mockGetCommandGroups,
+			DeleteCommandGroup: mockDeleteCommandGroup,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodDelete, testServer.URL+"/api/v1/command-groups/group-1", "", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockDeleteCommandGroup)
+	})
+
+	t.Run("DELETE /command-groups/{id}/commands/{commandId} should remove the command from the group", func(t *testing.T) {
+		// Arrange
+		mockGetCommandGroups := new(commandgroupusecasestest.MockGetCommandGroups)
+		mockRemoveCommandFromCommandGroup := new(commandgroupusecasestest.MockRemoveCommandFromCommandGroup)
+		mockGetCommandGroups.On("Execute").Return(groups, nil)
+		mockRemoveCommandFromCommandGroup.On("Execute", "cmd-1", "group-1").Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{
+			GetCommandGroups:              mockGetCommandGroups,
+			RemoveCommandFromCommandGroup: mockRemoveCommandFromCommandGroup,
+		})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodDelete, testServer.URL+"/api/v1/command-groups/group-1/commands/cmd-1", "", nil)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockRemoveCommandFromCommandGroup)
+	})
+
+	t.Run("PUT /command-groups/order should reorder the groups", func(t *testing.T) {
+		// Arrange
+		mockReorderCommandGroups := new(commandgroupusecasestest.MockReorderCommandGroups)
+		mockReorderCommandGroups.On("Execute", []string{"group-2", "group-1"}).Return(nil)
+
+		testServer := startTestServer(t, app.UseCases{ReorderCommandGroups: mockReorderCommandGroups})
+
+		// Act
+		resp, err := authorizedRequest(http.MethodPut, testServer.URL+"/api/v1/command-groups/order", "application/json",
+			strings.NewReader(`{"ids": ["group-2", "group-1"]}`))
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		mock.AssertExpectationsForObjects(t, mockReorderCommandGroups)
 	})
 }
@@ -0,0 +1,505 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gomander/cmd/gomanderctl/client"
+)
+
+const usage = `Usage: gomanderctl [--pid <pid>] <command> [arguments]
+
+Commands:
+  ls [--json]                     List the commands of the open project
+  run <name|id>...                Run commands
+  stop <name|id>...               Stop commands
+  restart <name|id>...            Restart commands
+  adopt <name|id>...              Adopt the processes left running by a crashed Gomander
+  group ls [--json]               List the command groups of the open project
+  group run <name|id>             Run a command group
+  group stop <name|id>            Stop a command group
+  group restart <name|id>         Restart a command group
+  logs [-f] [-n <lines>] <name|id>
+                                  Print the output of a command
+  status [--json]                 Show the running instance and the state of its commands
+  completion <bash|zsh|fish>      Print the shell completion script
+
+Commands and groups are looked up by id, then by name.
+The most recently started Gomander is used unless --pid is given. Set GOMANDER_URL, and
+GOMANDER_TOKEN, to connect to a Gomander that is not running on this machine.
+`
+
+const (
+	exitOk    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// errUsage is returned when the arguments are wrong, the message is printed along with the usage
+type errUsage struct {
+	message string
+}
+
+func (e errUsage) Error() string {
+	return e.message
+}
+
+func usageError(format string, args ...interface{}) error {
+	return errUsage{message: fmt.Sprintf(format, args...)}
+}
+
+type CLI struct {
+	Stdout io.Writer
+	Stderr io.Writer
+	// Connect returns a client of the running instance with the pid, or of the most recent one when it's 0
+	Connect func(pid int) (*client.Client, error)
+
+	pid int
+}
+
+func NewCLI(stdout io.Writer, stderr io.Writer, connect func(pid int) (*client.Client, error)) *CLI {
+	return &CLI{
+		Stdout:  stdout,
+		Stderr:  stderr,
+		Connect: connect,
+	}
+}
+
+// Run executes the command of the arguments and returns the exit code of the process
+func (c *CLI) Run(ctx context.Context, args []string) int {
+	err := c.run(ctx, args)
+
+	var usageErr errUsage
+	switch {
+	case err == nil:
+		return exitOk
+	case errors.Is(err, flag.ErrHelp):
+		_, _ = fmt.Fprint(c.Stdout, usage)
+		return exitOk
+	case errors.As(err, &usageErr):
+		_, _ = fmt.Fprintf(c.Stderr, "gomanderctl: %s\n\n%s", err, usage)
+		return exitUsage
+	default:
+		_, _ = fmt.Fprintf(c.Stderr, "gomanderctl: %s\n", err)
+		return exitError
+	}
+}
+
+func (c *CLI) run(ctx context.Context, args []string) error {
+	flags := newFlagSet("gomanderctl")
+	flags.IntVar(&c.pid, "pid", 0, "")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	args = flags.Args()
+	if len(args) == 0 {
+		return usageError("missing command")
+	}
+
+	command, args := args[0], args[1:]
+	switch command {
+	case "ls":
+		return c.listCommands(args)
+	case "run":
+		return c.runCommands(args)
+	case "stop":
+		return c.stopCommands(args)
+	case "restart":
+		return c.restartCommands(args)
+	case "adopt":
+		return c.adoptOrphanedProcesses(args)
+	case "group":
+		return c.group(args)
+	case "logs":
+		return c.logs(ctx, args)
+	case "status":
+		return c.status(args)
+	case "completion":
+		return c.completion(args)
+	case completeCommand:
+		c.complete(args)
+		return nil
+	case "help":
+		return flag.ErrHelp
+	default:
+		return usageError("unknown command %q", command)
+	}
+}
+
+func (c *CLI) listCommands(args []string) error {
+	flags := newFlagSet("ls")
+	asJSON := flags.Bool("json", false, "")
+	if _, err := parse(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	api, err := c.Connect(c.pid)
+	if err != nil {
+		return err
+	}
+
+	commands, err := api.GetCommands()
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return c.printJSON(commands)
+	}
+
+	w := tabwriter.NewWriter(c.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tSTATUS\tID")
+	for _, command := range commands {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", command.Name, commandStatus(command), command.Id)
+	}
+	return w.Flush()
+}
+
+func (c *CLI) runCommands(args []string) error {
+	return c.onCommands("run", args, func(api *client.Client, command client.Command) error {
+		return api.RunCommand(command.Id)
+	})
+}
+
+func (c *CLI) stopCommands(args []string) error {
+	return c.onCommands("stop", args, func(api *client.Client, command client.Command) error {
+		return api.StopCommand(command.Id)
+	})
+}
+
+func (c *CLI) restartCommands(args []string) error {
+	return c.onCommands("restart", args, func(api *client.Client, command client.Command) error {
+		return api.RestartCommand(command.Id)
+	})
+}
+
+func (c *CLI) adoptOrphanedProcesses(args []string) error {
+	return c.onCommands("adopt", args, func(api *client.Client, command client.Command) error {
+		return api.AdoptOrphanedProcess(command.Id)
+	})
+}
+
+// onCommands resolves every command before acting on any of them, so a typo doesn't leave the work half done
+func (c *CLI) onCommands(name string, args []string, action func(*client.Client, client.Command) error) error {
+	queries, err := parse(newFlagSet(name), args, 1, -1)
+	if err != nil {
+		return err
+	}
+
+	api, err := c.Connect(c.pid)
+	if err != nil {
+		return err
+	}
+
+	commands, err := api.GetCommands()
+	if err != nil {
+		return err
+	}
+
+	resolved := make([]client.Command, 0, len(queries))
+	for _, query := range queries {
+		command, err := resolve(commands, query, "command", func(command client.Command) (string, string) {
+			return command.Id, command.Name
+		})
+		if err != nil {
+			return err
+		}
+		resolved = append(resolved, command)
+	}
+
+	for _, command := range resolved {
+		if err := action(api, command); err != nil {
+			return fmt.Errorf("%s %s: %w", name, command.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *CLI) group(args []string) error {
+	if len(args) == 0 {
+		return usageError("missing group command")
+	}
+
+	command, args := args[0], args[1:]
+	switch command {
+	case "ls":
+		return c.listCommandGroups(args)
+	case "run":
+		return c.onCommandGroup("run", args, (*client.Client).RunCommandGroup)
+	case "stop":
+		return c.onCommandGroup("stop", args, (*client.Client).StopCommandGroup)
+	case "restart":
+		return c.onCommandGroup("restart", args, (*client.Client).RestartCommandGroup)
+	default:
+		return usageError("unknown group command %q", command)
+	}
+}
+
+func (c *CLI) listCommandGroups(args []string) error {
+	flags := newFlagSet("group ls")
+	asJSON := flags.Bool("json", false, "")
+	if _, err := parse(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	api, err := c.Connect(c.pid)
+	if err != nil {
+		return err
+	}
+
+	groups, err := api.GetCommandGroups()
+	if err != nil {
+		return err
+	}
+
+	if *asJSON {
+		return c.printJSON(groups)
+	}
+
+	w := tabwriter.NewWriter(c.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tMODE\tRUNNING\tID")
+	for _, group := range groups {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d/%d\t%s\n", group.Name, group.Mode, group.RunningCommands, group.Commands, group.Id)
+	}
+	return w.Flush()
+}
+
+func (c *CLI) onCommandGroup(name string, args []string, action func(*client.Client, string) error) error {
+	queries, err := parse(newFlagSet("group "+name), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	api, err := c.Connect(c.pid)
+	if err != nil {
+		return err
+	}
+
+	groups, err := api.GetCommandGroups()
+	if err != nil {
+		return err
+	}
+
+	group, err := resolve(groups, queries[0], "command group", func(group client.CommandGroup) (string, string) {
+		return group.Id, group.Name
+	})
+	if err != nil {
+		return err
+	}
+
+	return action(api, group.Id)
+}
+
+func (c *CLI) logs(ctx context.Context, args []string) error {
+	flags := newFlagSet("logs")
+	follow := flags.Bool("f", false, "")
+	lines := flags.Int("n", 100, "")
+	queries, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	if *lines < 0 {
+		return usageError("the number of lines can't be negative")
+	}
+
+	api, err := c.Connect(c.pid)
+	if err != nil {
+		return err
+	}
+
+	commands, err := api.GetCommands()
+	if err != nil {
+		return err
+	}
+
+	command, err := resolve(commands, queries[0], "command", func(command client.Command) (string, string) {
+		return command.Id, command.Name
+	})
+	if err != nil {
+		return err
+	}
+
+	return api.StreamLogs(ctx, command.Id, *lines, *follow, func(entry client.LogEntry) {
+		if entry.Raw {
+			_, _ = fmt.Fprint(c.Stdout, entry.Line)
+			return
+		}
+		_, _ = fmt.Fprintln(c.Stdout, entry.Line)
+	})
+}
+
+type statusResponse struct {
+	Address       string                `json:"address"`
+	Version       string                `json:"version"`
+	APIVersion    string                `json:"apiVersion"`
+	Project       *client.Project       `json:"project"`
+	Commands      []client.Command      `json:"commands"`
+	CommandGroups []client.CommandGroup `json:"commandGroups"`
+}
+
+func (c *CLI) status(args []string) error {
+	flags := newFlagSet("status")
+	asJSON := flags.Bool("json", false, "")
+	if _, err := parse(flags, args, 0, 0); err != nil {
+		return err
+	}
+
+	api, err := c.Connect(c.pid)
+	if err != nil {
+		return err
+	}
+
+	discovery, err := api.Discovery()
+	if err != nil {
+		return err
+	}
+
+	status := statusResponse{
+		Address:       api.Address,
+		Version:       discovery.Version,
+		APIVersion:    discovery.APIVersion,
+		Commands:      []client.Command{},
+		CommandGroups: []client.CommandGroup{},
+	}
+
+	status.Project, err = api.GetCurrentProject()
+	if err != nil {
+		return err
+	}
+
+	// Commands and groups belong to the open project
+	if status.Project != nil {
+		status.Commands, err = api.GetCommands()
+		if err != nil {
+			return err
+		}
+		status.CommandGroups, err = api.GetCommandGroups()
+		if err != nil {
+			return err
+		}
+	}
+
+	if *asJSON {
+		return c.printJSON(status)
+	}
+
+	_, _ = fmt.Fprintf(c.Stdout, "Gomander %s (API %s) at %s\n", status.Version, status.APIVersion, status.Address)
+	if status.Project == nil {
+		_, _ = fmt.Fprintln(c.Stdout, "No project is open")
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(c.Stdout, "Project: %s (%s)\n", status.Project.Name, status.Project.WorkingDirectory)
+
+	running := 0
+	for _, command := range status.Commands {
+		if isActive(command.Status) {
+			running++
+		}
+	}
+	_, _ = fmt.Fprintf(c.Stdout, "Commands: %d running of %d\n", running, len(status.Commands))
+
+	return nil
+}
+
+func (c *CLI) printJSON(payload interface{}) error {
+	encoder := json.NewEncoder(c.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(payload)
+}
+
+// commandStatus adds the exit code to the status of the commands that failed
+func commandStatus(command client.Command) string {
+	if command.ExitCode != nil && *command.ExitCode != 0 {
+		return fmt.Sprintf("%s (%d)", command.Status, *command.ExitCode)
+	}
+	return command.Status
+}
+
+func isActive(status string) bool {
+	return status == "starting" || status == "running" || status == "stopping" || status == "orphaned"
+}
+
+// resolve finds the item whose id is the query, or else the only one whose name is the query.
+// Names are compared ignoring the case when there's no exact match.
+func resolve[T any](items []T, query string, kind string, key func(T) (string, string)) (T, error) {
+	var zero T
+
+	for _, item := range items {
+		if id, _ := key(item); id == query {
+			return item, nil
+		}
+	}
+
+	for _, compare := range []func(string, string) bool{
+		func(a, b string) bool { return a == b },
+		strings.EqualFold,
+	} {
+		matches := make([]T, 0)
+		for _, item := range items {
+			if _, name := key(item); compare(name, query) {
+				matches = append(matches, item)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			return matches[0], nil
+		default:
+			ids := make([]string, 0, len(matches))
+			for _, match := range matches {
+				id, _ := key(match)
+				ids = append(ids, id)
+			}
+			return zero, fmt.Errorf("%q matches several %ss, use one of their ids: %s", query, kind, strings.Join(ids, ", "))
+		}
+	}
+
+	return zero, fmt.Errorf("%s %q not found", kind, query)
+}
+
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	return flags
+}
+
+// parse accepts the flags anywhere between the positional arguments, and checks how many of them there are.
+// A max of -1 allows any number of them.
+func parse(flags *flag.FlagSet, args []string, min int, max int) ([]string, error) {
+	positional := make([]string, 0)
+	for {
+		if err := flags.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, usageError("%s: %s", flags.Name(), err)
+		}
+
+		args = flags.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if len(positional) < min {
+		return nil, usageError("%s: missing arguments", flags.Name())
+	}
+	if max >= 0 && len(positional) > max {
+		return nil, usageError("%s: too many arguments", flags.Name())
+	}
+
+	return positional, nil
+}
@@ -0,0 +1,302 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/cmd/gomanderctl/cli"
+	"gomander/cmd/gomanderctl/client"
+)
+
+// fakeAPI serves a project with a couple of commands and a group, and records the actions requested
+type fakeAPI struct {
+	mu      sync.Mutex
+	actions []string
+}
+
+func (f *fakeAPI) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /discovery", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"app":"Gomander","version":"1.4.0","apiVersion":"v1"}`))
+	})
+	mux.HandleFunc("GET /api/v1/projects/current", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"project-1","name":"Shop","workingDirectory":"/src/shop"}`))
+	})
+	mux.HandleFunc("GET /api/v1/commands", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[
+			{"id":"cmd-1","name":"api","status":"running"},
+			{"id":"cmd-2","name":"Web","status":"exited-error","exitCode":1},
+			{"id":"cmd-3","name":"worker","status":"stopped"},
+			{"id":"cmd-4","name":"worker","status":"stopped"}
+		]`))
+	})
+	mux.HandleFunc("GET /api/v1/command-groups", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":"group-1","name":"Backend","mode":"parallel","commands":2,"runningCommands":1}]`))
+	})
+	mux.HandleFunc("POST /api/v1/{kind}/{id}/{action}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.actions = append(f.actions, r.PathValue("action")+" "+r.PathValue("kind")+"/"+r.PathValue("id"))
+	})
+	mux.HandleFunc("GET /api/v1/commands/{id}/logs/stream", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		_, _ = w.Write([]byte("event: new_log_entry\ndata: {\"id\":\"cmd-1\",\"line\":\"Listening on :8080\"}\n\n"))
+	})
+	return mux
+}
+
+func runCLI(t *testing.T, args ...string) (int, string, string, *fakeAPI) {
+	api := &fakeAPI{}
+	server := httptest.NewServer(api.handler())
+	t.Cleanup(server.Close)
+
+	var stdout, stderr bytes.Buffer
+	c := cli.NewCLI(&stdout, &stderr, func(_ int) (*client.Client, error) {
+		return client.NewClient(server.URL, "token", server.Client()), nil
+	})
+
+	code := c.Run(context.Background(), args)
+	return code, stdout.String(), stderr.String(), api
+}
+
+func TestCLI_Ls(t *testing.T) {
+	t.Run("Should list the commands with their status", func(t *testing.T) {
+		// Act
+		code, stdout, _, _ := runCLI(t, "ls")
+
+		// Assert
+		assert.Equal(t, 0, code)
+		assert.Equal(t, "NAME    STATUS            ID\n"+
+			"api     running           cmd-1\n"+
+			"Web     exited-error (1)  cmd-2\n"+
+			"worker  stopped           cmd-3\n"+
+			"worker  stopped           cmd-4\n", stdout)
+	})
+
+	t.Run("Should list the commands as JSON", func(t *testing.T) {
+		// Act
+		code, stdout, _, _ := runCLI(t, "ls", "--json")
+
+		// Assert
+		assert.Equal(t, 0, code)
+
+		var commands []client.Command
+		assert.NoError(t, json.Unmarshal([]byte(stdout), &commands))
+		assert.Len(t, commands, 4)
+	})
+}
+
+func TestCLI_Run(t *testing.T) {
+	t.Run("Should run the commands by name and id", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "run", "api", "cmd-3")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, []string{"run commands/cmd-1", "run commands/cmd-3"}, api.actions)
+	})
+
+	t.Run("Should match the names ignoring the case when there's no exact match", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "run", "web")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, []string{"run commands/cmd-2"}, api.actions)
+	})
+
+	t.Run("Should not run anything when a command is not found", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "run", "api", "db")
+
+		// Assert
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, `command "db" not found`)
+		assert.Empty(t, api.actions)
+	})
+
+	t.Run("Should fail when the name matches several commands", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "run", "worker")
+
+		// Assert
+		assert.Equal(t, 1, code)
+		assert.Contains(t, stderr, "cmd-3, cmd-4")
+		assert.Empty(t, api.actions)
+	})
+
+	t.Run("Should fail without arguments", func(t *testing.T) {
+		// Act
+		code, _, stderr, _ := runCLI(t, "run")
+
+		// Assert
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr, "Usage:")
+	})
+}
+
+func TestCLI_Stop(t *testing.T) {
+	t.Run("Should stop the command", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "stop", "api")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, []string{"stop commands/cmd-1"}, api.actions)
+	})
+}
+
+func TestCLI_Restart(t *testing.T) {
+	t.Run("Should restart the commands", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "restart", "api", "Web")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, []string{"restart commands/cmd-1", "restart commands/cmd-2"}, api.actions)
+	})
+}
+
+func TestCLI_Adopt(t *testing.T) {
+	t.Run("Should adopt the orphaned process of the command", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "adopt", "api")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, []string{"adopt commands/cmd-1"}, api.actions)
+	})
+}
+
+func TestCLI_Group(t *testing.T) {
+	t.Run("Should run the command group by name", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "group", "run", "Backend")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, []string{"run command-groups/group-1"}, api.actions)
+	})
+
+	t.Run("Should stop the command group", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "group", "stop", "group-1")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, []string{"stop command-groups/group-1"}, api.actions)
+	})
+
+	t.Run("Should restart the command group", func(t *testing.T) {
+		// Act
+		code, _, stderr, api := runCLI(t, "group", "restart", "Backend")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, []string{"restart command-groups/group-1"}, api.actions)
+	})
+
+	t.Run("Should list the command groups", func(t *testing.T) {
+		// Act
+		code, stdout, _, _ := runCLI(t, "group", "ls")
+
+		// Assert
+		assert.Equal(t, 0, code)
+		assert.Equal(t, "NAME     MODE      RUNNING  ID\nBackend  parallel  1/2      group-1\n", stdout)
+	})
+}
+
+func TestCLI_Logs(t *testing.T) {
+	t.Run("Should print the output of the command", func(t *testing.T) {
+		// Act
+		code, stdout, stderr, _ := runCLI(t, "logs", "api", "-n", "10")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Equal(t, "Listening on :8080\n", stdout)
+	})
+}
+
+func TestCLI_Status(t *testing.T) {
+	t.Run("Should print the state of the instance", func(t *testing.T) {
+		// Act
+		code, stdout, stderr, _ := runCLI(t, "status")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+		assert.Contains(t, stdout, "Gomander 1.4.0 (API v1)")
+		assert.Contains(t, stdout, "Project: Shop (/src/shop)")
+		assert.Contains(t, stdout, "Commands: 1 running of 4")
+	})
+
+	t.Run("Should print the state as JSON", func(t *testing.T) {
+		// Act
+		code, stdout, stderr, _ := runCLI(t, "status", "--json")
+
+		// Assert
+		assert.Equal(t, 0, code, stderr)
+
+		var status struct {
+			Version       string                `json:"version"`
+			Project       client.Project        `json:"project"`
+			Commands      []client.Command      `json:"commands"`
+			CommandGroups []client.CommandGroup `json:"commandGroups"`
+		}
+		assert.NoError(t, json.Unmarshal([]byte(stdout), &status))
+		assert.Equal(t, "1.4.0", status.Version)
+		assert.Equal(t, "Shop", status.Project.Name)
+		assert.Len(t, status.Commands, 4)
+		assert.Len(t, status.CommandGroups, 1)
+	})
+}
+
+func TestCLI_Completion(t *testing.T) {
+	t.Run("Should print the completion script of the shell", func(t *testing.T) {
+		// Act
+		code, stdout, _, _ := runCLI(t, "completion", "bash")
+
+		// Assert
+		assert.Equal(t, 0, code)
+		assert.Contains(t, stdout, "complete -F _gomanderctl gomanderctl")
+	})
+
+	t.Run("Should fail for an unsupported shell", func(t *testing.T) {
+		// Act
+		code, _, stderr, _ := runCLI(t, "completion", "tcsh")
+
+		// Assert
+		assert.Equal(t, 2, code)
+		assert.Contains(t, stderr, "unsupported shell")
+	})
+
+	t.Run("Should complete the commands of the CLI", func(t *testing.T) {
+		// Act
+		_, stdout, _, _ := runCLI(t, "__complete", "s")
+
+		// Assert
+		assert.Equal(t, "stop\nstatus\n", stdout)
+	})
+
+	t.Run("Should complete the names of the commands", func(t *testing.T) {
+		// Act
+		_, stdout, _, _ := runCLI(t, "__complete", "logs", "-f", "w")
+
+		// Assert
+		assert.Equal(t, "worker\n", stdout)
+	})
+
+	t.Run("Should complete the names of the command groups", func(t *testing.T) {
+		// Act
+		_, stdout, _, _ := runCLI(t, "__complete", "--pid", "123", "group", "run", "")
+
+		// Assert
+		assert.Equal(t, "Backend\n", stdout)
+	})
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// completeCommand is called by the completion scripts with the words typed so far, the last one being the word
+// being completed, and prints the candidates one per line
+const completeCommand = "__complete"
+
+var completionScripts = map[string]string{
+	"bash": `_gomanderctl() {
+    local IFS=$'\n'
+    COMPREPLY=($(gomanderctl __complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null | while read -r candidate; do printf '%q\n' "$candidate"; done))
+}
+complete -F _gomanderctl gomanderctl
+`,
+	"zsh": `#compdef gomanderctl
+_gomanderctl() {
+    local -a candidates
+    candidates=("${(@f)$(gomanderctl __complete "${(@)words[2,CURRENT]}" 2>/dev/null)}")
+    compadd -a candidates
+}
+compdef _gomanderctl gomanderctl
+`,
+	"fish": `complete -c gomanderctl -f -a '(gomanderctl __complete (commandline -opc)[2..-1] (commandline -ct) 2>/dev/null)'
+`,
+}
+
+var (
+	commandNames      = []string{"ls", "run", "stop", "restart", "adopt", "group", "logs", "status", "completion"}
+	groupCommandNames = []string{"ls", "run", "stop", "restart"}
+	shellNames        = []string{"bash", "fish", "zsh"}
+)
+
+func (c *CLI) completion(args []string) error {
+	shells, err := parse(newFlagSet("completion"), args, 1, 1)
+	if err != nil {
+		return err
+	}
+
+	script, ok := completionScripts[shells[0]]
+	if !ok {
+		return usageError("unsupported shell %q, use one of: %s", shells[0], strings.Join(shellNames, ", "))
+	}
+
+	_, err = fmt.Fprint(c.Stdout, script)
+	return err
+}
+
+// complete never fails, the shell just gets no candidates
+func (c *CLI) complete(words []string) {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	previous, current := words[:len(words)-1], words[len(words)-1]
+
+	// The instance flag goes before the command
+	if len(previous) >= 2 && (previous[0] == "--pid" || previous[0] == "-pid") {
+		_, _ = fmt.Sscan(previous[1], &c.pid)
+		previous = previous[2:]
+	}
+
+	for _, candidate := range c.candidates(previous, current) {
+		if strings.HasPrefix(candidate, current) {
+			_, _ = fmt.Fprintln(c.Stdout, candidate)
+		}
+	}
+}
+
+func (c *CLI) candidates(previous []string, current string) []string {
+	if len(previous) == 0 {
+		return commandNames
+	}
+	if strings.HasPrefix(current, "-") {
+		return nil
+	}
+
+	switch previous[0] {
+	case "run", "stop", "restart", "adopt", "logs":
+		return c.commandCandidates()
+	case "group":
+		if len(previous) == 1 {
+			return groupCommandNames
+		}
+		if len(previous) == 2 && (previous[1] == "run" || previous[1] == "stop" || previous[1] == "restart") {
+			return c.commandGroupCandidates()
+		}
+	case "completion":
+		if len(previous) == 1 {
+			return shellNames
+		}
+	}
+
+	return nil
+}
+
+func (c *CLI) commandCandidates() []string {
+	api, err := c.Connect(c.pid)
+	if err != nil {
+		return nil
+	}
+
+	commands, err := api.GetCommands()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(commands))
+	for _, command := range commands {
+		if !slices.Contains(names, command.Name) {
+			names = append(names, command.Name)
+		}
+	}
+	return names
+}
+
+func (c *CLI) commandGroupCandidates() []string {
+	api, err := c.Connect(c.pid)
+	if err != nil {
+		return nil
+	}
+
+	groups, err := api.GetCommandGroups()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		names = append(names, group.Name)
+	}
+	return names
+}
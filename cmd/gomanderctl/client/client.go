@@ -0,0 +1,306 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"gomander/internal/discovery"
+)
+
+// APIVersion is the version of the integrations API this client speaks
+const APIVersion = "v1"
+
+const basePath = "/api/" + APIVersion
+
+// socketHost is only used to build the request URLs, the connection goes through the socket
+const socketHost = "gomander"
+
+var ErrNoInstance = errors.New("gomander is not running")
+
+// APIError is an error response of the integrations API
+type APIError struct {
+	Status  int
+	Code    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+type Discovery struct {
+	App          string   `json:"app"`
+	Version      string   `json:"version"`
+	APIVersion   string   `json:"apiVersion"`
+	Capabilities []string `json:"capabilities"`
+}
+
+type Project struct {
+	Id               string `json:"id"`
+	Name             string `json:"name"`
+	WorkingDirectory string `json:"workingDirectory"`
+}
+
+type Command struct {
+	Id         string     `json:"id"`
+	Name       string     `json:"name"`
+	Status     string     `json:"status"`
+	ExitCode   *int       `json:"exitCode,omitempty"`
+	StartedAt  *time.Time `json:"startedAt,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	DurationMs int64      `json:"durationMs"`
+}
+
+type CommandGroup struct {
+	Id              string `json:"id"`
+	Name            string `json:"name"`
+	Mode            string `json:"mode"`
+	Commands        int    `json:"commands"`
+	RunningCommands int    `json:"runningCommands"`
+}
+
+// LogEntry is a line of the output of a command. Raw entries are chunks of a terminal, without a trailing newline.
+type LogEntry struct {
+	Line string
+	Raw  bool
+}
+
+// Client talks to the integrations API of a running Gomander
+type Client struct {
+	baseUrl    string
+	token      string
+	httpClient *http.Client
+	// Address describes where the client connects to
+	Address string
+}
+
+func NewClient(baseUrl string, token string, httpClient *http.Client) *Client {
+	return &Client{
+		baseUrl:    strings.TrimSuffix(baseUrl, "/"),
+		token:      token,
+		httpClient: httpClient,
+		Address:    baseUrl,
+	}
+}
+
+// NewSocketClient connects through the Unix socket of the instance, which doesn't need the token
+func NewSocketClient(socketPath string) *Client {
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	c := NewClient("http://"+socketHost, "", httpClient)
+	c.Address = "unix://" + socketPath
+	return c
+}
+
+// Connect finds a running instance in the discovery directory. The most recently started one is used,
+// unless a pid is given. The socket is preferred over TCP when the instance serves one.
+func Connect(directory string, pid int) (*Client, error) {
+	instances, err := discovery.List(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, instance := range instances {
+		if pid != 0 && instance.Pid != pid {
+			continue
+		}
+
+		if instance.Socket != "" {
+			return NewSocketClient(instance.Socket), nil
+		}
+		return NewClient(fmt.Sprintf("http://127.0.0.1:%d", instance.Port), instance.Token, &http.Client{}), nil
+	}
+
+	if pid != 0 {
+		return nil, fmt.Errorf("%w with pid %d", ErrNoInstance, pid)
+	}
+	return nil, ErrNoInstance
+}
+
+func (c *Client) Discovery() (*Discovery, error) {
+	var result Discovery
+	err := c.do(http.MethodGet, "/discovery", &result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCurrentProject returns nil when no project is open
+func (c *Client) GetCurrentProject() (*Project, error) {
+	var result Project
+	err := c.do(http.MethodGet, basePath+"/projects/current", &result)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.Code == "no_project_open" {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (c *Client) GetCommands() ([]Command, error) {
+	result := make([]Command, 0)
+	err := c.do(http.MethodGet, basePath+"/commands", &result)
+	return result, err
+}
+
+func (c *Client) GetCommandGroups() ([]CommandGroup, error) {
+	result := make([]CommandGroup, 0)
+	err := c.do(http.MethodGet, basePath+"/command-groups", &result)
+	return result, err
+}
+
+func (c *Client) RunCommand(id string) error {
+	return c.do(http.MethodPost, basePath+"/commands/"+url.PathEscape(id)+"/run", nil)
+}
+
+func (c *Client) StopCommand(id string) error {
+	return c.do(http.MethodPost, basePath+"/commands/"+url.PathEscape(id)+"/stop", nil)
+}
+
+func (c *Client) RestartCommand(id string) error {
+	return c.do(http.MethodPost, basePath+"/commands/"+url.PathEscape(id)+"/restart", nil)
+}
+
+func (c *Client) AdoptOrphanedProcess(id string) error {
+	return c.do(http.MethodPost, basePath+"/commands/"+url.PathEscape(id)+"/adopt", nil)
+}
+
+func (c *Client) RunCommandGroup(id string) error {
+	return c.do(http.MethodPost, basePath+"/command-groups/"+url.PathEscape(id)+"/run", nil)
+}
+
+func (c *Client) StopCommandGroup(id string) error {
+	return c.do(http.MethodPost, basePath+"/command-groups/"+url.PathEscape(id)+"/stop", nil)
+}
+
+func (c *Client) RestartCommandGroup(id string) error {
+	return c.do(http.MethodPost, basePath+"/command-groups/"+url.PathEscape(id)+"/restart", nil)
+}
+
+// StreamLogs sends the last lines of the command output to onEntry and, when following, the new ones
+// until the context is done.
+func (c *Client) StreamLogs(ctx context.Context, id string, tail int, follow bool, onEntry func(LogEntry)) error {
+	query := url.Values{}
+	query.Set("tail", strconv.Itoa(tail))
+	query.Set("follow", strconv.FormatBool(follow))
+
+	resp, err := c.request(ctx, http.MethodGet, basePath+"/commands/"+url.PathEscape(id)+"/logs/stream?"+query.Encode())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	err = readServerSentEvents(resp.Body, func(name string, data string) {
+		if name != "new_log_entry" {
+			return
+		}
+
+		var payload struct {
+			Line string `json:"line"`
+			Raw  string `json:"raw"`
+		}
+		if json.Unmarshal([]byte(data), &payload) == nil {
+			onEntry(LogEntry{Line: payload.Line, Raw: payload.Raw == "true"})
+		}
+	})
+	if ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
+
+func (c *Client) do(method string, path string, result interface{}) error {
+	resp, err := c.request(context.Background(), method, path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// request sends the request and turns the error responses into an APIError
+func (c *Client) request(ctx context.Context, method string, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseUrl+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		return nil, readError(resp)
+	}
+
+	return resp, nil
+}
+
+func readError(resp *http.Response) error {
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	apiErr := &APIError{Status: resp.StatusCode, Message: resp.Status}
+	if json.NewDecoder(resp.Body).Decode(&body) == nil && body.Error.Message != "" {
+		apiErr.Code = body.Error.Code
+		apiErr.Message = body.Error.Message
+	}
+	return apiErr
+}
+
+// readServerSentEvents calls onEvent with the name and the data of each event of the stream
+func readServerSentEvents(body io.Reader, onEvent func(name string, data string)) error {
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var name string
+	var data []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if len(data) > 0 {
+				onEvent(name, strings.Join(data, "\n"))
+			}
+			name, data = "", nil
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,154 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/cmd/gomanderctl/client"
+	"gomander/internal/discovery"
+)
+
+func TestClient_GetCommands(t *testing.T) {
+	t.Run("Should send the token and decode the commands", func(t *testing.T) {
+		// Arrange
+		var authorization string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authorization = r.Header.Get("Authorization")
+			assert.Equal(t, "/api/v1/commands", r.URL.Path)
+			_, _ = w.Write([]byte(`[{"id":"cmd-1","name":"api","status":"running"}]`))
+		}))
+		defer server.Close()
+
+		c := client.NewClient(server.URL, "token", server.Client())
+
+		// Act
+		commands, err := c.GetCommands()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer token", authorization)
+		assert.Equal(t, []client.Command{{Id: "cmd-1", Name: "api", Status: "running"}}, commands)
+	})
+
+	t.Run("Should return the API errors", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"error":{"code":"no_project_open","message":"No project is open"}}`))
+		}))
+		defer server.Close()
+
+		c := client.NewClient(server.URL, "token", server.Client())
+
+		// Act
+		_, err := c.GetCommands()
+
+		// Assert
+		var apiErr *client.APIError
+		assert.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusConflict, apiErr.Status)
+		assert.Equal(t, "no_project_open", apiErr.Code)
+		assert.Equal(t, "No project is open", apiErr.Message)
+	})
+}
+
+func TestClient_GetCurrentProject(t *testing.T) {
+	t.Run("Should return nil when no project is open", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"no_project_open","message":"No project is open"}}`))
+		}))
+		defer server.Close()
+
+		c := client.NewClient(server.URL, "", server.Client())
+
+		// Act
+		project, err := c.GetCurrentProject()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, project)
+	})
+}
+
+func TestClient_StreamLogs(t *testing.T) {
+	t.Run("Should send the log entries of the stream", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v1/commands/cmd-1/logs/stream", r.URL.Path)
+			assert.Equal(t, "5", r.URL.Query().Get("tail"))
+			assert.Equal(t, "false", r.URL.Query().Get("follow"))
+
+			w.Header().Set("Content-Type", "text/event-stream")
+			_, _ = fmt.Fprint(w, "event: new_log_entry\ndata: {\"id\":\"cmd-1\",\"line\":\"a\"}\n\n")
+			_, _ = fmt.Fprint(w, "event: process_started\ndata: \"cmd-1\"\n\n")
+			_, _ = fmt.Fprint(w, "event: new_log_entry\ndata: {\"id\":\"cmd-1\",\"line\":\"b\",\"raw\":\"true\"}\n\n")
+		}))
+		defer server.Close()
+
+		c := client.NewClient(server.URL, "", server.Client())
+
+		// Act
+		entries := make([]client.LogEntry, 0)
+		err := c.StreamLogs(context.Background(), "cmd-1", 5, false, func(entry client.LogEntry) {
+			entries = append(entries, entry)
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []client.LogEntry{{Line: "a"}, {Line: "b", Raw: true}}, entries)
+	})
+}
+
+func TestConnect(t *testing.T) {
+	t.Run("Should connect to the instance of the discovery file", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+			_, _ = w.Write([]byte(`{"app":"Gomander","version":"1.4.0","apiVersion":"v1"}`))
+		}))
+		defer server.Close()
+
+		directory := t.TempDir()
+		port := server.Listener.Addr().(*net.TCPAddr).Port
+		assert.NoError(t, discovery.Write(directory, discovery.Instance{Pid: os.Getpid(), Port: port, Token: "token"}))
+
+		// Act
+		c, err := client.Connect(directory, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		result, err := c.Discovery()
+		assert.NoError(t, err)
+		assert.Equal(t, "1.4.0", result.Version)
+	})
+
+	t.Run("Should fail when no instance is running", func(t *testing.T) {
+		// Act
+		_, err := client.Connect(t.TempDir(), 0)
+
+		// Assert
+		assert.ErrorIs(t, err, client.ErrNoInstance)
+	})
+
+	t.Run("Should fail when the instance with the pid is not running", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		assert.NoError(t, discovery.Write(directory, discovery.Instance{Pid: os.Getpid(), Port: 9002}))
+
+		// Act
+		_, err := client.Connect(directory, os.Getpid()+1)
+
+		// Assert
+		assert.ErrorIs(t, err, client.ErrNoInstance)
+	})
+}
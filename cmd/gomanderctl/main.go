@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+
+	"gomander/cmd/gomanderctl/cli"
+	"gomander/cmd/gomanderctl/client"
+)
+
+// configFolderPathName is the folder of the user config dir the app writes its discovery files to
+const configFolderPathName = "gomander"
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	c := cli.NewCLI(os.Stdout, os.Stderr, connect)
+	code := c.Run(ctx, os.Args[1:])
+
+	stop()
+	os.Exit(code)
+}
+
+// connect uses the address of the environment when set, for instances that don't run on this machine,
+// or else finds the instance in the discovery files
+func connect(pid int) (*client.Client, error) {
+	if url := os.Getenv("GOMANDER_URL"); url != "" {
+		return client.NewClient(url, os.Getenv("GOMANDER_TOKEN"), &http.Client{}), nil
+	}
+
+	userConfig, err := os.UserConfigDir()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Connect(filepath.Join(userConfig, configFolderPathName, "run"), pid)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"gomander/cmd/gomander/locales"
+	"gomander/cmd/gomander/thirdpartyserver"
+	internalapp "gomander/internal/app"
+	"gomander/internal/bootstrap"
+	"gomander/internal/discovery"
+	"gomander/internal/event"
+	"gomander/internal/facade"
+	"gomander/internal/logger"
+	"gomander/internal/runner"
+	"gomander/internal/supervisor"
+)
+
+// gomanderd runs Gomander without a window, serving only the integrations API.
+// It shares the database and the configuration with the desktop app, so both shouldn't run at the same time.
+// The commands run in the supervisor when there's one, so they aren't run twice next to it.
+func main() {
+	lanAccess := flag.Bool("lan", false, "listen on all interfaces, even if the integrationsLanAccess setting is off")
+	debug := flag.Bool("debug", false, "write the debug messages")
+	flag.Parse()
+
+	l := logger.NewConsoleLogger(os.Stderr, *debug)
+
+	// The app keeps its own context, so the commands can still be stopped once a signal is received
+	ctx := context.Background()
+	signalCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	app := internalapp.NewApp()
+
+	gormDb := bootstrap.ConfigDB(ctx, bootstrap.DbFile())
+	depsOptions := bootstrap.Options{
+		Logger:        l,
+		EventEmitter:  event.DiscardEventEmitter{},
+		RuntimeFacade: facade.HeadlessRuntimeFacade{},
+		LocaleFs:      locales.Fs,
+	}
+
+	detachedProcesses := bootstrap.DetachedProcesses(gormDb, ctx)
+	// Only the desktop app starts the supervisor, gomanderd uses it if it's already running
+	if client, err := supervisor.Dial(bootstrap.SupervisorSocketPath()); err == nil {
+		depsOptions.KeepCommandsOnClose = detachedProcesses
+		depsOptions.Runner = func(emitter event.EventEmitter) runner.Runner {
+			remoteRunner := supervisor.NewRemoteRunner(client, emitter, l)
+			go remoteRunner.Relay(ctx)
+			return remoteRunner
+		}
+	} else if detachedProcesses {
+		l.Info("Running the commands in gomanderd, the supervisor is not running")
+	}
+
+	bootstrap.RegisterDeps(gormDb, ctx, app, depsOptions)
+	app.RegisterHandlers()
+	app.Startup(ctx)
+
+	access, err := app.UseCases.GetIntegrationsAccess.Execute()
+	if err != nil {
+		l.Error(err.Error())
+		os.Exit(1)
+	}
+
+	server := thirdpartyserver.NewThirdPartyIntegrationsServer(app.UseCases, thirdpartyserver.Options{
+		Token:              access.Token,
+		LanAccess:          access.LanAccess || *lanAccess,
+		DiscoveryDirectory: bootstrap.DiscoveryFolderPath(),
+		SocketPath:         discovery.SocketPath(bootstrap.DiscoveryFolderPath(), os.Getpid()),
+		Logger:             l,
+	})
+
+	err = server.RegisterHandlers()
+	if err == nil {
+		err = server.Start()
+	}
+	if err != nil {
+		l.Error(err.Error())
+		os.Exit(1)
+	}
+
+	l.Info(fmt.Sprintf("Integrations API listening on %s", server.Server.Addr))
+
+	<-signalCtx.Done()
+	l.Info("Stopping the running commands...")
+
+	exitCode := 0
+	// Errors are already logged by the app
+	if app.OnBeforeClose(ctx) {
+		exitCode = 1
+	}
+
+	err = server.Stop()
+	if err != nil {
+		l.Error(err.Error())
+		exitCode = 1
+	}
+
+	stop()
+	os.Exit(exitCode)
+}
@@ -9,12 +9,14 @@ import (
 	commandgrouphandlers "gomander/internal/commandgroup/application/handlers"
 	commandgroupusecases "gomander/internal/commandgroup/application/usecases"
 	commandgroupdomain "gomander/internal/commandgroup/domain"
+	commandrunhandlers "gomander/internal/commandrun/application/handlers"
+	commandrunusecases "gomander/internal/commandrun/application/usecases"
 	configusecases "gomander/internal/config/application/usecases"
 	configdomain "gomander/internal/config/domain"
 	"gomander/internal/event"
-	localizationusecases "gomander/internal/localization/application/usecases"
 	"gomander/internal/eventbus"
 	"gomander/internal/facade"
+	localizationusecases "gomander/internal/localization/application/usecases"
 	"gomander/internal/logger"
 	projectusecases "gomander/internal/project/application/usecases"
 	projectdomain "gomander/internal/project/domain"
@@ -26,12 +28,14 @@ type EventHandlers struct {
 	CleanCommandGroupsOnProjectDeleted   commandgrouphandlers.CleanCommandGroupsOnProjectDeleted
 	CleanCommandsOnProjectDeleted        commandhandlers.CleanCommandsOnProjectDeleted
 	AddCommandToGroupOnCommandDuplicated commandgrouphandlers.AddCommandToGroupOnCommandDuplicated
+	CleanCommandRunsOnCommandDeleted     commandrunhandlers.CleanCommandRunsOnCommandDeleted
 }
 
 type UseCases struct {
 	// Configuration
-	GetUserConfig  configusecases.GetUserConfig
-	SaveUserConfig configusecases.SaveUserConfig
+	GetUserConfig         configusecases.GetUserConfig
+	SaveUserConfig        configusecases.SaveUserConfig
+	GetIntegrationsAccess configusecases.GetIntegrationsAccess
 	// Localization
 	GetTranslation        localizationusecases.GetTranslation
 	GetSupportedLanguages localizationusecases.GetSupportedLanguages
@@ -55,16 +59,28 @@ type UseCases struct {
 	ReorderCommandGroups          commandgroupusecases.ReorderCommandGroups
 	RunCommandGroup               commandgroupusecases.RunCommandGroup
 	StopCommandGroup              commandgroupusecases.StopCommandGroup
+	RestartCommandGroup           commandgroupusecases.RestartCommandGroup
+	GetPipelineStates             commandgroupusecases.GetPipelineStates
 	// Commands
-	GetCommands          commandusecases.GetCommands
-	AddCommand           commandusecases.AddCommand
-	DuplicateCommand     commandusecases.DuplicateCommand
-	RemoveCommand        commandusecases.RemoveCommand
-	EditCommand          commandusecases.EditCommand
-	ReorderCommands      commandusecases.ReorderCommands
-	RunCommand           commandusecases.RunCommand
-	StopCommand          commandusecases.StopCommand
-	GetRunningCommandIds commandusecases.GetRunningCommandIds
+	GetCommands           commandusecases.GetCommands
+	AddCommand            commandusecases.AddCommand
+	DuplicateCommand      commandusecases.DuplicateCommand
+	RemoveCommand         commandusecases.RemoveCommand
+	EditCommand           commandusecases.EditCommand
+	ReorderCommands       commandusecases.ReorderCommands
+	RunCommand            commandusecases.RunCommand
+	StopCommand           commandusecases.StopCommand
+	RestartCommand        commandusecases.RestartCommand
+	GetCommandRunStates   commandusecases.GetCommandRunStates
+	ResizeCommandTerminal commandusecases.ResizeCommandTerminal
+	WriteToCommand        commandusecases.WriteToCommand
+	GetCommandLogRuns     commandusecases.GetCommandLogRuns
+	GetCommandLogs        commandusecases.GetCommandLogs
+	StreamCommandLogs     commandusecases.StreamCommandLogs
+	AdoptOrphanedProcess  commandusecases.AdoptOrphanedProcess
+	// Command runs
+	GetCommandRuns          commandrunusecases.GetCommandRuns
+	DetectOrphanedProcesses commandrunusecases.DetectOrphanedProcesses
 }
 
 // App struct
@@ -74,6 +90,8 @@ type App struct {
 	logger        logger.Logger
 	eventEmitter  event.EventEmitter
 	commandRunner runner.Runner
+	// keepCommandsOnClose is set when the commands outlive the app, running in the supervisor
+	keepCommandsOnClose bool
 
 	commandRepository      commanddomain.Repository
 	commandGroupRepository commandgroupdomain.Repository
@@ -94,6 +112,8 @@ type Dependencies struct {
 	EventEmitter event.EventEmitter
 	Runner       runner.Runner
 
+	KeepCommandsOnClose bool
+
 	CommandRepository      commanddomain.Repository
 	CommandGroupRepository commandgroupdomain.Repository
 	ProjectRepository      projectdomain.Repository
@@ -112,6 +132,7 @@ func (a *App) LoadDependencies(d Dependencies) {
 	a.logger = d.Logger
 	a.eventEmitter = d.EventEmitter
 	a.commandRunner = d.Runner
+	a.keepCommandsOnClose = d.KeepCommandsOnClose
 
 	a.commandRepository = d.CommandRepository
 	a.commandGroupRepository = d.CommandGroupRepository
@@ -132,6 +153,7 @@ func (a *App) RegisterHandlers() {
 	a.eventBus.RegisterHandler(a.eventHandlers.CleanCommandGroupsOnProjectDeleted)
 	a.eventBus.RegisterHandler(a.eventHandlers.CleanCommandsOnProjectDeleted)
 	a.eventBus.RegisterHandler(a.eventHandlers.AddCommandToGroupOnCommandDuplicated)
+	a.eventBus.RegisterHandler(a.eventHandlers.CleanCommandRunsOnCommandDeleted)
 }
 
 // NewApp creates a new App application struct
@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"fmt"
 )
 
 // Startup is called when the app starts. The context is saved
@@ -17,9 +18,21 @@ func (a *App) Startup(ctx context.Context) {
 	}
 
 	a.logger.Info("Configuration loaded successfully")
+
+	// Processes left running by a crash are shown as orphaned, so they can be adopted or killed
+	orphans, err := a.UseCases.DetectOrphanedProcesses.Execute()
+	if err != nil {
+		a.logger.Error("[ERROR - Detecting orphaned processes]: " + err.Error())
+	} else if len(orphans) > 0 {
+		a.logger.Info(fmt.Sprintf("Orphaned processes found: %d", len(orphans)))
+	}
 }
 
 func (a *App) OnBeforeClose(_ context.Context) (prevent bool) {
+	if a.keepCommandsOnClose {
+		return false // The commands keep running in the supervisor
+	}
+
 	errs := a.commandRunner.StopAllRunningCommands()
 
 	if len(errs) > 0 {
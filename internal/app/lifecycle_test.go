@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"gomander/internal/app"
+	test5 "gomander/internal/commandrun/application/usecases/test"
+	commandrundomain "gomander/internal/commandrun/domain"
 	"gomander/internal/config/domain"
 	test2 "gomander/internal/config/domain/test"
 	test4 "gomander/internal/logger/test"
@@ -24,22 +26,85 @@ func TestApp_Startup(t *testing.T) {
 		mockLogger := new(test4.MockLogger)
 		mockUserConfigRepository := new(test2.MockConfigRepository)
 		mockProjectRepository := new(test.MockProjectRepository)
+		mockDetectOrphanedProcesses := new(test5.MockDetectOrphanedProcesses)
 
 		a.LoadDependencies(app.Dependencies{
 			Logger:            mockLogger,
 			ConfigRepository:  mockUserConfigRepository,
 			ProjectRepository: mockProjectRepository,
+			UseCases: app.UseCases{
+				DetectOrphanedProcesses: mockDetectOrphanedProcesses,
+			},
 		})
 
 		mockLogger.On("Info", mock.Anything).Return()
 		mockUserConfigRepository.On("GetOrCreate").Return(&domain.Config{LastOpenedProjectId: "123"}, nil)
+		mockDetectOrphanedProcesses.On("Execute").Return([]commandrundomain.CommandRun{}, nil)
 
 		// Act & Assert
 		assert.NotPanics(t, func() {
 			a.Startup(ctx)
 		})
 
-		mock.AssertExpectationsForObjects(t, mockUserConfigRepository, mockLogger)
+		mock.AssertExpectationsForObjects(t, mockUserConfigRepository, mockLogger, mockDetectOrphanedProcesses)
+	})
+
+	t.Run("Should report the orphaned processes", func(t *testing.T) {
+		// Arrange
+		a := app.NewApp()
+		ctx := context.Background()
+
+		mockLogger := new(test4.MockLogger)
+		mockUserConfigRepository := new(test2.MockConfigRepository)
+		mockDetectOrphanedProcesses := new(test5.MockDetectOrphanedProcesses)
+
+		a.LoadDependencies(app.Dependencies{
+			Logger:           mockLogger,
+			ConfigRepository: mockUserConfigRepository,
+			UseCases: app.UseCases{
+				DetectOrphanedProcesses: mockDetectOrphanedProcesses,
+			},
+		})
+
+		mockLogger.On("Info", mock.Anything).Return()
+		mockUserConfigRepository.On("GetOrCreate").Return(&domain.Config{}, nil)
+		mockDetectOrphanedProcesses.On("Execute").Return([]commandrundomain.CommandRun{{Id: "run-1", CommandId: "api", Pid: 4200}}, nil)
+
+		// Act
+		a.Startup(ctx)
+
+		// Assert
+		mockLogger.AssertCalled(t, "Info", "Orphaned processes found: 1")
+	})
+
+	t.Run("Should start even if the orphaned processes can't be detected", func(t *testing.T) {
+		// Arrange
+		a := app.NewApp()
+		ctx := context.Background()
+
+		mockLogger := new(test4.MockLogger)
+		mockUserConfigRepository := new(test2.MockConfigRepository)
+		mockDetectOrphanedProcesses := new(test5.MockDetectOrphanedProcesses)
+
+		a.LoadDependencies(app.Dependencies{
+			Logger:           mockLogger,
+			ConfigRepository: mockUserConfigRepository,
+			UseCases: app.UseCases{
+				DetectOrphanedProcesses: mockDetectOrphanedProcesses,
+			},
+		})
+
+		mockLogger.On("Info", mock.Anything).Return()
+		mockLogger.On("Error", mock.Anything).Return()
+		mockUserConfigRepository.On("GetOrCreate").Return(&domain.Config{}, nil)
+		mockDetectOrphanedProcesses.On("Execute").Return([]commandrundomain.CommandRun(nil), assert.AnError)
+
+		// Act & Assert
+		assert.NotPanics(t, func() {
+			a.Startup(ctx)
+		})
+
+		mock.AssertExpectationsForObjects(t, mockLogger, mockDetectOrphanedProcesses)
 	})
 
 	t.Run("Should panic if configuration loading fails", func(t *testing.T) {
@@ -115,4 +180,25 @@ func TestApp_OnBeforeClose(t *testing.T) {
 
 		mock.AssertExpectationsForObjects(t, mockCommandRunner, mockLogger)
 	})
+
+	t.Run("Should not stop the commands when they keep running in the supervisor", func(t *testing.T) {
+		// Arrange
+		a := app.NewApp()
+
+		mockCommandRunner := new(test3.MockRunner)
+		mockLogger := new(test4.MockLogger)
+
+		a.LoadDependencies(app.Dependencies{
+			Runner:              mockCommandRunner,
+			Logger:              mockLogger,
+			KeepCommandsOnClose: true,
+		})
+
+		// Act
+		prevent := a.OnBeforeClose(context.Background())
+
+		// Assert
+		assert.False(t, prevent)
+		mockCommandRunner.AssertNotCalled(t, "StopAllRunningCommands")
+	})
 }
@@ -0,0 +1,300 @@
+package bootstrap
+
+import (
+	"context"
+	"embed"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/glebarez/sqlite"
+	"github.com/pressly/goose/v3"
+	"gorm.io/gorm"
+
+	gormlogger "gorm.io/gorm/logger"
+
+	internalapp "gomander/internal/app"
+	"gomander/internal/command/application/handlers"
+	commandusecases "gomander/internal/command/application/usecases"
+	commmandinfrastructure "gomander/internal/command/infrastructure"
+	commandgrouphandlers "gomander/internal/commandgroup/application/handlers"
+	commandgroupusecases "gomander/internal/commandgroup/application/usecases"
+	commandgroupinfrastructure "gomander/internal/commandgroup/infrastructure"
+	commandrunhandlers "gomander/internal/commandrun/application/handlers"
+	commandrunusecases "gomander/internal/commandrun/application/usecases"
+	commandruninfrastructure "gomander/internal/commandrun/infrastructure"
+	configusecases "gomander/internal/config/application/usecases"
+	configinfrastructure "gomander/internal/config/infrastructure"
+	"gomander/internal/event"
+	"gomander/internal/eventbus"
+	"gomander/internal/facade"
+	localizationusecases "gomander/internal/localization/application/usecases"
+	"gomander/internal/logger"
+	"gomander/internal/logstore"
+	projectusecases "gomander/internal/project/application/usecases"
+	projectinfrastructure "gomander/internal/project/infrastructure"
+	"gomander/internal/runner"
+	"gomander/internal/supervisor"
+	_ "gomander/migrations"
+)
+
+const ConfigFolderPathName = "gomander"
+
+// Options holds what differs between the window and the headless entrypoints
+type Options struct {
+	Logger logger.Logger
+	// EventEmitter sends the events to the frontend. They are broadcast to the integrations server subscribers too.
+	EventEmitter  event.EventEmitter
+	RuntimeFacade facade.RuntimeFacade
+	// LocaleFs holds the translations of the frontend
+	LocaleFs fs.FS
+	// Runner builds the runner of the commands from the events emitter. They run in this process when nil.
+	Runner func(emitter event.EventEmitter) runner.Runner
+	// KeepCommandsOnClose leaves the commands running when the app is closed, for a runner they outlive
+	KeepCommandsOnClose bool
+}
+
+// ConfigDB opens the database and runs the pending migrations
+func ConfigDB(ctx context.Context, dbFile string) *gorm.DB {
+	gormDb, err := gorm.Open(sqlite.Open(dbFile+"?cache=shared&_pragma=busy_timeout(5000)"), &gorm.Config{
+		// Uncomment when debugging
+		// Logger: gormlogger.Default.LogMode(gormlogger.Info),
+		Logger: gormlogger.Default.LogMode(gormlogger.Error),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	db, err := gormDb.DB()
+
+	if err != nil {
+		panic(err)
+	}
+
+	if db == nil {
+		panic("db is nil")
+	}
+
+	db.SetMaxOpenConns(1)
+
+	// Execute migrations
+	err = goose.SetDialect("sqlite3")
+	if err != nil {
+		panic(err)
+	}
+
+	goose.SetBaseFS(embed.FS{})
+
+	err = goose.UpContext(ctx, db, ".")
+	if err != nil {
+		panic(err)
+	}
+	return gormDb
+}
+
+// ConfigFolderPath returns the folder of the user config dir holding the database, the logs and the discovery files.
+// It's created if needed.
+func ConfigFolderPath() string {
+	userConfig, err := os.UserConfigDir()
+
+	if err != nil {
+		panic(err)
+	}
+
+	configFolderPath := filepath.Join(userConfig, ConfigFolderPathName)
+	err = os.MkdirAll(configFolderPath, os.ModePerm)
+	if err != nil {
+		panic(err)
+	}
+
+	return configFolderPath
+}
+
+func DbFile() string {
+	return filepath.Join(ConfigFolderPath(), "data.db")
+}
+
+// LogsFolderPath returns the folder where the output of the commands is persisted, next to the database.
+// Folders are created on demand by the log store.
+func LogsFolderPath() string {
+	return filepath.Join(ConfigFolderPath(), "logs")
+}
+
+// DiscoveryFolderPath returns the folder where each running instance publishes how to reach its
+// integrations server.
+func DiscoveryFolderPath() string {
+	return filepath.Join(ConfigFolderPath(), "run")
+}
+
+// SupervisorSocketPath returns the socket of the supervisor running the detached commands
+func SupervisorSocketPath() string {
+	return supervisor.SocketPath(DiscoveryFolderPath())
+}
+
+// DetachedProcesses tells whether the user wants the commands to run in the supervisor
+func DetachedProcesses(gormDb *gorm.DB, ctx context.Context) bool {
+	config, err := configinfrastructure.NewGormConfigRepository(gormDb, ctx).GetOrCreate()
+	return err == nil && config.DetachedProcesses
+}
+
+// RegisterDeps builds the dependencies of the app, the same ones whether it has a window or not
+func RegisterDeps(gormDb *gorm.DB, ctx context.Context, app *internalapp.App, options Options) {
+	// Initialize deps
+	l := options.Logger
+	// Events are also broadcast to the subscribers of the 3rd party integrations server
+	ee := event.NewBroadcaster(options.EventEmitter)
+	ls := logstore.NewDefaultLogStore(LogsFolderPath())
+
+	// Initialize repos
+	commandRepo := commmandinfrastructure.NewGormCommandRepository(gormDb, ctx)
+	commandGroupRepo := commandgroupinfrastructure.NewGormCommandGroupRepository(gormDb, ctx)
+	projectRepo := projectinfrastructure.NewGormProjectRepository(gormDb, ctx)
+	configRepo := configinfrastructure.NewGormConfigRepository(gormDb, ctx)
+	commandRunRepo := commandruninfrastructure.NewGormCommandRunRepository(gormDb, ctx)
+
+	var r runner.Runner
+	if options.Runner != nil {
+		r = options.Runner(ee)
+	} else {
+		r = runner.NewDefaultRunner(l, ee, ls, commandRunRepo)
+	}
+
+	// Initialize event handlers
+	cleanCommandGroupsOnCommandDeleted := commandgrouphandlers.NewCleanCommandGroupsOnCommandDeleted(commandGroupRepo, ee)
+	cleanCommandGroupsOnProjectDeleted := commandgrouphandlers.NewCleanCommandGroupsOnProjectDeleted(commandGroupRepo, ee)
+	cleanCommandsOnProjectDeleted := handlers.NewCleanCommandOnProjectDeleted(commandRepo)
+	addCommandToGroupOnCommandDuplicated := commandgrouphandlers.NewAddCommandToGroupOnCommandDuplicated(commandRepo, commandGroupRepo)
+	cleanCommandRunsOnCommandDeleted := commandrunhandlers.NewCleanCommandRunsOnCommandDeleted(commandRunRepo)
+
+	// Initialize event bus
+	eventBus := eventbus.NewInMemoryEventBus()
+
+	// Initialize use cases
+
+	// Configuration
+	getUserConfig := configusecases.NewGetUserConfig(configRepo)
+	saveUserConfig := configusecases.NewSaveUserConfig(configRepo)
+	getIntegrationsAccess := configusecases.NewGetIntegrationsAccess(configRepo)
+	// Localization
+	getTranslation := localizationusecases.NewGetTranslation(options.LocaleFs)
+	getSupportedLanguages := localizationusecases.NewGetSupportedLanguages(options.LocaleFs)
+	// Projects
+	getCurrentProject := projectusecases.NewGetCurrentProject(configRepo, projectRepo)
+	getAvailableProjects := projectusecases.NewGetAvailableProjects(projectRepo)
+	openProject := projectusecases.NewOpenProject(configRepo, projectRepo)
+	createProject := projectusecases.NewCreateProject(projectRepo)
+	editProject := projectusecases.NewEditProject(projectRepo)
+	closeProject := projectusecases.NewCloseProject(configRepo)
+	deleteProject := projectusecases.NewDeleteProject(projectRepo, eventBus, l)
+	exportProject := projectusecases.NewExportProject(ctx, projectRepo, commandRepo, commandGroupRepo, options.RuntimeFacade, facade.DefaultFsFacade{})
+	importProject := projectusecases.NewImportProject(projectRepo, commandRepo, commandGroupRepo)
+	getProjectToImport := projectusecases.NewGetProjectToImport(ctx, options.RuntimeFacade, facade.DefaultFsFacade{})
+	// Command Groups
+	getCommandGroups := commandgroupusecases.NewGetCommandGroups(configRepo, commandGroupRepo)
+	createCommandGroup := commandgroupusecases.NewCreateCommandGroup(configRepo, commandGroupRepo)
+	updateCommandGroup := commandgroupusecases.NewUpdateCommandGroup(commandGroupRepo)
+	deleteCommandGroup := commandgroupusecases.NewDeleteCommandGroup(commandGroupRepo, ee)
+	removeCommandFromCommandGroup := commandgroupusecases.NewRemoveCommandFromCommandGroup(commandGroupRepo)
+	reorderCommandGroups := commandgroupusecases.NewReorderCommandGroups(configRepo, commandGroupRepo)
+	runCommandGroup := commandgroupusecases.NewRunCommandGroup(configRepo, commandRepo, commandGroupRepo, projectRepo, r)
+	stopCommandGroup := commandgroupusecases.NewStopCommandGroup(commandGroupRepo, r)
+	restartCommandGroup := commandgroupusecases.NewRestartCommandGroup(configRepo, commandRepo, commandGroupRepo, projectRepo, r)
+	getPipelineStates := commandgroupusecases.NewGetPipelineStates(r)
+	// Commands
+	getCommands := commandusecases.NewGetCommands(configRepo, commandRepo)
+	addCommand := commandusecases.NewAddCommand(configRepo, commandRepo)
+	duplicateCommand := commandusecases.NewDuplicateCommand(configRepo, commandRepo, eventBus)
+	removeCommand := commandusecases.NewRemoveCommand(commandRepo, eventBus)
+	editCommand := commandusecases.NewEditCommand(commandRepo)
+	reorderCommands := commandusecases.NewReorderCommands(configRepo, commandRepo)
+	runCommand := commandusecases.NewRunCommand(configRepo, commandRepo, projectRepo, r)
+	stopCommand := commandusecases.NewStopCommand(commandRepo, r)
+	restartCommand := commandusecases.NewRestartCommand(configRepo, commandRepo, projectRepo, r)
+	getCommandRunStates := commandusecases.NewGetCommandRunStates(r)
+	resizeCommandTerminal := commandusecases.NewResizeCommandTerminal(r)
+	writeToCommand := commandusecases.NewWriteToCommand(commandRepo, r)
+	getCommandLogRuns := commandusecases.NewGetCommandLogRuns(ls)
+	getCommandLogs := commandusecases.NewGetCommandLogs(ls)
+	streamCommandLogs := commandusecases.NewStreamCommandLogs(commandRepo, ls, ee)
+	adoptOrphanedProcess := commandusecases.NewAdoptOrphanedProcess(commandRepo, r)
+	// Command runs
+	getCommandRuns := commandrunusecases.NewGetCommandRuns(commandRunRepo)
+	detectOrphanedProcesses := commandrunusecases.NewDetectOrphanedProcesses(commandRunRepo, r)
+
+	app.LoadDependencies(internalapp.Dependencies{
+		Logger:       l,
+		EventEmitter: ee,
+		Runner:       r,
+
+		KeepCommandsOnClose: options.KeepCommandsOnClose,
+
+		CommandRepository:      commandRepo,
+		CommandGroupRepository: commandGroupRepo,
+		ProjectRepository:      projectRepo,
+		ConfigRepository:       configRepo,
+
+		FsFacade:      facade.DefaultFsFacade{},
+		RuntimeFacade: options.RuntimeFacade,
+
+		EventBus: eventBus,
+		EventHandlers: internalapp.EventHandlers{
+			CleanCommandGroupsOnCommandDeleted:   cleanCommandGroupsOnCommandDeleted,
+			CleanCommandGroupsOnProjectDeleted:   cleanCommandGroupsOnProjectDeleted,
+			CleanCommandsOnProjectDeleted:        cleanCommandsOnProjectDeleted,
+			AddCommandToGroupOnCommandDuplicated: addCommandToGroupOnCommandDuplicated,
+			CleanCommandRunsOnCommandDeleted:     cleanCommandRunsOnCommandDeleted,
+		},
+
+		UseCases: internalapp.UseCases{
+			// Configuration
+			GetUserConfig:         getUserConfig,
+			SaveUserConfig:        saveUserConfig,
+			GetIntegrationsAccess: getIntegrationsAccess,
+			// Localization
+			GetTranslation:        getTranslation,
+			GetSupportedLanguages: getSupportedLanguages,
+			// Projects
+			GetCurrentProject:    getCurrentProject,
+			GetAvailableProjects: getAvailableProjects,
+			OpenProject:          openProject,
+			CreateProject:        createProject,
+			EditProject:          editProject,
+			CloseProject:         closeProject,
+			DeleteProject:        deleteProject,
+			ExportProject:        exportProject,
+			ImportProject:        importProject,
+			GetProjectToImport:   getProjectToImport,
+			// Command Groups
+			GetCommandGroups:              getCommandGroups,
+			CreateCommandGroup:            createCommandGroup,
+			UpdateCommandGroup:            updateCommandGroup,
+			DeleteCommandGroup:            deleteCommandGroup,
+			RemoveCommandFromCommandGroup: removeCommandFromCommandGroup,
+			ReorderCommandGroups:          reorderCommandGroups,
+			RunCommandGroup:               runCommandGroup,
+			StopCommandGroup:              stopCommandGroup,
+			RestartCommandGroup:           restartCommandGroup,
+			GetPipelineStates:             getPipelineStates,
+			// Commands
+			GetCommands:           getCommands,
+			AddCommand:            addCommand,
+			DuplicateCommand:      duplicateCommand,
+			RemoveCommand:         removeCommand,
+			EditCommand:           editCommand,
+			ReorderCommands:       reorderCommands,
+			RunCommand:            runCommand,
+			StopCommand:           stopCommand,
+			RestartCommand:        restartCommand,
+			GetCommandRunStates:   getCommandRunStates,
+			ResizeCommandTerminal: resizeCommandTerminal,
+			WriteToCommand:        writeToCommand,
+			GetCommandLogRuns:     getCommandLogRuns,
+			GetCommandLogs:        getCommandLogs,
+			StreamCommandLogs:     streamCommandLogs,
+			AdoptOrphanedProcess:  adoptOrphanedProcess,
+			// Command runs
+			GetCommandRuns:          getCommandRuns,
+			DetectOrphanedProcesses: detectOrphanedProcesses,
+		},
+	})
+}
@@ -0,0 +1,48 @@
+package bootstrap_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	internalapp "gomander/internal/app"
+	"gomander/internal/bootstrap"
+	"gomander/internal/event"
+	"gomander/internal/facade"
+	"gomander/internal/logger"
+)
+
+func TestRegisterDeps(t *testing.T) {
+	t.Run("Should build every use case without the Wails runtime", func(t *testing.T) {
+		// Arrange
+		configHome := t.TempDir()
+		t.Setenv("HOME", configHome)
+		t.Setenv("XDG_CONFIG_HOME", configHome)
+		t.Setenv("AppData", configHome)
+
+		ctx := context.Background()
+		gormDb := bootstrap.ConfigDB(ctx, filepath.Join(t.TempDir(), "data.db"))
+		app := internalapp.NewApp()
+
+		// Act
+		bootstrap.RegisterDeps(gormDb, ctx, app, bootstrap.Options{
+			Logger:        logger.NewConsoleLogger(&bytes.Buffer{}, false),
+			EventEmitter:  event.DiscardEventEmitter{},
+			RuntimeFacade: facade.HeadlessRuntimeFacade{},
+		})
+
+		// Assert
+		useCases := reflect.ValueOf(app.UseCases)
+		for i := 0; i < useCases.NumField(); i++ {
+			assert.False(t, useCases.Field(i).IsNil(), "%s should be set", useCases.Type().Field(i).Name)
+		}
+
+		access, err := app.UseCases.GetIntegrationsAccess.Execute()
+		assert.NoError(t, err)
+		assert.NotEmpty(t, access.Token)
+	})
+}
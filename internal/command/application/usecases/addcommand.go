@@ -22,6 +22,11 @@ func NewAddCommand(configRepo configdomain.Repository, commandRepo domain.Reposi
 }
 
 func (uc *DefaultAddCommand) Execute(newCommand domain.Command) error {
+	err := newCommand.Validate()
+	if err != nil {
+		return err
+	}
+
 	userConfig, err := uc.configRepository.GetOrCreate()
 	if err != nil {
 		return err
@@ -53,6 +53,29 @@ func TestDefaultAddCommand_Execute(t *testing.T) {
 		)
 	})
 
+	t.Run("Should reject a negative max restarts", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockUserConfigRepository := new(test2.MockConfigRepository)
+
+		sut := usecases.NewAddCommand(mockUserConfigRepository, mockCommandRepository)
+
+		newCommand := test.NewCommandBuilder().
+			WithRestartPolicy(commanddomain.RestartPolicyAlways, -1).
+			Build()
+
+		// Act
+		err := sut.Execute(newCommand)
+
+		// Assert
+		assert.ErrorIs(t, err, commanddomain.ErrInvalidCommand)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockUserConfigRepository,
+		)
+	})
+
 	t.Run("Should return an error if fails to get the user config", func(t *testing.T) {
 		// Arrange
 		mockCommandRepository := new(test.MockCommandRepository)
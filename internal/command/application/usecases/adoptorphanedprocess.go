@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"gomander/internal/command/domain"
+	"gomander/internal/runner"
+)
+
+type AdoptOrphanedProcess interface {
+	Execute(commandId string) error
+}
+
+type DefaultAdoptOrphanedProcess struct {
+	commandRepository domain.Repository
+	commandRunner     runner.Runner
+}
+
+func NewAdoptOrphanedProcess(commandRepo domain.Repository, runner runner.Runner) *DefaultAdoptOrphanedProcess {
+	return &DefaultAdoptOrphanedProcess{
+		commandRepository: commandRepo,
+		commandRunner:     runner,
+	}
+}
+
+// Execute takes over the process left running by a previous session, without its output.
+// Orphaned processes are killed by stopping their command instead.
+func (uc *DefaultAdoptOrphanedProcess) Execute(commandId string) error {
+	_, err := uc.commandRepository.Get(commandId)
+	if err != nil {
+		return err
+	}
+
+	return uc.commandRunner.AdoptOrphanedProcess(commandId)
+}
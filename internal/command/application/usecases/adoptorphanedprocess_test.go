@@ -0,0 +1,85 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/application/usecases"
+	"gomander/internal/command/domain/test"
+	"gomander/internal/runner"
+	test2 "gomander/internal/runner/test"
+)
+
+func TestDefaultAdoptOrphanedProcess_Execute(t *testing.T) {
+	t.Run("Should adopt the orphaned process of the command", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockRunner := new(test2.MockRunner)
+
+		sut := usecases.NewAdoptOrphanedProcess(mockCommandRepository, mockRunner)
+
+		cmd := test.NewCommandBuilder().WithProjectId("project1").Build()
+
+		mockCommandRepository.On("Get", cmd.Id).Return(&cmd, nil)
+
+		mockRunner.On("AdoptOrphanedProcess", cmd.Id).Return(nil)
+
+		// Act
+		err := sut.Execute(cmd.Id)
+		assert.NoError(t, err)
+
+		// Assert
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should return error if the command does not exist", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockRunner := new(test2.MockRunner)
+
+		sut := usecases.NewAdoptOrphanedProcess(mockCommandRepository, mockRunner)
+
+		commandId := "non-existing-command"
+
+		mockCommandRepository.On("Get", commandId).Return(nil, errors.New("command not found"))
+
+		// Act
+		err := sut.Execute(commandId)
+		assert.Error(t, err, "command not found")
+
+		// Assert
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+		)
+	})
+
+	t.Run("Should return error if the command has no orphaned process", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockRunner := new(test2.MockRunner)
+
+		sut := usecases.NewAdoptOrphanedProcess(mockCommandRepository, mockRunner)
+
+		cmd := test.NewCommandBuilder().WithProjectId("project1").Build()
+
+		mockCommandRepository.On("Get", cmd.Id).Return(&cmd, nil)
+
+		mockRunner.On("AdoptOrphanedProcess", cmd.Id).Return(runner.ErrNotOrphaned)
+
+		// Act
+		err := sut.Execute(cmd.Id)
+		assert.ErrorIs(t, err, runner.ErrNotOrphaned)
+
+		// Assert
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockRunner,
+		)
+	})
+}
@@ -19,7 +19,12 @@ func NewEditCommand(commandRepo domain.Repository) *DefaultEditCommand {
 }
 
 func (uc *DefaultEditCommand) Execute(newCommand domain.Command) error {
-	err := uc.commandRepository.Update(&newCommand)
+	err := newCommand.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = uc.commandRepository.Update(&newCommand)
 	if err != nil {
 		return err
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"gomander/internal/command/application/usecases"
+	"gomander/internal/command/domain"
 	"gomander/internal/command/domain/test"
 )
 
@@ -59,4 +60,42 @@ func TestApp_EditCommand(t *testing.T) {
 			mockCommandRepository,
 		)
 	})
+
+	t.Run("Should reject an invalid regular expression", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+
+		sut := usecases.NewEditCommand(mockCommandRepository)
+
+		commandToEdit := test.NewCommandBuilder().
+			WithErrorPatterns(domain.ErrorPattern{Pattern: "panic: (", Regex: true}).
+			Build()
+
+		// Act
+		err := sut.Execute(commandToEdit)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidErrorPattern)
+
+		mockCommandRepository.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("Should reject an unknown stop signal", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+
+		sut := usecases.NewEditCommand(mockCommandRepository)
+
+		commandToEdit := test.NewCommandBuilder().
+			WithStop("SIGKILL", 0, "").
+			Build()
+
+		// Act
+		err := sut.Execute(commandToEdit)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidCommand)
+
+		mockCommandRepository.AssertNotCalled(t, "Update", mock.Anything)
+	})
 }
@@ -0,0 +1,22 @@
+package usecases
+
+import "gomander/internal/logstore"
+
+type GetCommandLogRuns interface {
+	Execute(commandId string) ([]logstore.Run, error)
+}
+
+type DefaultGetCommandLogRuns struct {
+	logStore logstore.LogStore
+}
+
+func NewGetCommandLogRuns(logStore logstore.LogStore) *DefaultGetCommandLogRuns {
+	return &DefaultGetCommandLogRuns{
+		logStore: logStore,
+	}
+}
+
+// Execute returns the runs of the command whose output has been persisted, the most recent first.
+func (uc *DefaultGetCommandLogRuns) Execute(commandId string) ([]logstore.Run, error) {
+	return uc.logStore.GetRuns(commandId)
+}
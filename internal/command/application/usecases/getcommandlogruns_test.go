@@ -0,0 +1,49 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/command/application/usecases"
+	"gomander/internal/logstore"
+	"gomander/internal/logstore/test"
+)
+
+func TestDefaultGetCommandLogRuns_Execute(t *testing.T) {
+	t.Run("Should return the persisted runs of the command", func(t *testing.T) {
+		// Arrange
+		mockLogStore := new(test.MockLogStore)
+		sut := usecases.NewGetCommandLogRuns(mockLogStore)
+
+		expectedRuns := []logstore.Run{
+			{Id: "20261017T140000.000000000", StartedAt: time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC), Size: 2048},
+		}
+		mockLogStore.On("GetRuns", "cmd-1").Return(expectedRuns, nil)
+
+		// Act
+		result, err := sut.Execute("cmd-1")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedRuns, result)
+		mockLogStore.AssertExpectations(t)
+	})
+
+	t.Run("Should return an error if the runs cannot be read", func(t *testing.T) {
+		// Arrange
+		mockLogStore := new(test.MockLogStore)
+		sut := usecases.NewGetCommandLogRuns(mockLogStore)
+
+		mockLogStore.On("GetRuns", "cmd-1").Return([]logstore.Run(nil), errors.New("permission denied"))
+
+		// Act
+		_, err := sut.Execute("cmd-1")
+
+		// Assert
+		assert.Error(t, err)
+		mockLogStore.AssertExpectations(t)
+	})
+}
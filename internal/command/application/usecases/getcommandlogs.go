@@ -0,0 +1,23 @@
+package usecases
+
+import "gomander/internal/logstore"
+
+type GetCommandLogs interface {
+	Execute(commandId string, runId string, offset int, limit int) (logstore.Page, error)
+}
+
+type DefaultGetCommandLogs struct {
+	logStore logstore.LogStore
+}
+
+func NewGetCommandLogs(logStore logstore.LogStore) *DefaultGetCommandLogs {
+	return &DefaultGetCommandLogs{
+		logStore: logStore,
+	}
+}
+
+// Execute pages through the persisted output of a run of the command, the last one when runId is empty.
+// A negative offset counts from the end of the run.
+func (uc *DefaultGetCommandLogs) Execute(commandId string, runId string, offset int, limit int) (logstore.Page, error) {
+	return uc.logStore.GetLines(commandId, runId, offset, limit)
+}
@@ -0,0 +1,50 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/command/application/usecases"
+	"gomander/internal/logstore"
+	"gomander/internal/logstore/test"
+)
+
+func TestDefaultGetCommandLogs_Execute(t *testing.T) {
+	t.Run("Should return the requested page of the run", func(t *testing.T) {
+		// Arrange
+		mockLogStore := new(test.MockLogStore)
+		sut := usecases.NewGetCommandLogs(mockLogStore)
+
+		expectedPage := logstore.Page{
+			RunId:      "20261017T140000.000000000",
+			Lines:      []string{"panic: runtime error", "goroutine 1 [running]:"},
+			Offset:     98,
+			TotalLines: 100,
+		}
+		mockLogStore.On("GetLines", "cmd-1", "", -2, 50).Return(expectedPage, nil)
+
+		// Act
+		result, err := sut.Execute("cmd-1", "", -2, 50)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedPage, result)
+		mockLogStore.AssertExpectations(t)
+	})
+
+	t.Run("Should return an error if the run does not exist", func(t *testing.T) {
+		// Arrange
+		mockLogStore := new(test.MockLogStore)
+		sut := usecases.NewGetCommandLogs(mockLogStore)
+
+		mockLogStore.On("GetLines", "cmd-1", "missing", 0, 50).Return(logstore.Page{}, logstore.ErrRunNotFound)
+
+		// Act
+		_, err := sut.Execute("cmd-1", "missing", 0, 50)
+
+		// Assert
+		assert.ErrorIs(t, err, logstore.ErrRunNotFound)
+		mockLogStore.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,21 @@
+package usecases
+
+import "gomander/internal/runner"
+
+type GetCommandRunStates interface {
+	Execute() map[string]runner.RunState
+}
+
+type DefaultGetCommandRunStates struct {
+	runner runner.Runner
+}
+
+func NewGetCommandRunStates(runner runner.Runner) *DefaultGetCommandRunStates {
+	return &DefaultGetCommandRunStates{
+		runner: runner,
+	}
+}
+
+func (uc *DefaultGetCommandRunStates) Execute() map[string]runner.RunState {
+	return uc.runner.GetRunStates()
+}
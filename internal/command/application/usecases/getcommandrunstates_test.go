@@ -0,0 +1,48 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/command/application/usecases"
+	"gomander/internal/runner"
+	"gomander/internal/runner/test"
+)
+
+func TestDefaultGetCommandRunStates_Execute(t *testing.T) {
+	t.Run("Should return empty map when no command has been run", func(t *testing.T) {
+		// Arrange
+		mockRunner := new(test.MockRunner)
+		sut := usecases.NewGetCommandRunStates(mockRunner)
+
+		mockRunner.On("GetRunStates").Return(map[string]runner.RunState{})
+
+		// Act
+		result := sut.Execute()
+
+		// Assert
+		assert.Empty(t, result)
+		mockRunner.AssertExpectations(t)
+	})
+
+	t.Run("Should return the run state of each command", func(t *testing.T) {
+		// Arrange
+		mockRunner := new(test.MockRunner)
+		sut := usecases.NewGetCommandRunStates(mockRunner)
+
+		exitCode := 1
+		expectedStates := map[string]runner.RunState{
+			"cmd-1": {CommandId: "cmd-1", Status: runner.RunStatusRunning},
+			"cmd-2": {CommandId: "cmd-2", Status: runner.RunStatusExitedError, ExitCode: &exitCode},
+		}
+		mockRunner.On("GetRunStates").Return(expectedStates)
+
+		// Act
+		result := sut.Execute()
+
+		// Assert
+		assert.Equal(t, expectedStates, result)
+		mockRunner.AssertExpectations(t)
+	})
+}
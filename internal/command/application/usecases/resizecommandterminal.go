@@ -0,0 +1,21 @@
+package usecases
+
+import "gomander/internal/runner"
+
+type ResizeCommandTerminal interface {
+	Execute(commandId string, size runner.TerminalSize) error
+}
+
+type DefaultResizeCommandTerminal struct {
+	commandRunner runner.Runner
+}
+
+func NewResizeCommandTerminal(runner runner.Runner) *DefaultResizeCommandTerminal {
+	return &DefaultResizeCommandTerminal{
+		commandRunner: runner,
+	}
+}
+
+func (uc *DefaultResizeCommandTerminal) Execute(commandId string, size runner.TerminalSize) error {
+	return uc.commandRunner.ResizeTerminal(commandId, size)
+}
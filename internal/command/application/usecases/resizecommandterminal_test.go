@@ -0,0 +1,47 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/application/usecases"
+	"gomander/internal/runner"
+	"gomander/internal/runner/test"
+)
+
+func TestDefaultResizeCommandTerminal_Execute(t *testing.T) {
+	t.Run("Should resize the command terminal", func(t *testing.T) {
+		// Arrange
+		mockRunner := new(test.MockRunner)
+		sut := usecases.NewResizeCommandTerminal(mockRunner)
+
+		size := runner.TerminalSize{Cols: 120, Rows: 40}
+		mockRunner.On("ResizeTerminal", "cmd-1", size).Return(nil)
+
+		// Act
+		err := sut.Execute("cmd-1", size)
+
+		// Assert
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, mockRunner)
+	})
+
+	t.Run("Should return an error if failing to resize the terminal", func(t *testing.T) {
+		// Arrange
+		mockRunner := new(test.MockRunner)
+		sut := usecases.NewResizeCommandTerminal(mockRunner)
+
+		size := runner.TerminalSize{Cols: 120, Rows: 40}
+		mockRunner.On("ResizeTerminal", "cmd-1", size).Return(errors.New("failed to resize"))
+
+		// Act
+		err := sut.Execute("cmd-1", size)
+
+		// Assert
+		assert.Error(t, err)
+		mock.AssertExpectationsForObjects(t, mockRunner)
+	})
+}
@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"gomander/internal/command/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+	configdomain "gomander/internal/config/domain"
+	"gomander/internal/helpers/array"
+	projectdomain "gomander/internal/project/domain"
+	"gomander/internal/runner"
+)
+
+type RestartCommand interface {
+	Execute(commandId string, trigger commandrundomain.Trigger) error
+}
+
+type DefaultRestartCommand struct {
+	configRepository  configdomain.Repository
+	commandRepository domain.Repository
+	projectRepository projectdomain.Repository
+	commandRunner     runner.Runner
+}
+
+func NewRestartCommand(
+	configRepo configdomain.Repository,
+	commandRepo domain.Repository,
+	projectRepo projectdomain.Repository,
+	runner runner.Runner,
+) *DefaultRestartCommand {
+	return &DefaultRestartCommand{
+		configRepository:  configRepo,
+		commandRepository: commandRepo,
+		projectRepository: projectRepo,
+		commandRunner:     runner,
+	}
+}
+
+// Execute restarts the command with the current settings of its project, or reloads it when its restart mode says so.
+func (uc *DefaultRestartCommand) Execute(commandId string, trigger commandrundomain.Trigger) error {
+	cmd, err := uc.commandRepository.Get(commandId)
+	if err != nil {
+		return err
+	}
+	if cmd == nil {
+		return domain.ErrCommandNotFound
+	}
+
+	userConfig, err := uc.configRepository.GetOrCreate()
+	if err != nil {
+		return err
+	}
+
+	currentProject, err := uc.projectRepository.Get(cmd.ProjectId)
+	if err != nil {
+		return err
+	}
+
+	environmentPathsStrings := array.Map(userConfig.EnvironmentPaths, func(ep configdomain.EnvironmentPath) string {
+		return ep.Path
+	})
+
+	return uc.commandRunner.RestartCommand(cmd, runner.RunOptions{
+		EnvironmentPaths:     environmentPathsStrings,
+		BaseWorkingDirectory: currentProject.WorkingDirectory,
+		EnvironmentVariables: currentProject.EnvironmentVariables,
+		EnvFiles:             currentProject.EnvFiles,
+		Trigger:              trigger,
+	})
+}
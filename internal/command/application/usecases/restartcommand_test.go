@@ -0,0 +1,160 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/application/usecases"
+	"gomander/internal/command/domain"
+	"gomander/internal/command/domain/test"
+	commandrundomain "gomander/internal/commandrun/domain"
+	configdomain "gomander/internal/config/domain"
+	test3 "gomander/internal/config/domain/test"
+	"gomander/internal/environment"
+	projectdomain "gomander/internal/project/domain"
+	test2 "gomander/internal/project/domain/test"
+	"gomander/internal/runner"
+	test4 "gomander/internal/runner/test"
+)
+
+func TestDefaultRestartCommand_Execute(t *testing.T) {
+	t.Run("Should restart the command with the project settings", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test2.MockProjectRepository)
+		mockRunner := new(test4.MockRunner)
+
+		projectId := "project1"
+		sut := usecases.NewRestartCommand(mockUserConfigRepository, mockCommandRepository, mockProjectRepository, mockRunner)
+
+		mockUserConfigRepository.On("GetOrCreate").Return(&configdomain.Config{
+			LastOpenedProjectId: projectId,
+			EnvironmentPaths:    []configdomain.EnvironmentPath{{Id: "1", Path: "/1"}},
+		}, nil)
+
+		cmd := test.NewCommandBuilder().
+			WithProjectId(projectId).
+			WithRestartMode(domain.RestartModeReload).
+			Build()
+
+		mockCommandRepository.On("Get", cmd.Id).Return(&cmd, nil)
+		project := projectdomain.Project{
+			Id:                   projectId,
+			Name:                 "Test Project",
+			WorkingDirectory:     "/working/dir",
+			EnvironmentVariables: []environment.Variable{{Key: "FOO", Value: "bar"}},
+			EnvFiles:             []string{".env"},
+		}
+		mockProjectRepository.On("Get", cmd.ProjectId).Return(&project, nil)
+
+		mockRunner.On("RestartCommand", &cmd, runner.RunOptions{
+			EnvironmentPaths:     []string{"/1"},
+			BaseWorkingDirectory: project.WorkingDirectory,
+			EnvironmentVariables: project.EnvironmentVariables,
+			EnvFiles:             project.EnvFiles,
+			Trigger:              commandrundomain.TriggerHTTP,
+		}).Return(nil)
+
+		// Act
+		err := sut.Execute(cmd.Id, commandrundomain.TriggerHTTP)
+
+		// Assert
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockUserConfigRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should return an error if failing to retrieve the command", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test2.MockProjectRepository)
+		mockRunner := new(test4.MockRunner)
+
+		sut := usecases.NewRestartCommand(mockUserConfigRepository, mockCommandRepository, mockProjectRepository, mockRunner)
+
+		cmdId := "command1"
+
+		mockCommandRepository.On("Get", cmdId).Return(nil, errors.New("command not found"))
+
+		// Act
+		err := sut.Execute(cmdId, commandrundomain.TriggerUI)
+
+		// Assert
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+		)
+	})
+
+	t.Run("Should return ErrCommandNotFound if the command doesn't exist", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test2.MockProjectRepository)
+		mockRunner := new(test4.MockRunner)
+
+		sut := usecases.NewRestartCommand(mockUserConfigRepository, mockCommandRepository, mockProjectRepository, mockRunner)
+
+		cmdId := "command1"
+
+		mockCommandRepository.On("Get", cmdId).Return(nil, nil)
+
+		// Act
+		err := sut.Execute(cmdId, commandrundomain.TriggerHTTP)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrCommandNotFound)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockUserConfigRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should return an error if failing to restart the command", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test2.MockProjectRepository)
+		mockRunner := new(test4.MockRunner)
+
+		projectId := "project1"
+		sut := usecases.NewRestartCommand(mockUserConfigRepository, mockCommandRepository, mockProjectRepository, mockRunner)
+
+		mockUserConfigRepository.On("GetOrCreate").Return(&configdomain.Config{LastOpenedProjectId: projectId}, nil)
+
+		cmd := test.NewCommandBuilder().WithProjectId(projectId).Build()
+
+		mockCommandRepository.On("Get", cmd.Id).Return(&cmd, nil)
+		project := projectdomain.Project{Id: projectId, WorkingDirectory: "/working/dir"}
+		mockProjectRepository.On("Get", cmd.ProjectId).Return(&project, nil)
+
+		mockRunner.On("RestartCommand", &cmd, mock.Anything).Return(errors.New("failed to stop the command"))
+
+		// Act
+		err := sut.Execute(cmd.Id, commandrundomain.TriggerUI)
+
+		// Assert
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockUserConfigRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+	})
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"gomander/internal/command/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
 	configdomain "gomander/internal/config/domain"
 	"gomander/internal/helpers/array"
 	projectdomain "gomander/internal/project/domain"
@@ -9,7 +10,7 @@ import (
 )
 
 type RunCommand interface {
-	Execute(commandId string) error
+	Execute(commandId string, trigger commandrundomain.Trigger) error
 }
 
 type DefaultRunCommand struct {
@@ -33,7 +34,8 @@ func NewRunCommand(
 	}
 }
 
-func (uc *DefaultRunCommand) Execute(commandId string) error {
+// Execute runs the command with the settings of its project, recording in its history where it was triggered from.
+func (uc *DefaultRunCommand) Execute(commandId string, trigger commandrundomain.Trigger) error {
 	cmd, err := uc.commandRepository.Get(commandId)
 	if err != nil {
 		return err
@@ -53,7 +55,13 @@ func (uc *DefaultRunCommand) Execute(commandId string) error {
 		return ep.Path
 	})
 
-	err = uc.commandRunner.RunCommand(cmd, environmentPathsStrings, currentProject.WorkingDirectory)
+	err = uc.commandRunner.RunCommand(cmd, runner.RunOptions{
+		EnvironmentPaths:     environmentPathsStrings,
+		BaseWorkingDirectory: currentProject.WorkingDirectory,
+		EnvironmentVariables: currentProject.EnvironmentVariables,
+		EnvFiles:             currentProject.EnvFiles,
+		Trigger:              trigger,
+	})
 	if err != nil {
 		return err
 	}
@@ -9,10 +9,13 @@ import (
 
 	"gomander/internal/command/application/usecases"
 	"gomander/internal/command/domain/test"
+	commandrundomain "gomander/internal/commandrun/domain"
 	configdomain "gomander/internal/config/domain"
 	test3 "gomander/internal/config/domain/test"
+	"gomander/internal/environment"
 	projectdomain "gomander/internal/project/domain"
 	test2 "gomander/internal/project/domain/test"
+	"gomander/internal/runner"
 	test4 "gomander/internal/runner/test"
 )
 
@@ -52,10 +55,58 @@ func TestDefaultRunCommand_Execute(t *testing.T) {
 		}
 		mockProjectRepository.On("Get", cmd.ProjectId).Return(&project, nil)
 
-		mockRunner.On("RunCommand", &cmd, []string{"/1"}, project.WorkingDirectory).Return(nil)
+		mockRunner.On("RunCommand", &cmd, runner.RunOptions{EnvironmentPaths: []string{"/1"}, BaseWorkingDirectory: project.WorkingDirectory, Trigger: commandrundomain.TriggerUI}).Return(nil)
 
 		// Act
-		err := sut.Execute(cmd.Id)
+		err := sut.Execute(cmd.Id, commandrundomain.TriggerUI)
+
+		// Assert
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockUserConfigRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should run the command with the project environment variables and env files", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test2.MockProjectRepository)
+		mockRunner := new(test4.MockRunner)
+
+		projectId := "project1"
+		sut := usecases.NewRunCommand(mockUserConfigRepository, mockCommandRepository, mockProjectRepository, mockRunner)
+
+		mockUserConfigRepository.On("GetOrCreate").Return(&configdomain.Config{
+			LastOpenedProjectId: projectId,
+		}, nil)
+
+		cmd := test.NewCommandBuilder().WithProjectId(projectId).Build()
+
+		mockCommandRepository.On("Get", cmd.Id).Return(&cmd, nil)
+		project := projectdomain.Project{
+			Id:                   projectId,
+			Name:                 "Test Project",
+			WorkingDirectory:     "/working/dir",
+			EnvironmentVariables: []environment.Variable{{Key: "FOO", Value: "bar"}},
+			EnvFiles:             []string{".env"},
+		}
+		mockProjectRepository.On("Get", cmd.ProjectId).Return(&project, nil)
+
+		mockRunner.On("RunCommand", &cmd, runner.RunOptions{
+			EnvironmentPaths:     []string{},
+			BaseWorkingDirectory: project.WorkingDirectory,
+			EnvironmentVariables: project.EnvironmentVariables,
+			EnvFiles:             project.EnvFiles,
+			Trigger:              commandrundomain.TriggerUI,
+		}).Return(nil)
+
+		// Act
+		err := sut.Execute(cmd.Id, commandrundomain.TriggerUI)
 
 		// Assert
 		assert.NoError(t, err)
@@ -82,7 +133,7 @@ func TestDefaultRunCommand_Execute(t *testing.T) {
 		mockCommandRepository.On("Get", cmdId).Return(nil, errors.New("command not found"))
 
 		// Act
-		err := sut.Execute(cmdId)
+		err := sut.Execute(cmdId, commandrundomain.TriggerUI)
 
 		// Assert
 		assert.Error(t, err)
@@ -107,7 +158,7 @@ func TestDefaultRunCommand_Execute(t *testing.T) {
 		mockUserConfigRepository.On("GetOrCreate").Return(nil, errors.New("failed to get user config"))
 
 		// Act
-		err := sut.Execute(cmd.Id)
+		err := sut.Execute(cmd.Id, commandrundomain.TriggerUI)
 
 		// Assert
 		assert.Error(t, err)
@@ -136,7 +187,7 @@ func TestDefaultRunCommand_Execute(t *testing.T) {
 		mockProjectRepository.On("Get", projectId).Return(nil, errors.New("failed to get project"))
 
 		// Act
-		err := sut.Execute(cmd.Id)
+		err := sut.Execute(cmd.Id, commandrundomain.TriggerUI)
 
 		// Assert
 		assert.Error(t, err)
@@ -183,10 +234,10 @@ func TestDefaultRunCommand_Execute(t *testing.T) {
 		}
 		mockProjectRepository.On("Get", cmd.ProjectId).Return(&project, nil)
 
-		mockRunner.On("RunCommand", &cmd, []string{"/1"}, project.WorkingDirectory).Return(errors.New("failed to run command"))
+		mockRunner.On("RunCommand", &cmd, runner.RunOptions{EnvironmentPaths: []string{"/1"}, BaseWorkingDirectory: project.WorkingDirectory, Trigger: commandrundomain.TriggerUI}).Return(errors.New("failed to run command"))
 
 		// Act
-		err := sut.Execute(cmd.Id)
+		err := sut.Execute(cmd.Id, commandrundomain.TriggerUI)
 
 		// Assert
 		assert.Error(t, err)
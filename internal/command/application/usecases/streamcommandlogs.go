@@ -0,0 +1,176 @@
+package usecases
+
+import (
+	"errors"
+	"slices"
+	"sync"
+
+	"gomander/internal/command/domain"
+	"gomander/internal/event"
+	"gomander/internal/logstore"
+	"gomander/internal/runner"
+)
+
+var streamedEvents = []event.Event{
+	event.NewLogEntry,
+	event.ProcessStarted,
+	event.ProcessFinished,
+	event.CommandErrorDetected,
+}
+
+// LogStream follows the output of a command. Close must be called once the stream is no longer read.
+type LogStream struct {
+	// Backlog holds the last persisted lines of the command, written before the stream was opened
+	Backlog  []string
+	Messages <-chan event.Message
+	Close    func()
+}
+
+type StreamCommandLogs interface {
+	Execute(commandId string, tail int) (*LogStream, error)
+}
+
+type DefaultStreamCommandLogs struct {
+	commandRepository domain.Repository
+	logStore          logstore.LogStore
+	subscriber        event.Subscriber
+}
+
+func NewStreamCommandLogs(commandRepo domain.Repository, logStore logstore.LogStore, subscriber event.Subscriber) *DefaultStreamCommandLogs {
+	return &DefaultStreamCommandLogs{
+		commandRepository: commandRepo,
+		logStore:          logStore,
+		subscriber:        subscriber,
+	}
+}
+
+// Execute opens a stream of the log, start, finish and error events of the command, along with the last tail lines
+// of its last run.
+func (uc *DefaultStreamCommandLogs) Execute(commandId string, tail int) (*LogStream, error) {
+	command, err := uc.commandRepository.Get(commandId)
+	if err != nil {
+		return nil, err
+	}
+	if command == nil {
+		return nil, domain.ErrCommandNotFound
+	}
+
+	// Subscribe before reading the backlog, so no line is lost in between
+	live, unsubscribe := uc.subscriber.Subscribe(func(message event.Message) bool {
+		return slices.Contains(streamedEvents, message.Event) && commandIdOf(message.Payload) == commandId
+	})
+
+	backlog := make([]string, 0)
+	if tail > 0 {
+		page, err := uc.logStore.GetLines(commandId, "", -tail, tail)
+		if err != nil && !errors.Is(err, logstore.ErrRunNotFound) {
+			unsubscribe()
+			return nil, err
+		}
+		backlog = append(backlog, page.Lines...)
+	}
+
+	// The lines logged while the backlog was read are also in the subscription, they are dropped so they're not sent twice
+	pending := dropLinesInBacklog(drainMessages(live), backlog)
+
+	messages := make(chan event.Message, event.SubscriptionBufferSize)
+	done := make(chan struct{})
+	go forwardMessages(pending, live, messages, done)
+
+	var once sync.Once
+	return &LogStream{
+		Backlog:  backlog,
+		Messages: messages,
+		Close: func() {
+			once.Do(func() {
+				close(done)
+				unsubscribe()
+			})
+		},
+	}, nil
+}
+
+// drainMessages returns the messages already received, without waiting for more
+func drainMessages(messages <-chan event.Message) []event.Message {
+	drained := make([]event.Message, 0)
+	for {
+		select {
+		case message := <-messages:
+			drained = append(drained, message)
+		default:
+			return drained
+		}
+	}
+}
+
+// dropLinesInBacklog removes the first log entries when they are the last lines of the backlog. Lines are persisted
+// before being emitted, so the ones read with the backlog are the first ones received.
+func dropLinesInBacklog(messages []event.Message, backlog []string) []event.Message {
+	lines := make([]string, 0)
+	for _, message := range messages {
+		if message.Event == event.NewLogEntry {
+			lines = append(lines, lineOf(message.Payload))
+		}
+	}
+
+	for covered := min(len(lines), len(backlog)); covered > 0; covered-- {
+		if !slices.Equal(lines[:covered], backlog[len(backlog)-covered:]) {
+			continue
+		}
+
+		kept := make([]event.Message, 0, len(messages)-covered)
+		for _, message := range messages {
+			if message.Event == event.NewLogEntry && covered > 0 {
+				covered--
+				continue
+			}
+			kept = append(kept, message)
+		}
+		return kept
+	}
+
+	return messages
+}
+
+// forwardMessages sends the pending messages and then the live ones, until the subscription ends or the stream is closed
+func forwardMessages(pending []event.Message, live <-chan event.Message, out chan<- event.Message, done <-chan struct{}) {
+	defer close(out)
+
+	for _, message := range pending {
+		select {
+		case out <- message:
+		case <-done:
+			return
+		}
+	}
+
+	for message := range live {
+		select {
+		case out <- message:
+		case <-done:
+			return
+		}
+	}
+}
+
+func lineOf(payload interface{}) string {
+	if p, ok := payload.(map[string]string); ok {
+		return p["line"]
+	}
+	return ""
+}
+
+func commandIdOf(payload interface{}) string {
+	switch p := payload.(type) {
+	case string:
+		return p
+	case map[string]string:
+		return p["id"]
+	case runner.RunState:
+		return p.CommandId
+	case runner.ErrorDetectedPayload:
+		return p.Id
+	default:
+		return ""
+	}
+}
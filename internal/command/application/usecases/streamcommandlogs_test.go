@@ -0,0 +1,215 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/application/usecases"
+	"gomander/internal/command/domain"
+	"gomander/internal/command/domain/test"
+	"gomander/internal/event"
+	eventtest "gomander/internal/event/test"
+	"gomander/internal/logstore"
+	logstoretest "gomander/internal/logstore/test"
+	"gomander/internal/runner"
+)
+
+func newBroadcaster() *event.Broadcaster {
+	mockEmitter := new(eventtest.MockEventEmitter)
+	mockEmitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
+	return event.NewBroadcaster(mockEmitter)
+}
+
+func TestDefaultStreamCommandLogs_Execute(t *testing.T) {
+	t.Run("Should return the backlog and stream the events of the command", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockLogStore := new(logstoretest.MockLogStore)
+		broadcaster := newBroadcaster()
+		sut := usecases.NewStreamCommandLogs(mockCommandRepository, mockLogStore, broadcaster)
+
+		cmd := test.NewCommandBuilder().WithId("cmd-1").Build()
+		mockCommandRepository.On("Get", "cmd-1").Return(&cmd, nil)
+		mockLogStore.On("GetLines", "cmd-1", "", -2, 2).Return(logstore.Page{Lines: []string{"b", "c"}}, nil)
+
+		// Act
+		stream, err := sut.Execute("cmd-1", 2)
+		defer stream.Close()
+
+		broadcaster.EmitEvent(event.ProcessStarted, "cmd-2")
+		broadcaster.EmitEvent(event.PipelineStarted, "cmd-1")
+		broadcaster.EmitEvent(event.ProcessStarted, "cmd-1")
+		broadcaster.EmitEvent(event.NewLogEntry, map[string]string{"id": "cmd-1", "line": "d"})
+		broadcaster.EmitEvent(event.CommandErrorDetected, runner.ErrorDetectedPayload{Id: "cmd-1", Line: "d"})
+		broadcaster.EmitEvent(event.ProcessFinished, runner.RunState{CommandId: "cmd-1"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"b", "c"}, stream.Backlog)
+		assert.Equal(t, event.ProcessStarted, (<-stream.Messages).Event)
+		assert.Equal(t, event.NewLogEntry, (<-stream.Messages).Event)
+		assert.Equal(t, event.CommandErrorDetected, (<-stream.Messages).Event)
+		assert.Equal(t, event.ProcessFinished, (<-stream.Messages).Event)
+		assert.Empty(t, stream.Messages)
+
+		mock.AssertExpectationsForObjects(t, mockCommandRepository, mockLogStore)
+	})
+
+	t.Run("Should not stream again the lines logged while the backlog is read", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockLogStore := new(logstoretest.MockLogStore)
+		broadcaster := newBroadcaster()
+		sut := usecases.NewStreamCommandLogs(mockCommandRepository, mockLogStore, broadcaster)
+
+		cmd := test.NewCommandBuilder().WithId("cmd-1").Build()
+		mockCommandRepository.On("Get", "cmd-1").Return(&cmd, nil)
+		mockLogStore.On("GetLines", "cmd-1", "", -3, 3).
+			Run(func(args mock.Arguments) {
+				// Logged once subscribed, and persisted before the backlog is read
+				broadcaster.EmitEvent(event.NewLogEntry, map[string]string{"id": "cmd-1", "line": "c"})
+				broadcaster.EmitEvent(event.CommandErrorDetected, runner.ErrorDetectedPayload{Id: "cmd-1", Line: "c"})
+			}).
+			Return(logstore.Page{Lines: []string{"a", "b", "c"}}, nil)
+
+		// Act
+		stream, err := sut.Execute("cmd-1", 3)
+		defer stream.Close()
+
+		broadcaster.EmitEvent(event.NewLogEntry, map[string]string{"id": "cmd-1", "line": "d"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, stream.Backlog)
+		assert.Equal(t, event.CommandErrorDetected, (<-stream.Messages).Event)
+		assert.Equal(t, event.Message{
+			Event:   event.NewLogEntry,
+			Payload: map[string]string{"id": "cmd-1", "line": "d"},
+		}, <-stream.Messages)
+		assert.Empty(t, stream.Messages)
+	})
+
+	t.Run("Should stream the lines logged while the backlog is read that it doesn't contain", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockLogStore := new(logstoretest.MockLogStore)
+		broadcaster := newBroadcaster()
+		sut := usecases.NewStreamCommandLogs(mockCommandRepository, mockLogStore, broadcaster)
+
+		cmd := test.NewCommandBuilder().WithId("cmd-1").Build()
+		mockCommandRepository.On("Get", "cmd-1").Return(&cmd, nil)
+		mockLogStore.On("GetLines", "cmd-1", "", -2, 2).
+			Run(func(args mock.Arguments) {
+				// Logged once the backlog was read
+				broadcaster.EmitEvent(event.NewLogEntry, map[string]string{"id": "cmd-1", "line": "c"})
+			}).
+			Return(logstore.Page{Lines: []string{"a", "b"}}, nil)
+
+		// Act
+		stream, err := sut.Execute("cmd-1", 2)
+		defer stream.Close()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, stream.Backlog)
+		assert.Equal(t, event.Message{
+			Event:   event.NewLogEntry,
+			Payload: map[string]string{"id": "cmd-1", "line": "c"},
+		}, <-stream.Messages)
+	})
+
+	t.Run("Should end the messages once the stream is closed", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockLogStore := new(logstoretest.MockLogStore)
+		sut := usecases.NewStreamCommandLogs(mockCommandRepository, mockLogStore, newBroadcaster())
+
+		cmd := test.NewCommandBuilder().WithId("cmd-1").Build()
+		mockCommandRepository.On("Get", "cmd-1").Return(&cmd, nil)
+
+		stream, err := sut.Execute("cmd-1", 0)
+		assert.NoError(t, err)
+
+		// Act
+		stream.Close()
+
+		// Assert
+		_, ok := <-stream.Messages
+		assert.False(t, ok)
+	})
+
+	t.Run("Should return an empty backlog if the command was never run", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockLogStore := new(logstoretest.MockLogStore)
+		sut := usecases.NewStreamCommandLogs(mockCommandRepository, mockLogStore, newBroadcaster())
+
+		cmd := test.NewCommandBuilder().WithId("cmd-1").Build()
+		mockCommandRepository.On("Get", "cmd-1").Return(&cmd, nil)
+		mockLogStore.On("GetLines", "cmd-1", "", -10, 10).Return(logstore.Page{}, logstore.ErrRunNotFound)
+
+		// Act
+		stream, err := sut.Execute("cmd-1", 10)
+		defer stream.Close()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, stream.Backlog)
+	})
+
+	t.Run("Should not read the backlog if no tail is requested", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockLogStore := new(logstoretest.MockLogStore)
+		sut := usecases.NewStreamCommandLogs(mockCommandRepository, mockLogStore, newBroadcaster())
+
+		cmd := test.NewCommandBuilder().WithId("cmd-1").Build()
+		mockCommandRepository.On("Get", "cmd-1").Return(&cmd, nil)
+
+		// Act
+		stream, err := sut.Execute("cmd-1", 0)
+		defer stream.Close()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, stream.Backlog)
+		mockLogStore.AssertNotCalled(t, "GetLines", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Should return an error if the command does not exist", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockLogStore := new(logstoretest.MockLogStore)
+		sut := usecases.NewStreamCommandLogs(mockCommandRepository, mockLogStore, newBroadcaster())
+
+		mockCommandRepository.On("Get", "cmd-1").Return(nil, nil)
+
+		// Act
+		stream, err := sut.Execute("cmd-1", 10)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrCommandNotFound)
+		assert.Nil(t, stream)
+	})
+
+	t.Run("Should return an error if failing to read the backlog", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockLogStore := new(logstoretest.MockLogStore)
+		sut := usecases.NewStreamCommandLogs(mockCommandRepository, mockLogStore, newBroadcaster())
+
+		cmd := test.NewCommandBuilder().WithId("cmd-1").Build()
+		mockCommandRepository.On("Get", "cmd-1").Return(&cmd, nil)
+		mockLogStore.On("GetLines", "cmd-1", "", -10, 10).Return(logstore.Page{}, errors.New("failed to read the log file"))
+
+		// Act
+		stream, err := sut.Execute("cmd-1", 10)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, stream)
+	})
+}
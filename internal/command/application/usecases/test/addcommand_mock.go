@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/domain"
+)
+
+type MockAddCommand struct {
+	mock.Mock
+}
+
+func (m *MockAddCommand) Execute(command domain.Command) error {
+	args := m.Called(command)
+	return args.Error(0)
+}
@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/runner"
+)
+
+type MockGetCommandRunStates struct {
+	mock.Mock
+}
+
+func (m *MockGetCommandRunStates) Execute() map[string]runner.RunState {
+	args := m.Called()
+	return args.Get(0).(map[string]runner.RunState)
+}
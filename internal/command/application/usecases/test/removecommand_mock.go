@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockRemoveCommand struct {
+	mock.Mock
+}
+
+func (m *MockRemoveCommand) Execute(commandId string) error {
+	args := m.Called(commandId)
+	return args.Error(0)
+}
@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockReorderCommands struct {
+	mock.Mock
+}
+
+func (m *MockReorderCommands) Execute(orderedIds []string) error {
+	args := m.Called(orderedIds)
+	return args.Error(0)
+}
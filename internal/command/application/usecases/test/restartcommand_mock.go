@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	commandrundomain "gomander/internal/commandrun/domain"
+)
+
+type MockRestartCommand struct {
+	mock.Mock
+}
+
+func (m *MockRestartCommand) Execute(commandId string, trigger commandrundomain.Trigger) error {
+	args := m.Called(commandId, trigger)
+	return args.Error(0)
+}
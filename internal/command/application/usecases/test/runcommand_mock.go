@@ -1,12 +1,16 @@
 package test
 
-import "github.com/stretchr/testify/mock"
+import (
+	"github.com/stretchr/testify/mock"
+
+	commandrundomain "gomander/internal/commandrun/domain"
+)
 
 type MockRunCommands struct {
 	mock.Mock
 }
 
-func (m *MockRunCommands) Execute(commandId string) error {
-	args := m.Called(commandId)
+func (m *MockRunCommands) Execute(commandId string, trigger commandrundomain.Trigger) error {
+	args := m.Called(commandId, trigger)
 	return args.Error(0)
 }
@@ -0,0 +1,19 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/application/usecases"
+)
+
+type MockStreamCommandLogs struct {
+	mock.Mock
+}
+
+func (m *MockStreamCommandLogs) Execute(commandId string, tail int) (*usecases.LogStream, error) {
+	args := m.Called(commandId, tail)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*usecases.LogStream), args.Error(1)
+}
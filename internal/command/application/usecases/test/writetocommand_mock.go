@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockWriteToCommand struct {
+	mock.Mock
+}
+
+func (m *MockWriteToCommand) Execute(commandId string, data string) error {
+	args := m.Called(commandId, data)
+	return args.Error(0)
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"gomander/internal/command/domain"
+	"gomander/internal/runner"
+)
+
+type WriteToCommand interface {
+	Execute(commandId string, data string) error
+}
+
+type DefaultWriteToCommand struct {
+	commandRepository domain.Repository
+	commandRunner     runner.Runner
+}
+
+func NewWriteToCommand(commandRepo domain.Repository, runner runner.Runner) *DefaultWriteToCommand {
+	return &DefaultWriteToCommand{
+		commandRepository: commandRepo,
+		commandRunner:     runner,
+	}
+}
+
+func (uc *DefaultWriteToCommand) Execute(commandId string, data string) error {
+	// Check if the command exists before trying to write to it
+	command, err := uc.commandRepository.Get(commandId)
+	if err != nil {
+		return err
+	}
+	if command == nil {
+		return domain.ErrCommandNotFound
+	}
+
+	return uc.commandRunner.WriteToCommand(commandId, data)
+}
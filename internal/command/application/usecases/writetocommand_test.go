@@ -0,0 +1,107 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/application/usecases"
+	"gomander/internal/command/domain"
+	"gomander/internal/command/domain/test"
+	"gomander/internal/runner"
+	test2 "gomander/internal/runner/test"
+)
+
+func TestDefaultWriteToCommand_Execute(t *testing.T) {
+	t.Run("Should write the data to the command", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockRunner := new(test2.MockRunner)
+
+		sut := usecases.NewWriteToCommand(mockCommandRepository, mockRunner)
+
+		cmd := test.NewCommandBuilder().Build()
+
+		mockCommandRepository.On("Get", cmd.Id).Return(&cmd, nil)
+		mockRunner.On("WriteToCommand", cmd.Id, "r").Return(nil)
+
+		// Act
+		err := sut.Execute(cmd.Id, "r")
+
+		// Assert
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should return error if the command does not exist", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockRunner := new(test2.MockRunner)
+
+		sut := usecases.NewWriteToCommand(mockCommandRepository, mockRunner)
+
+		commandId := "non-existing-command"
+
+		mockCommandRepository.On("Get", commandId).Return(nil, errors.New("command not found"))
+
+		// Act
+		err := sut.Execute(commandId, "r")
+
+		// Assert
+		assert.Error(t, err)
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should return ErrCommandNotFound if the command does not exist", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockRunner := new(test2.MockRunner)
+
+		sut := usecases.NewWriteToCommand(mockCommandRepository, mockRunner)
+
+		commandId := "non-existing-command"
+
+		mockCommandRepository.On("Get", commandId).Return(nil, nil)
+
+		// Act
+		err := sut.Execute(commandId, "r")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrCommandNotFound)
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should return error if the command is not running", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockRunner := new(test2.MockRunner)
+
+		sut := usecases.NewWriteToCommand(mockCommandRepository, mockRunner)
+
+		cmd := test.NewCommandBuilder().Build()
+
+		mockCommandRepository.On("Get", cmd.Id).Return(&cmd, nil)
+		mockRunner.On("WriteToCommand", cmd.Id, "r").Return(runner.ErrCommandNotRunning)
+
+		// Act
+		err := sut.Execute(cmd.Id, "r")
+
+		// Assert
+		assert.ErrorIs(t, err, runner.ErrCommandNotRunning)
+		mock.AssertExpectationsForObjects(t,
+			mockCommandRepository,
+			mockRunner,
+		)
+	})
+}
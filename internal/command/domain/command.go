@@ -1,12 +1,145 @@
 package domain
 
+import (
+	"errors"
+	"fmt"
+
+	"gomander/internal/environment"
+)
+
+var (
+	ErrCommandNotFound = errors.New("command not found")
+	ErrInvalidCommand  = errors.New("invalid command")
+)
+
 type Command struct {
-	Id               string   `json:"id"`
-	ProjectId        string   `json:"projectId"`
-	Name             string   `json:"name"`
-	Command          string   `json:"command"`
-	WorkingDirectory string   `json:"workingDirectory"`
-	Position         int      `json:"position"`
-	Link             string   `json:"link"`
-	ErrorPatterns    []string `json:"errorPatterns"`
+	Id                   string                 `json:"id"`
+	ProjectId            string                 `json:"projectId"`
+	Name                 string                 `json:"name"`
+	Command              string                 `json:"command"`
+	WorkingDirectory     string                 `json:"workingDirectory"`
+	Position             int                    `json:"position"`
+	Link                 string                 `json:"link"`
+	ErrorPatterns        []ErrorPattern         `json:"errorPatterns"`
+	TerminalMode         bool                   `json:"terminalMode"`
+	Interactive          bool                   `json:"interactive"`
+	EnvironmentVariables []environment.Variable `json:"environmentVariables"`
+	EnvFiles             []string               `json:"envFiles"`
+	RestartPolicy        RestartPolicy          `json:"restartPolicy"`
+	MaxRestarts          int                    `json:"maxRestarts"`
+	ReadinessProbe       *ReadinessProbe        `json:"readinessProbe"`
+	StopSignal           StopSignal             `json:"stopSignal"`
+	StopTimeoutSeconds   int                    `json:"stopTimeoutSeconds"`
+	StopCommand          string                 `json:"stopCommand"`
+	RestartMode          RestartMode            `json:"restartMode"`
+	FileWatch            *FileWatch             `json:"fileWatch"`
+}
+
+// RestartPolicy defines whether a command is restarted when it exits on its own, up to MaxRestarts (0 for no limit).
+type RestartPolicy string
+
+const (
+	RestartPolicyNever     RestartPolicy = "never"
+	RestartPolicyOnFailure RestartPolicy = "on-failure"
+	RestartPolicyAlways    RestartPolicy = "always"
+)
+
+// RestartMode defines whether restarting a running command stops and runs it again, or sends it SIGHUP.
+type RestartMode string
+
+const (
+	RestartModeStopStart RestartMode = "stop-start"
+	RestartModeReload    RestartMode = "reload"
+)
+
+// FileWatch restarts the command when the files matching its globs, relative to its working directory, change.
+type FileWatch struct {
+	Patterns   []string `json:"patterns"`
+	Ignore     []string `json:"ignore"`
+	DebounceMs int      `json:"debounceMs"`
+}
+
+// StopSignal is sent to the process group of a command to stop it, unless it has a StopCommand.
+type StopSignal string
+
+const (
+	StopSignalInterrupt StopSignal = "SIGINT"
+	StopSignalTerminate StopSignal = "SIGTERM"
+	StopSignalQuit      StopSignal = "SIGQUIT"
+	StopSignalHangup    StopSignal = "SIGHUP"
+)
+
+// GetStopSignal returns the stop signal of the command, defaulting to SIGTERM.
+func (c Command) GetStopSignal() StopSignal {
+	if c.StopSignal == "" {
+		return StopSignalTerminate
+	}
+	return c.StopSignal
+}
+
+type ReadinessProbeType string
+
+const (
+	ReadinessProbeTCP  ReadinessProbeType = "tcp"
+	ReadinessProbeHTTP ReadinessProbeType = "http"
+	ReadinessProbeLog  ReadinessProbeType = "log"
+)
+
+// ReadinessProbe tells when a running command is ready, from a tcp address, an http URL or a log line.
+type ReadinessProbe struct {
+	Type           ReadinessProbeType `json:"type"`
+	Target         string             `json:"target"`
+	TimeoutSeconds int                `json:"timeoutSeconds"`
+}
+
+// Validate checks the error patterns and options of the command.
+func (c Command) Validate() error {
+	err := c.ValidateErrorPatterns()
+	if err != nil {
+		return err
+	}
+
+	switch c.RestartPolicy {
+	case "", RestartPolicyNever, RestartPolicyOnFailure, RestartPolicyAlways:
+	default:
+		return fmt.Errorf("%w: unknown restart policy %q", ErrInvalidCommand, c.RestartPolicy)
+	}
+	if c.MaxRestarts < 0 {
+		return fmt.Errorf("%w: the max restarts can't be negative", ErrInvalidCommand)
+	}
+
+	switch c.RestartMode {
+	case "", RestartModeStopStart, RestartModeReload:
+	default:
+		return fmt.Errorf("%w: unknown restart mode %q", ErrInvalidCommand, c.RestartMode)
+	}
+
+	switch c.StopSignal {
+	case "", StopSignalInterrupt, StopSignalTerminate, StopSignalQuit, StopSignalHangup:
+	default:
+		return fmt.Errorf("%w: unknown stop signal %q", ErrInvalidCommand, c.StopSignal)
+	}
+	if c.StopTimeoutSeconds < 0 {
+		return fmt.Errorf("%w: the stop timeout can't be negative", ErrInvalidCommand)
+	}
+
+	if c.ReadinessProbe != nil {
+		switch c.ReadinessProbe.Type {
+		case ReadinessProbeTCP, ReadinessProbeHTTP, ReadinessProbeLog:
+		default:
+			return fmt.Errorf("%w: unknown readiness probe type %q", ErrInvalidCommand, c.ReadinessProbe.Type)
+		}
+		if c.ReadinessProbe.Target == "" {
+			return fmt.Errorf("%w: the readiness probe target is empty", ErrInvalidCommand)
+		}
+		if c.ReadinessProbe.TimeoutSeconds < 0 {
+			return fmt.Errorf("%w: the readiness probe timeout can't be negative", ErrInvalidCommand)
+		}
+	}
+
+	if c.FileWatch != nil && c.FileWatch.DebounceMs < 0 {
+		return fmt.Errorf("%w: the file watch debounce can't be negative", ErrInvalidCommand)
+	}
+
+	return nil
 }
@@ -0,0 +1,67 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/command/domain"
+)
+
+func TestCommand_Validate(t *testing.T) {
+	t.Run("Should accept the default and known options", func(t *testing.T) {
+		// Arrange
+		commands := []domain.Command{
+			{},
+			{
+				RestartPolicy:      domain.RestartPolicyOnFailure,
+				MaxRestarts:        3,
+				RestartMode:        domain.RestartModeReload,
+				StopSignal:         domain.StopSignalInterrupt,
+				StopTimeoutSeconds: 30,
+				ReadinessProbe:     &domain.ReadinessProbe{Type: domain.ReadinessProbeTCP, Target: "3000", TimeoutSeconds: 10},
+				FileWatch:          &domain.FileWatch{Patterns: []string{"**/*.go"}, DebounceMs: 500},
+			},
+		}
+
+		for _, command := range commands {
+			// Act
+			err := command.Validate()
+
+			// Assert
+			assert.NoError(t, err)
+		}
+	})
+	t.Run("Should reject unknown or negative options", func(t *testing.T) {
+		// Arrange
+		commands := []domain.Command{
+			{RestartPolicy: "sometimes"},
+			{MaxRestarts: -1},
+			{RestartMode: "reboot"},
+			{StopSignal: "SIGKILL"},
+			{StopTimeoutSeconds: -5},
+			{ReadinessProbe: &domain.ReadinessProbe{Type: "udp", Target: "3000"}},
+			{ReadinessProbe: &domain.ReadinessProbe{Type: domain.ReadinessProbeHTTP}},
+			{ReadinessProbe: &domain.ReadinessProbe{Type: domain.ReadinessProbeLog, Target: "ready", TimeoutSeconds: -1}},
+			{FileWatch: &domain.FileWatch{Patterns: []string{"*.go"}, DebounceMs: -1}},
+		}
+
+		for _, command := range commands {
+			// Act
+			err := command.Validate()
+
+			// Assert
+			assert.ErrorIs(t, err, domain.ErrInvalidCommand)
+		}
+	})
+	t.Run("Should reject invalid error patterns", func(t *testing.T) {
+		// Arrange
+		command := domain.Command{ErrorPatterns: []domain.ErrorPattern{{Pattern: "(", Regex: true}}}
+
+		// Act
+		err := command.Validate()
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidErrorPattern)
+	})
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+type ErrorPatternSeverity string
+
+const (
+	ErrorPatternSeverityError   ErrorPatternSeverity = "error"
+	ErrorPatternSeverityWarning ErrorPatternSeverity = "warning"
+	ErrorPatternSeverityInfo    ErrorPatternSeverity = "info"
+)
+
+var ErrInvalidErrorPattern = errors.New("invalid error pattern")
+
+// ErrorPattern highlights the output lines of a command containing Pattern, or matching it when Regex is set.
+type ErrorPattern struct {
+	Pattern  string               `json:"pattern"`
+	Regex    bool                 `json:"regex"`
+	Severity ErrorPatternSeverity `json:"severity"`
+	Label    string               `json:"label"`
+}
+
+// GetSeverity returns the severity of the pattern, defaulting to error.
+func (p ErrorPattern) GetSeverity() ErrorPatternSeverity {
+	if p.Severity == "" {
+		return ErrorPatternSeverityError
+	}
+	return p.Severity
+}
+
+func (p ErrorPattern) Validate() error {
+	if p.Pattern == "" {
+		return fmt.Errorf("%w: the pattern is empty", ErrInvalidErrorPattern)
+	}
+
+	switch p.GetSeverity() {
+	case ErrorPatternSeverityError, ErrorPatternSeverityWarning, ErrorPatternSeverityInfo:
+	default:
+		return fmt.Errorf("%w: unknown severity %q", ErrInvalidErrorPattern, p.Severity)
+	}
+
+	if p.Regex {
+		_, err := regexp.Compile(p.Pattern)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidErrorPattern, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// ValidateErrorPatterns checks that the regular expressions of the error patterns compile.
+func (c Command) ValidateErrorPatterns() error {
+	for _, pattern := range c.ErrorPatterns {
+		err := pattern.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/command/domain"
+)
+
+func TestErrorPattern_Validate(t *testing.T) {
+	t.Run("Should accept plain text and regular expressions", func(t *testing.T) {
+		// Arrange
+		patterns := []domain.ErrorPattern{
+			{Pattern: "panic:"},
+			{Pattern: `WARN\s+deprecated`, Regex: true, Severity: domain.ErrorPatternSeverityWarning, Label: "Deprecation"},
+			{Pattern: "(", Severity: domain.ErrorPatternSeverityInfo},
+		}
+
+		for _, pattern := range patterns {
+			// Act
+			err := pattern.Validate()
+
+			// Assert
+			assert.NoError(t, err)
+		}
+	})
+	t.Run("Should reject invalid patterns", func(t *testing.T) {
+		// Arrange
+		patterns := []domain.ErrorPattern{
+			{Pattern: ""},
+			{Pattern: "(", Regex: true},
+			{Pattern: "panic:", Severity: "fatal"},
+		}
+
+		for _, pattern := range patterns {
+			// Act
+			err := pattern.Validate()
+
+			// Assert
+			assert.ErrorIs(t, err, domain.ErrInvalidErrorPattern)
+		}
+	})
+	t.Run("Should default to the error severity", func(t *testing.T) {
+		// Arrange
+		pattern := domain.ErrorPattern{Pattern: "panic:"}
+
+		// Act
+		severity := pattern.GetSeverity()
+
+		// Assert
+		assert.Equal(t, domain.ErrorPatternSeverityError, severity)
+	})
+}
@@ -4,17 +4,30 @@ import (
 	"github.com/google/uuid"
 
 	"gomander/internal/command/domain"
+	"gomander/internal/environment"
 )
 
 type CommandData struct {
-	Id               string
-	ProjectId        string
-	Name             string
-	Command          string
-	WorkingDirectory string
-	Position         int
-	Link             string
-	ErrorPatterns    []string
+	Id                   string
+	ProjectId            string
+	Name                 string
+	Command              string
+	WorkingDirectory     string
+	Position             int
+	Link                 string
+	ErrorPatterns        []domain.ErrorPattern
+	TerminalMode         bool
+	Interactive          bool
+	EnvironmentVariables []environment.Variable
+	EnvFiles             []string
+	RestartPolicy        domain.RestartPolicy
+	MaxRestarts          int
+	ReadinessProbe       *domain.ReadinessProbe
+	StopSignal           domain.StopSignal
+	StopTimeoutSeconds   int
+	StopCommand          string
+	RestartMode          domain.RestartMode
+	FileWatch            *domain.FileWatch
 }
 
 type CommandBuilder struct {
@@ -31,7 +44,8 @@ func NewCommandBuilder() *CommandBuilder {
 			WorkingDirectory: "/app",
 			Position:         0,
 			Link:             "",
-			ErrorPatterns:    []string{},
+			ErrorPatterns:    []domain.ErrorPattern{},
+			TerminalMode:     false,
 		},
 	}
 }
@@ -71,20 +85,80 @@ func (b *CommandBuilder) WithLink(link string) *CommandBuilder {
 	return b
 }
 
-func (b *CommandBuilder) WithErrorPatterns(patterns []string) *CommandBuilder {
+func (b *CommandBuilder) WithErrorPatterns(patterns ...domain.ErrorPattern) *CommandBuilder {
 	b.data.ErrorPatterns = patterns
 	return b
 }
 
+func (b *CommandBuilder) WithTerminalMode(terminalMode bool) *CommandBuilder {
+	b.data.TerminalMode = terminalMode
+	return b
+}
+
+func (b *CommandBuilder) WithInteractive(interactive bool) *CommandBuilder {
+	b.data.Interactive = interactive
+	return b
+}
+
+func (b *CommandBuilder) WithEnvironmentVariables(variables []environment.Variable) *CommandBuilder {
+	b.data.EnvironmentVariables = variables
+	return b
+}
+
+func (b *CommandBuilder) WithEnvFiles(envFiles []string) *CommandBuilder {
+	b.data.EnvFiles = envFiles
+	return b
+}
+
+func (b *CommandBuilder) WithRestartPolicy(policy domain.RestartPolicy, maxRestarts int) *CommandBuilder {
+	b.data.RestartPolicy = policy
+	b.data.MaxRestarts = maxRestarts
+	return b
+}
+
+func (b *CommandBuilder) WithReadinessProbe(probe *domain.ReadinessProbe) *CommandBuilder {
+	b.data.ReadinessProbe = probe
+	return b
+}
+
+func (b *CommandBuilder) WithStop(signal domain.StopSignal, timeoutSeconds int, stopCommand string) *CommandBuilder {
+	b.data.StopSignal = signal
+	b.data.StopTimeoutSeconds = timeoutSeconds
+	b.data.StopCommand = stopCommand
+	return b
+}
+
+func (b *CommandBuilder) WithRestartMode(mode domain.RestartMode) *CommandBuilder {
+	b.data.RestartMode = mode
+	return b
+}
+
+func (b *CommandBuilder) WithFileWatch(watch *domain.FileWatch) *CommandBuilder {
+	b.data.FileWatch = watch
+	return b
+}
+
 func (b *CommandBuilder) Build() domain.Command {
 	return domain.Command{
-		Id:               b.data.Id,
-		ProjectId:        b.data.ProjectId,
-		Name:             b.data.Name,
-		Command:          b.data.Command,
-		WorkingDirectory: b.data.WorkingDirectory,
-		Position:         b.data.Position,
-		Link:             b.data.Link,
-		ErrorPatterns:    b.data.ErrorPatterns,
+		Id:                   b.data.Id,
+		ProjectId:            b.data.ProjectId,
+		Name:                 b.data.Name,
+		Command:              b.data.Command,
+		WorkingDirectory:     b.data.WorkingDirectory,
+		Position:             b.data.Position,
+		Link:                 b.data.Link,
+		ErrorPatterns:        b.data.ErrorPatterns,
+		TerminalMode:         b.data.TerminalMode,
+		Interactive:          b.data.Interactive,
+		EnvironmentVariables: b.data.EnvironmentVariables,
+		EnvFiles:             b.data.EnvFiles,
+		RestartPolicy:        b.data.RestartPolicy,
+		MaxRestarts:          b.data.MaxRestarts,
+		ReadinessProbe:       b.data.ReadinessProbe,
+		StopSignal:           b.data.StopSignal,
+		StopTimeoutSeconds:   b.data.StopTimeoutSeconds,
+		StopCommand:          b.data.StopCommand,
+		RestartMode:          b.data.RestartMode,
+		FileWatch:            b.data.FileWatch,
 	}
 }
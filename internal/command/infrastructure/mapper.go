@@ -1,34 +1,142 @@
 package infrastructure
 
 import (
-	"strings"
+	"encoding/json"
 
 	"gomander/internal/command/domain"
-	"gomander/internal/helpers/array"
+	"gomander/internal/environment"
 )
 
 func ToDomainCommand(commandModel CommandModel) domain.Command {
 	return domain.Command{
-		Id:               commandModel.Id,
-		Name:             commandModel.Name,
-		Command:          commandModel.Command,
-		WorkingDirectory: commandModel.WorkingDirectory,
-		Position:         commandModel.Position,
-		ProjectId:        commandModel.ProjectId,
-		Link:             commandModel.Link,
-		ErrorPatterns:    array.Filter(strings.Split(commandModel.ErrorPatterns, "\n"), func(s string) bool { return s != "" }),
+		Id:                   commandModel.Id,
+		Name:                 commandModel.Name,
+		Command:              commandModel.Command,
+		WorkingDirectory:     commandModel.WorkingDirectory,
+		Position:             commandModel.Position,
+		ProjectId:            commandModel.ProjectId,
+		Link:                 commandModel.Link,
+		ErrorPatterns:        unmarshalErrorPatterns(commandModel.ErrorPatterns),
+		TerminalMode:         commandModel.TerminalMode,
+		Interactive:          commandModel.Interactive,
+		EnvironmentVariables: environment.UnmarshalVariables(commandModel.EnvironmentVariables),
+		EnvFiles:             environment.UnmarshalEnvFiles(commandModel.EnvFiles),
+		RestartPolicy:        domain.RestartPolicy(commandModel.RestartPolicy),
+		MaxRestarts:          commandModel.MaxRestarts,
+		ReadinessProbe:       unmarshalReadinessProbe(commandModel.ReadinessProbe),
+		StopSignal:           domain.StopSignal(commandModel.StopSignal),
+		StopTimeoutSeconds:   commandModel.StopTimeoutSeconds,
+		StopCommand:          commandModel.StopCommand,
+		RestartMode:          domain.RestartMode(commandModel.RestartMode),
+		FileWatch:            unmarshalFileWatch(commandModel.FileWatch),
 	}
 }
 
 func ToCommandModel(domainCommand *domain.Command) CommandModel {
 	return CommandModel{
-		Id:               domainCommand.Id,
-		Name:             domainCommand.Name,
-		Command:          domainCommand.Command,
-		WorkingDirectory: domainCommand.WorkingDirectory,
-		Position:         domainCommand.Position,
-		ProjectId:        domainCommand.ProjectId,
-		Link:             domainCommand.Link,
-		ErrorPatterns:    strings.Join(domainCommand.ErrorPatterns, "\n"),
+		Id:                   domainCommand.Id,
+		Name:                 domainCommand.Name,
+		Command:              domainCommand.Command,
+		WorkingDirectory:     domainCommand.WorkingDirectory,
+		Position:             domainCommand.Position,
+		ProjectId:            domainCommand.ProjectId,
+		Link:                 domainCommand.Link,
+		ErrorPatterns:        marshalErrorPatterns(domainCommand.ErrorPatterns),
+		TerminalMode:         domainCommand.TerminalMode,
+		Interactive:          domainCommand.Interactive,
+		EnvironmentVariables: environment.MarshalVariables(domainCommand.EnvironmentVariables),
+		EnvFiles:             environment.MarshalEnvFiles(domainCommand.EnvFiles),
+		RestartPolicy:        string(domainCommand.RestartPolicy),
+		MaxRestarts:          domainCommand.MaxRestarts,
+		ReadinessProbe:       marshalReadinessProbe(domainCommand.ReadinessProbe),
+		StopSignal:           string(domainCommand.StopSignal),
+		StopTimeoutSeconds:   domainCommand.StopTimeoutSeconds,
+		StopCommand:          domainCommand.StopCommand,
+		RestartMode:          string(domainCommand.RestartMode),
+		FileWatch:            marshalFileWatch(domainCommand.FileWatch),
 	}
 }
+
+func marshalReadinessProbe(probe *domain.ReadinessProbe) string {
+	if probe == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(probe)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// unmarshalReadinessProbe treats empty or invalid data as no probe at all.
+func unmarshalReadinessProbe(data string) *domain.ReadinessProbe {
+	if data == "" {
+		return nil
+	}
+
+	var probe domain.ReadinessProbe
+	err := json.Unmarshal([]byte(data), &probe)
+	if err != nil {
+		return nil
+	}
+
+	return &probe
+}
+
+func marshalFileWatch(watch *domain.FileWatch) string {
+	if watch == nil {
+		return ""
+	}
+
+	data, err := json.Marshal(watch)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// unmarshalFileWatch treats empty or invalid data as no file watch at all.
+func unmarshalFileWatch(data string) *domain.FileWatch {
+	if data == "" {
+		return nil
+	}
+
+	var watch domain.FileWatch
+	err := json.Unmarshal([]byte(data), &watch)
+	if err != nil {
+		return nil
+	}
+
+	return &watch
+}
+
+func marshalErrorPatterns(patterns []domain.ErrorPattern) string {
+	if len(patterns) == 0 {
+		return ""
+	}
+
+	data, err := json.Marshal(patterns)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// unmarshalErrorPatterns treats empty or invalid data as no patterns at all.
+func unmarshalErrorPatterns(data string) []domain.ErrorPattern {
+	patterns := make([]domain.ErrorPattern, 0)
+	if data == "" {
+		return patterns
+	}
+
+	err := json.Unmarshal([]byte(data), &patterns)
+	if err != nil {
+		return make([]domain.ErrorPattern, 0)
+	}
+
+	return patterns
+}
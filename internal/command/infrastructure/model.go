@@ -1,14 +1,26 @@
 package infrastructure
 
 type CommandModel struct {
-	Id               string `gorm:"primaryKey;column:id"`
-	ProjectId        string `gorm:"column:project_id"`
-	Name             string `gorm:"column:name"`
-	Command          string `gorm:"column:command"`
-	WorkingDirectory string `gorm:"column:working_directory"`
-	Position         int    `gorm:"column:position"`
-	Link             string `gorm:"column:link"`
-	ErrorPatterns    string `gorm:"column:error_patterns"`
+	Id                   string `gorm:"primaryKey;column:id"`
+	ProjectId            string `gorm:"column:project_id"`
+	Name                 string `gorm:"column:name"`
+	Command              string `gorm:"column:command"`
+	WorkingDirectory     string `gorm:"column:working_directory"`
+	Position             int    `gorm:"column:position"`
+	Link                 string `gorm:"column:link"`
+	ErrorPatterns        string `gorm:"column:error_patterns"`
+	TerminalMode         bool   `gorm:"column:terminal_mode"`
+	Interactive          bool   `gorm:"column:interactive"`
+	EnvironmentVariables string `gorm:"column:environment_variables"`
+	EnvFiles             string `gorm:"column:env_files"`
+	RestartPolicy        string `gorm:"column:restart_policy"`
+	MaxRestarts          int    `gorm:"column:max_restarts"`
+	ReadinessProbe       string `gorm:"column:readiness_probe"`
+	StopSignal           string `gorm:"column:stop_signal"`
+	StopTimeoutSeconds   int    `gorm:"column:stop_timeout_seconds"`
+	StopCommand          string `gorm:"column:stop_command"`
+	RestartMode          string `gorm:"column:restart_mode"`
+	FileWatch            string `gorm:"column:file_watch"`
 }
 
 func (CommandModel) TableName() string {
@@ -13,6 +13,7 @@ import (
 	"gomander/internal/command/domain/test"
 
 	"gomander/internal/command/domain"
+	"gomander/internal/environment"
 	_ "gomander/migrations"
 )
 
@@ -133,6 +134,39 @@ func TestGormCommandRepository_Save(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, &cmd, actual)
 	})
+
+	t.Run("Should save a new command with its runtime settings", func(t *testing.T) {
+		// Arrange
+		var preloadedCommandModels []*CommandModel
+		h := newTestHelper(t, preloadedCommandModels)
+
+		cmd := test.NewCommandBuilder().
+			WithEnvironmentVariables([]environment.Variable{
+				{Key: "FOO", Value: "bar"},
+				{Key: "MULTILINE", Value: "a\nb"},
+			}).
+			WithEnvFiles([]string{".env", "config/.env.local"}).
+			WithRestartPolicy(domain.RestartPolicyOnFailure, 5).
+			WithReadinessProbe(&domain.ReadinessProbe{Type: domain.ReadinessProbeTCP, Target: "5432", TimeoutSeconds: 30}).
+			WithStop(domain.StopSignalInterrupt, 30, "docker compose down").
+			WithRestartMode(domain.RestartModeReload).
+			WithFileWatch(&domain.FileWatch{Patterns: []string{"**/*.go", "go.mod"}, Ignore: []string{"*_test.go"}, DebounceMs: 500}).
+			WithErrorPatterns(
+				domain.ErrorPattern{Pattern: "panic:", Severity: domain.ErrorPatternSeverityError},
+				domain.ErrorPattern{Pattern: `WARN\s+deprecated`, Regex: true, Severity: domain.ErrorPatternSeverityWarning, Label: "Deprecation"},
+			).
+			Build()
+
+		// Act
+		err := h.repo.Create(&cmd)
+
+		// Assert
+		assert.NoError(t, err)
+
+		actual, err := h.repo.Get(cmd.Id)
+		assert.NoError(t, err)
+		assert.Equal(t, &cmd, actual)
+	})
 }
 
 func TestGormCommandRepository_Edit(t *testing.T) {
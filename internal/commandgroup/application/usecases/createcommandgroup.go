@@ -22,6 +22,11 @@ func NewCreateCommandGroup(configRepo configdomain.Repository, commandGroupRepo
 }
 
 func (uc *DefaultCreateCommandGroup) Execute(commandGroup *domain.CommandGroup) error {
+	err := commandGroup.ValidateDependencies()
+	if err != nil {
+		return err
+	}
+
 	userConfig, err := uc.configRepository.GetOrCreate()
 	if err != nil {
 		return err
@@ -58,6 +58,33 @@ func TestDefaultCreateCommandGroup_Execute(t *testing.T) {
 		)
 	})
 
+	t.Run("Should return an error if the dependencies form a cycle", func(t *testing.T) {
+		// Arrange
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+
+		sut := usecases.NewCreateCommandGroup(mockUserConfigRepository, mockCommandGroupRepository)
+
+		api := test.NewCommandBuilder().WithId("api").Build()
+		database := test.NewCommandBuilder().WithId("database").Build()
+
+		paramCommandGroup := test2.NewCommandGroupBuilder().
+			WithCommands(api, database).
+			WithDependencies(
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionRunning},
+				domain.CommandDependency{CommandId: database.Id, DependsOnId: api.Id, Condition: domain.DependencyConditionRunning},
+			).
+			Build()
+
+		// Act
+		err := sut.Execute(&paramCommandGroup)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+
+		mockCommandGroupRepository.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
 	t.Run("Should return an error if failing to retrieve user config", func(t *testing.T) {
 		// Arrange
 		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
@@ -0,0 +1,22 @@
+package usecases
+
+import "gomander/internal/runner"
+
+type GetPipelineStates interface {
+	Execute() map[string]runner.PipelineState
+}
+
+type DefaultGetPipelineStates struct {
+	runner runner.Runner
+}
+
+func NewGetPipelineStates(runner runner.Runner) *DefaultGetPipelineStates {
+	return &DefaultGetPipelineStates{
+		runner: runner,
+	}
+}
+
+// Execute returns the state of the current or last run of the command groups run as pipelines, by group id.
+func (uc *DefaultGetPipelineStates) Execute() map[string]runner.PipelineState {
+	return uc.runner.GetPipelineStates()
+}
@@ -0,0 +1,32 @@
+package usecases_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/commandgroup/application/usecases"
+	"gomander/internal/runner"
+	"gomander/internal/runner/test"
+)
+
+func TestDefaultGetPipelineStates_Execute(t *testing.T) {
+	t.Run("Should return the state of each pipeline", func(t *testing.T) {
+		// Arrange
+		mockRunner := new(test.MockRunner)
+		sut := usecases.NewGetPipelineStates(mockRunner)
+
+		expectedStates := map[string]runner.PipelineState{
+			"group-1": {Id: "group-1", Status: runner.PipelineStatusRunning, CurrentCommandId: "cmd-1", CurrentStep: 1, TotalSteps: 3},
+			"group-2": {Id: "group-2", Status: runner.PipelineStatusFailed, FailedCommandIds: []string{"cmd-2"}},
+		}
+		mockRunner.On("GetPipelineStates").Return(expectedStates)
+
+		// Act
+		result := sut.Execute()
+
+		// Assert
+		assert.Equal(t, expectedStates, result)
+		mockRunner.AssertExpectations(t)
+	})
+}
@@ -3,9 +3,7 @@ package usecases
 import (
 	"errors"
 
-	commanddomain "gomander/internal/command/domain"
 	"gomander/internal/commandgroup/domain"
-	"gomander/internal/helpers/array"
 )
 
 type RemoveCommandFromCommandGroup interface {
@@ -31,9 +29,7 @@ func (uc *DefaultRemoveCommandFromCommandGroup) Execute(commandId, commandGroupI
 		return errors.New("cannot remove the last command from the group; delete the group instead")
 	}
 
-	commandGroup.Commands = array.Filter(commandGroup.Commands, func(cmd commanddomain.Command) bool {
-		return cmd.Id != commandId
-	})
+	commandGroup.RemoveCommand(commandId)
 
 	return uc.commandGroupRepository.Update(commandGroup)
 }
@@ -0,0 +1,55 @@
+package usecases
+
+import (
+	"gomander/internal/command/domain"
+	commandgroupdomain "gomander/internal/commandgroup/domain"
+	configdomain "gomander/internal/config/domain"
+	projectdomain "gomander/internal/project/domain"
+	"gomander/internal/runner"
+)
+
+type RestartCommandGroup interface {
+	Execute(commandGroupId string) error
+}
+
+type DefaultRestartCommandGroup struct {
+	configRepository       configdomain.Repository
+	commandRepository      domain.Repository
+	commandGroupRepository commandgroupdomain.Repository
+	projectRepository      projectdomain.Repository
+	commandRunner          runner.Runner
+}
+
+func NewRestartCommandGroup(
+	configRepo configdomain.Repository,
+	commandRepo domain.Repository,
+	commandGroupRepo commandgroupdomain.Repository,
+	projectRepo projectdomain.Repository,
+	runner runner.Runner,
+) *DefaultRestartCommandGroup {
+	return &DefaultRestartCommandGroup{
+		configRepository:       configRepo,
+		commandRepository:      commandRepo,
+		commandGroupRepository: commandGroupRepo,
+		projectRepository:      projectRepo,
+		commandRunner:          runner,
+	}
+}
+
+// Execute stops the commands of the group, dependent commands first, and runs the group again once all of them
+// exited, so no command starts while another one of the group is still shutting down.
+func (uc *DefaultRestartCommandGroup) Execute(commandGroupId string) error {
+	cmdGroup, err := uc.commandGroupRepository.Get(commandGroupId)
+	if err != nil {
+		return err
+	}
+
+	runOptions, err := groupRunOptions(uc.configRepository, uc.projectRepository, cmdGroup)
+	if err != nil {
+		return err
+	}
+
+	return uc.commandRunner.RestartCommandGroup(cmdGroup.Id, cmdGroup.StopOrder(), func() error {
+		return runCommandGroup(uc.commandRunner, cmdGroup, runOptions)
+	})
+}
@@ -0,0 +1,168 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/domain"
+	"gomander/internal/command/domain/test"
+	"gomander/internal/commandgroup/application/usecases"
+	commandgroupdomain "gomander/internal/commandgroup/domain"
+	test2 "gomander/internal/commandgroup/domain/test"
+	commandrundomain "gomander/internal/commandrun/domain"
+	configdomain "gomander/internal/config/domain"
+	test3 "gomander/internal/config/domain/test"
+	projectdomain "gomander/internal/project/domain"
+	test4 "gomander/internal/project/domain/test"
+	"gomander/internal/runner"
+	test5 "gomander/internal/runner/test"
+)
+
+func TestDefaultRestartCommandGroup_Execute(t *testing.T) {
+	t.Run("Should stop the commands in stop order and run the group again", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test4.MockProjectRepository)
+		mockRunner := new(test5.MockRunner)
+
+		projectId := "project1"
+		sut := usecases.NewRestartCommandGroup(
+			mockUserConfigRepository,
+			mockCommandRepository,
+			mockCommandGroupRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+
+		mockUserConfigRepository.On("GetOrCreate").Return(&configdomain.Config{
+			LastOpenedProjectId: projectId,
+		}, nil)
+
+		api := test.NewCommandBuilder().WithId("api").WithProjectId(projectId).Build()
+		database := test.NewCommandBuilder().WithId("database").WithProjectId(projectId).Build()
+
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithId("group1").
+			WithProjectId(projectId).
+			WithCommands(api, database).
+			WithDependencies(commandgroupdomain.CommandDependency{
+				CommandId:   api.Id,
+				DependsOnId: database.Id,
+				Condition:   commandgroupdomain.DependencyConditionReady,
+			}).
+			Build()
+
+		mockCommandGroupRepository.On("Get", cmdGroup.Id).Return(&cmdGroup, nil)
+
+		project := projectdomain.Project{
+			Id:               projectId,
+			Name:             "Test Project",
+			WorkingDirectory: "/working/dir",
+		}
+		mockProjectRepository.On("Get", cmdGroup.ProjectId).Return(&project, nil)
+
+		runOptions := runner.RunOptions{EnvironmentPaths: []string{}, BaseWorkingDirectory: project.WorkingDirectory, Trigger: commandrundomain.TriggerGroup}
+		var startErr error
+		mockRunner.On("RestartCommandGroup", cmdGroup.Id, []domain.Command{api, database}, mock.Anything).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				startErr = args.Get(2).(func() error)()
+			})
+		mockRunner.On(
+			"RunCommandsWithDependencies",
+			[]domain.Command{database, api},
+			map[string][]runner.Dependency{api.Id: {{CommandId: database.Id, Condition: commandgroupdomain.DependencyConditionReady}}},
+			runOptions,
+		).Return(nil)
+
+		// Act
+		err := sut.Execute(cmdGroup.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, startErr)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandGroupRepository,
+			mockUserConfigRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should return an error if failing to retrieve the command group", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test4.MockProjectRepository)
+		mockRunner := new(test5.MockRunner)
+
+		sut := usecases.NewRestartCommandGroup(
+			mockUserConfigRepository,
+			mockCommandRepository,
+			mockCommandGroupRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+
+		cmdGroupId := "group1"
+
+		mockCommandGroupRepository.On("Get", cmdGroupId).Return(nil, errors.New("command group not found"))
+
+		// Act
+		err := sut.Execute(cmdGroupId)
+
+		// Assert
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandGroupRepository,
+		)
+	})
+
+	t.Run("Should return an error if failing to restart the commands", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test4.MockProjectRepository)
+		mockRunner := new(test5.MockRunner)
+
+		projectId := "project1"
+		sut := usecases.NewRestartCommandGroup(
+			mockUserConfigRepository,
+			mockCommandRepository,
+			mockCommandGroupRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+
+		mockUserConfigRepository.On("GetOrCreate").Return(&configdomain.Config{LastOpenedProjectId: projectId}, nil)
+
+		cmd := test.NewCommandBuilder().WithProjectId(projectId).Build()
+		cmdGroup := test2.NewCommandGroupBuilder().WithId("group1").WithProjectId(projectId).WithCommands(cmd).Build()
+
+		mockCommandGroupRepository.On("Get", cmdGroup.Id).Return(&cmdGroup, nil)
+		mockProjectRepository.On("Get", projectId).Return(&projectdomain.Project{Id: projectId}, nil)
+		mockRunner.On("RestartCommandGroup", cmdGroup.Id, []domain.Command{cmd}, mock.Anything).Return(errors.New("failed to stop the commands"))
+
+		// Act
+		err := sut.Execute(cmdGroup.Id)
+
+		// Assert
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandGroupRepository,
+			mockUserConfigRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+	})
+}
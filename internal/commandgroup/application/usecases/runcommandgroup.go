@@ -3,6 +3,7 @@ package usecases
 import (
 	"gomander/internal/command/domain"
 	commandgroupdomain "gomander/internal/commandgroup/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
 	configdomain "gomander/internal/config/domain"
 	"gomander/internal/helpers/array"
 	projectdomain "gomander/internal/project/domain"
@@ -43,24 +44,63 @@ func (uc *DefaultRunCommandGroup) Execute(commandGroupId string) error {
 		return err
 	}
 
-	userConfig, err := uc.configRepository.GetOrCreate()
+	runOptions, err := groupRunOptions(uc.configRepository, uc.projectRepository, cmdGroup)
 	if err != nil {
 		return err
 	}
 
-	currentProject, err := uc.projectRepository.Get(cmdGroup.ProjectId)
+	return runCommandGroup(uc.commandRunner, cmdGroup, runOptions)
+}
+
+// groupRunOptions returns the options to run the commands of the group with the settings of its project.
+func groupRunOptions(
+	configRepo configdomain.Repository,
+	projectRepo projectdomain.Repository,
+	cmdGroup *commandgroupdomain.CommandGroup,
+) (runner.RunOptions, error) {
+	userConfig, err := configRepo.GetOrCreate()
 	if err != nil {
-		return err
+		return runner.RunOptions{}, err
+	}
+
+	currentProject, err := projectRepo.Get(cmdGroup.ProjectId)
+	if err != nil {
+		return runner.RunOptions{}, err
 	}
 
 	environmentPathsStrings := array.Map(userConfig.EnvironmentPaths, func(ep configdomain.EnvironmentPath) string {
 		return ep.Path
 	})
 
-	err = uc.commandRunner.RunCommands(cmdGroup.Commands, environmentPathsStrings, currentProject.WorkingDirectory)
-	if err != nil {
-		return err
+	return runner.RunOptions{
+		EnvironmentPaths:     environmentPathsStrings,
+		BaseWorkingDirectory: currentProject.WorkingDirectory,
+		EnvironmentVariables: currentProject.EnvironmentVariables,
+		EnvFiles:             currentProject.EnvFiles,
+		Trigger:              commandrundomain.TriggerGroup,
+	}, nil
+}
+
+// runCommandGroup runs the commands of the group the way its mode says.
+func runCommandGroup(commandRunner runner.Runner, cmdGroup *commandgroupdomain.CommandGroup, runOptions runner.RunOptions) error {
+	switch {
+	case cmdGroup.Mode == commandgroupdomain.ModePipeline:
+		// Dependencies only constrain the order of the steps of a pipeline
+		return commandRunner.RunPipeline(cmdGroup.Id, cmdGroup.StartOrder(), cmdGroup.ContinueOnFailure, runOptions)
+	case len(cmdGroup.Dependencies) == 0:
+		return commandRunner.RunCommands(cmdGroup.Commands, runOptions)
+	default:
+		return commandRunner.RunCommandsWithDependencies(cmdGroup.StartOrder(), toRunnerDependencies(cmdGroup), runOptions)
 	}
+}
 
-	return nil
+func toRunnerDependencies(cmdGroup *commandgroupdomain.CommandGroup) map[string][]runner.Dependency {
+	dependencies := make(map[string][]runner.Dependency)
+	for _, dependency := range cmdGroup.Dependencies {
+		dependencies[dependency.CommandId] = append(dependencies[dependency.CommandId], runner.Dependency{
+			CommandId: dependency.DependsOnId,
+			Condition: dependency.Condition,
+		})
+	}
+	return dependencies
 }
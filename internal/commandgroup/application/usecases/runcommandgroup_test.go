@@ -8,13 +8,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"gomander/internal/command/domain"
 	"gomander/internal/command/domain/test"
 	"gomander/internal/commandgroup/application/usecases"
+	commandgroupdomain "gomander/internal/commandgroup/domain"
 	test2 "gomander/internal/commandgroup/domain/test"
+	commandrundomain "gomander/internal/commandrun/domain"
 	configdomain "gomander/internal/config/domain"
 	test3 "gomander/internal/config/domain/test"
 	projectdomain "gomander/internal/project/domain"
 	test4 "gomander/internal/project/domain/test"
+	"gomander/internal/runner"
 	test5 "gomander/internal/runner/test"
 )
 
@@ -69,7 +73,7 @@ func TestDefaultRunCommandGroup_Execute(t *testing.T) {
 		}
 		mockProjectRepository.On("Get", cmdGroup.ProjectId).Return(&project, nil)
 
-		mockRunner.On("RunCommands", cmdGroup.Commands, []string{"/1"}, project.WorkingDirectory).Return(nil)
+		mockRunner.On("RunCommands", cmdGroup.Commands, runner.RunOptions{EnvironmentPaths: []string{"/1"}, BaseWorkingDirectory: project.WorkingDirectory, Trigger: commandrundomain.TriggerGroup}).Return(nil)
 
 		// Act
 		err := sut.Execute(cmdGroup.Id)
@@ -86,6 +90,136 @@ func TestDefaultRunCommandGroup_Execute(t *testing.T) {
 		)
 	})
 
+	t.Run("Should run the command group in dependency order when commands depend on each other", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test4.MockProjectRepository)
+		mockRunner := new(test5.MockRunner)
+
+		projectId := "project1"
+		sut := usecases.NewRunCommandGroup(
+			mockUserConfigRepository,
+			mockCommandRepository,
+			mockCommandGroupRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+
+		mockUserConfigRepository.On("GetOrCreate").Return(&configdomain.Config{
+			LastOpenedProjectId: projectId,
+		}, nil)
+
+		api := test.NewCommandBuilder().WithId("api").WithProjectId(projectId).Build()
+		database := test.NewCommandBuilder().WithId("database").WithProjectId(projectId).Build()
+
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithId("group1").
+			WithProjectId(projectId).
+			WithCommands(api, database).
+			WithDependencies(commandgroupdomain.CommandDependency{
+				CommandId:   api.Id,
+				DependsOnId: database.Id,
+				Condition:   commandgroupdomain.DependencyConditionReady,
+			}).
+			Build()
+
+		mockCommandGroupRepository.On("Get", cmdGroup.Id).Return(&cmdGroup, nil)
+
+		project := projectdomain.Project{
+			Id:               projectId,
+			Name:             "Test Project",
+			WorkingDirectory: "/working/dir",
+		}
+		mockProjectRepository.On("Get", cmdGroup.ProjectId).Return(&project, nil)
+
+		expectedDependencies := map[string][]runner.Dependency{
+			api.Id: {{CommandId: database.Id, Condition: commandgroupdomain.DependencyConditionReady}},
+		}
+		mockRunner.On(
+			"RunCommandsWithDependencies",
+			[]domain.Command{database, api},
+			expectedDependencies,
+			runner.RunOptions{EnvironmentPaths: []string{}, BaseWorkingDirectory: project.WorkingDirectory, Trigger: commandrundomain.TriggerGroup},
+		).Return(nil)
+
+		// Act
+		err := sut.Execute(cmdGroup.Id)
+
+		// Assert
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandGroupRepository,
+			mockUserConfigRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should run the command group as a pipeline", func(t *testing.T) {
+		// Arrange
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+		mockUserConfigRepository := new(test3.MockConfigRepository)
+		mockProjectRepository := new(test4.MockProjectRepository)
+		mockRunner := new(test5.MockRunner)
+
+		projectId := "project1"
+		sut := usecases.NewRunCommandGroup(
+			mockUserConfigRepository,
+			mockCommandRepository,
+			mockCommandGroupRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+
+		mockUserConfigRepository.On("GetOrCreate").Return(&configdomain.Config{
+			LastOpenedProjectId: projectId,
+		}, nil)
+
+		lint := test.NewCommandBuilder().WithId("lint").WithProjectId(projectId).Build()
+		build := test.NewCommandBuilder().WithId("build").WithProjectId(projectId).Build()
+
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithId("group1").
+			WithProjectId(projectId).
+			WithCommands(lint, build).
+			WithMode(commandgroupdomain.ModePipeline, true).
+			Build()
+
+		mockCommandGroupRepository.On("Get", cmdGroup.Id).Return(&cmdGroup, nil)
+
+		project := projectdomain.Project{
+			Id:               projectId,
+			Name:             "Test Project",
+			WorkingDirectory: "/working/dir",
+		}
+		mockProjectRepository.On("Get", cmdGroup.ProjectId).Return(&project, nil)
+
+		mockRunner.On(
+			"RunPipeline",
+			cmdGroup.Id,
+			[]domain.Command{lint, build},
+			true,
+			runner.RunOptions{EnvironmentPaths: []string{}, BaseWorkingDirectory: project.WorkingDirectory, Trigger: commandrundomain.TriggerGroup},
+		).Return(nil)
+
+		// Act
+		err := sut.Execute(cmdGroup.Id)
+
+		// Assert
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandGroupRepository,
+			mockUserConfigRepository,
+			mockProjectRepository,
+			mockRunner,
+		)
+	})
+
 	t.Run("Should return an error if failing to retrieve the command group", func(t *testing.T) {
 		// Arrange
 		mockCommandRepository := new(test.MockCommandRepository)
@@ -236,7 +370,7 @@ func TestDefaultRunCommandGroup_Execute(t *testing.T) {
 		}
 		mockProjectRepository.On("Get", cmdGroup.ProjectId).Return(&project, nil)
 
-		mockRunner.On("RunCommands", cmdGroup.Commands, []string{"/1"}, project.WorkingDirectory).
+		mockRunner.On("RunCommands", cmdGroup.Commands, runner.RunOptions{EnvironmentPaths: []string{"/1"}, BaseWorkingDirectory: project.WorkingDirectory, Trigger: commandrundomain.TriggerGroup}).
 			Return(errors.New("failed to run commands"))
 
 		// Act
@@ -30,7 +30,13 @@ func (uc *DefaultStopCommandGroup) Execute(commandGroupId string) error {
 		return err
 	}
 
-	err = uc.commandRunner.StopRunningCommands(cmdGroup.Commands)
+	if cmdGroup.Mode == commandgroupdomain.ModePipeline {
+		// Otherwise stopping the current step would just make the pipeline move on or fail
+		uc.commandRunner.StopPipeline(cmdGroup.Id)
+	}
+
+	// Dependent commands are stopped first
+	err = uc.commandRunner.StopRunningCommands(cmdGroup.StopOrder())
 	if err != nil {
 		return err
 	}
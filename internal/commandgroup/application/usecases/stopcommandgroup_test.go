@@ -8,8 +8,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"gomander/internal/command/domain"
 	"gomander/internal/command/domain/test"
 	"gomander/internal/commandgroup/application/usecases"
+	commandgroupdomain "gomander/internal/commandgroup/domain"
 	test2 "gomander/internal/commandgroup/domain/test"
 	test3 "gomander/internal/runner/test"
 )
@@ -50,6 +52,80 @@ func TestDefaultStopCommandGroup_Execute(t *testing.T) {
 		)
 	})
 
+	t.Run("Should stop dependent commands before the commands they depend on", func(t *testing.T) {
+		// Arrange
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+		mockRunner := new(test3.MockRunner)
+
+		sut := usecases.NewStopCommandGroup(mockCommandGroupRepository, mockRunner)
+
+		database := test.NewCommandBuilder().WithId("database").Build()
+		api := test.NewCommandBuilder().WithId("api").Build()
+		docs := test.NewCommandBuilder().WithId("docs").Build()
+
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithId("group1").
+			WithCommands(database, api, docs).
+			WithDependencies(commandgroupdomain.CommandDependency{
+				CommandId:   database.Id,
+				DependsOnId: api.Id,
+				Condition:   commandgroupdomain.DependencyConditionRunning,
+			}).
+			Build()
+
+		mockCommandGroupRepository.On("Get", cmdGroup.Id).Return(&cmdGroup, nil)
+		mockRunner.On("StopRunningCommands", []domain.Command{docs, database, api}).Return(nil)
+
+		// Act
+		err := sut.Execute(cmdGroup.Id)
+
+		// Assert
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandGroupRepository,
+			mockRunner,
+		)
+	})
+
+	t.Run("Should stop the pipeline before stopping its commands", func(t *testing.T) {
+		// Arrange
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+		mockRunner := new(test3.MockRunner)
+
+		sut := usecases.NewStopCommandGroup(mockCommandGroupRepository, mockRunner)
+
+		lint := test.NewCommandBuilder().WithId("lint").Build()
+		build := test.NewCommandBuilder().WithId("build").Build()
+
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithId("group1").
+			WithCommands(lint, build).
+			WithMode(commandgroupdomain.ModePipeline, false).
+			Build()
+
+		var calls []string
+		mockCommandGroupRepository.On("Get", cmdGroup.Id).Return(&cmdGroup, nil)
+		mockRunner.On("StopPipeline", cmdGroup.Id).Run(func(args mock.Arguments) {
+			calls = append(calls, "StopPipeline")
+		}).Return()
+		mockRunner.On("StopRunningCommands", []domain.Command{build, lint}).Run(func(args mock.Arguments) {
+			calls = append(calls, "StopRunningCommands")
+		}).Return(nil)
+
+		// Act
+		err := sut.Execute(cmdGroup.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"StopPipeline", "StopRunningCommands"}, calls)
+
+		mock.AssertExpectationsForObjects(t,
+			mockCommandGroupRepository,
+			mockRunner,
+		)
+	})
+
 	t.Run("Should return an error if failing to retrieve the command group", func(t *testing.T) {
 		// Arrange
 		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/commandgroup/domain"
+)
+
+type MockCreateCommandGroup struct {
+	mock.Mock
+}
+
+func (m *MockCreateCommandGroup) Execute(commandGroup *domain.CommandGroup) error {
+	args := m.Called(commandGroup)
+	return args.Error(0)
+}
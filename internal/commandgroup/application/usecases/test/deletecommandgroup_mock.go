@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockDeleteCommandGroup struct {
+	mock.Mock
+}
+
+func (m *MockDeleteCommandGroup) Execute(commandGroupId string) error {
+	args := m.Called(commandGroupId)
+	return args.Error(0)
+}
@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/runner"
+)
+
+type MockGetPipelineStates struct {
+	mock.Mock
+}
+
+func (m *MockGetPipelineStates) Execute() map[string]runner.PipelineState {
+	args := m.Called()
+	return args.Get(0).(map[string]runner.PipelineState)
+}
@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockRemoveCommandFromCommandGroup struct {
+	mock.Mock
+}
+
+func (m *MockRemoveCommandFromCommandGroup) Execute(commandId, commandGroupId string) error {
+	args := m.Called(commandId, commandGroupId)
+	return args.Error(0)
+}
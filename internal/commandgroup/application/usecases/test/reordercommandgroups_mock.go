@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockReorderCommandGroups struct {
+	mock.Mock
+}
+
+func (m *MockReorderCommandGroups) Execute(newOrderedIds []string) error {
+	args := m.Called(newOrderedIds)
+	return args.Error(0)
+}
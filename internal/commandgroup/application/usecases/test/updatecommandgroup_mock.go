@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/commandgroup/domain"
+)
+
+type MockUpdateCommandGroup struct {
+	mock.Mock
+}
+
+func (m *MockUpdateCommandGroup) Execute(commandGroup *domain.CommandGroup) error {
+	args := m.Called(commandGroup)
+	return args.Error(0)
+}
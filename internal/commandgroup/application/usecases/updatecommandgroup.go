@@ -1,6 +1,9 @@
 package usecases
 
 import (
+	"slices"
+
+	commanddomain "gomander/internal/command/domain"
 	"gomander/internal/commandgroup/domain"
 )
 
@@ -18,6 +21,34 @@ func NewUpdateCommandGroup(commandGroupRepo domain.Repository) *DefaultUpdateCom
 	}
 }
 
+// Execute saves the command group. When its dependencies are omitted, the saved ones are kept,
+// except for those referencing commands no longer in the group.
 func (uc *DefaultUpdateCommandGroup) Execute(commandGroup *domain.CommandGroup) error {
+	if commandGroup.Dependencies == nil {
+		existingGroup, err := uc.commandGroupRepository.Get(commandGroup.Id)
+		if err != nil {
+			return err
+		}
+		if existingGroup != nil {
+			commandGroup.Dependencies = keptDependencies(existingGroup.Dependencies, commandGroup.Commands)
+		}
+	}
+
+	err := commandGroup.ValidateDependencies()
+	if err != nil {
+		return err
+	}
+
 	return uc.commandGroupRepository.Update(commandGroup)
 }
+
+func keptDependencies(dependencies []domain.CommandDependency, commands []commanddomain.Command) []domain.CommandDependency {
+	inGroup := make(map[string]bool, len(commands))
+	for _, command := range commands {
+		inGroup[command.Id] = true
+	}
+
+	return slices.DeleteFunc(slices.Clone(dependencies), func(dependency domain.CommandDependency) bool {
+		return !inGroup[dependency.CommandId] || !inGroup[dependency.DependsOnId]
+	})
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	test2 "gomander/internal/command/domain/test"
 	"gomander/internal/commandgroup/application/usecases"
+	"gomander/internal/commandgroup/domain"
 	"gomander/internal/commandgroup/domain/test"
 )
 
@@ -24,6 +26,7 @@ func TestDefaultUpdateCommandGroup_Execute(t *testing.T) {
 			WithProjectId(projectId).
 			Build()
 
+		mockCommandGroupRepository.On("Get", paramCommandGroup.Id).Return(nil, nil)
 		mockCommandGroupRepository.On("Update", &paramCommandGroup).Return(nil)
 
 		// Act
@@ -37,6 +40,92 @@ func TestDefaultUpdateCommandGroup_Execute(t *testing.T) {
 		)
 	})
 
+	t.Run("Should keep the saved dependencies when editing a group without them", func(t *testing.T) {
+		// Arrange
+		mockCommandGroupRepository := new(test.MockCommandGroupRepository)
+
+		sut := usecases.NewUpdateCommandGroup(mockCommandGroupRepository)
+
+		api := test2.NewCommandBuilder().WithId("api").Build()
+		database := test2.NewCommandBuilder().WithId("database").Build()
+		worker := test2.NewCommandBuilder().WithId("worker").Build()
+
+		apiDependency := domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionReady}
+		workerDependency := domain.CommandDependency{CommandId: worker.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionRunning}
+		existingCommandGroup := test.NewCommandGroupBuilder().
+			WithCommands(api, database, worker).
+			WithDependencies(apiDependency, workerDependency).
+			Build()
+
+		paramCommandGroup := test.NewCommandGroupBuilder().
+			WithId(existingCommandGroup.Id).
+			WithName("Renamed").
+			WithCommands(api, database).
+			Build()
+
+		mockCommandGroupRepository.On("Get", existingCommandGroup.Id).Return(&existingCommandGroup, nil)
+		mockCommandGroupRepository.On("Update", mock.MatchedBy(func(commandGroup *domain.CommandGroup) bool {
+			return commandGroup.Name == "Renamed" &&
+				assert.ObjectsAreEqual([]domain.CommandDependency{apiDependency}, commandGroup.Dependencies)
+		})).Return(nil)
+
+		// Act
+		err := sut.Execute(&paramCommandGroup)
+
+		// Assert
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, mockCommandGroupRepository)
+	})
+
+	t.Run("Should clear the dependencies when editing a group with an empty list", func(t *testing.T) {
+		// Arrange
+		mockCommandGroupRepository := new(test.MockCommandGroupRepository)
+
+		sut := usecases.NewUpdateCommandGroup(mockCommandGroupRepository)
+
+		paramCommandGroup := test.NewCommandGroupBuilder().Build()
+		paramCommandGroup.Dependencies = []domain.CommandDependency{}
+
+		mockCommandGroupRepository.On("Update", &paramCommandGroup).Return(nil)
+
+		// Act
+		err := sut.Execute(&paramCommandGroup)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, paramCommandGroup.Dependencies)
+
+		mock.AssertExpectationsForObjects(t, mockCommandGroupRepository)
+		mockCommandGroupRepository.AssertNotCalled(t, "Get", mock.Anything)
+	})
+
+	t.Run("Should return an error if the dependencies form a cycle", func(t *testing.T) {
+		// Arrange
+		mockCommandGroupRepository := new(test.MockCommandGroupRepository)
+
+		sut := usecases.NewUpdateCommandGroup(mockCommandGroupRepository)
+
+		api := test2.NewCommandBuilder().WithId("api").Build()
+		database := test2.NewCommandBuilder().WithId("database").Build()
+
+		paramCommandGroup := test.NewCommandGroupBuilder().
+			WithCommands(api, database).
+			WithDependencies(
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionRunning},
+				domain.CommandDependency{CommandId: database.Id, DependsOnId: api.Id, Condition: domain.DependencyConditionRunning},
+			).
+			Build()
+
+		// Act
+		err := sut.Execute(&paramCommandGroup)
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+
+		mockCommandGroupRepository.AssertNotCalled(t, "Update", mock.Anything)
+	})
+
 	t.Run("Should return an error if failing to update the command group", func(t *testing.T) {
 		// Arrange
 		mockCommandGroupRepository := new(test.MockCommandGroupRepository)
@@ -49,6 +138,7 @@ func TestDefaultUpdateCommandGroup_Execute(t *testing.T) {
 			WithProjectId(projectId).
 			Build()
 
+		mockCommandGroupRepository.On("Get", paramCommandGroup.Id).Return(nil, nil)
 		mockCommandGroupRepository.On("Update", &paramCommandGroup).Return(errors.New("failed to update command group"))
 
 		// Act
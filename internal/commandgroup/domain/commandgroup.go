@@ -4,10 +4,25 @@ import (
 	"gomander/internal/command/domain"
 )
 
+// CommandGroup runs several commands together. ContinueOnFailure lets a pipeline run the next commands
+// after one of them fails, and is ignored by parallel groups.
 type CommandGroup struct {
-	Id        string           `json:"id"`
-	ProjectId string           `json:"projectId"`
-	Name      string           `json:"name"`
-	Commands  []domain.Command `json:"commands"`
-	Position  int              `json:"position"`
+	Id                string              `json:"id"`
+	ProjectId         string              `json:"projectId"`
+	Name              string              `json:"name"`
+	Commands          []domain.Command    `json:"commands"`
+	Position          int                 `json:"position"`
+	Dependencies      []CommandDependency `json:"dependencies"`
+	Mode              Mode                `json:"mode"`
+	ContinueOnFailure bool                `json:"continueOnFailure"`
 }
+
+// Mode defines how the commands of a group are run. An empty mode is parallel.
+type Mode string
+
+const (
+	// ModeParallel starts all the commands at once, or as soon as their dependencies allow it.
+	ModeParallel Mode = "parallel"
+	// ModePipeline runs the commands one after another, each one once the previous exited with code 0.
+	ModePipeline Mode = "pipeline"
+)
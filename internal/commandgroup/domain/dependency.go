@@ -0,0 +1,179 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gomander/internal/command/domain"
+	"gomander/internal/helpers/array"
+)
+
+// DependencyCondition is the state the command depended on must reach before the dependent command is started.
+type DependencyCondition string
+
+const (
+	DependencyConditionRunning DependencyCondition = "running"
+	// DependencyConditionReady waits for the readiness probe to pass, or behaves as running when there is no probe.
+	DependencyConditionReady    DependencyCondition = "ready"
+	DependencyConditionExitedOk DependencyCondition = "exited-ok"
+)
+
+// CommandDependency makes a command of the group wait for another command of the same group when the group is run.
+type CommandDependency struct {
+	CommandId   string              `json:"commandId"`
+	DependsOnId string              `json:"dependsOnId"`
+	Condition   DependencyCondition `json:"condition"`
+}
+
+var (
+	ErrDependencyOutsideGroup     = errors.New("dependencies can only reference commands of the group")
+	ErrInvalidDependencyCondition = errors.New("invalid dependency condition")
+	ErrDependencyCycle            = errors.New("dependencies cannot form a cycle")
+)
+
+// ValidateDependencies checks that the dependencies only reference commands of the group,
+// have a known condition and can be resolved in a start order.
+func (g *CommandGroup) ValidateDependencies() error {
+	commandNames := make(map[string]string, len(g.Commands))
+	for _, command := range g.Commands {
+		commandNames[command.Id] = command.Name
+	}
+
+	for _, dependency := range g.Dependencies {
+		_, commandInGroup := commandNames[dependency.CommandId]
+		_, dependsOnInGroup := commandNames[dependency.DependsOnId]
+		if !commandInGroup || !dependsOnInGroup {
+			return ErrDependencyOutsideGroup
+		}
+
+		switch dependency.Condition {
+		case DependencyConditionRunning, DependencyConditionReady, DependencyConditionExitedOk:
+		default:
+			return fmt.Errorf("%w: %s", ErrInvalidDependencyCondition, dependency.Condition)
+		}
+	}
+
+	if cycle := g.findCycle(); cycle != nil {
+		names := make([]string, 0, len(cycle))
+		for _, id := range cycle {
+			names = append(names, commandNames[id])
+		}
+		return fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(names, " -> "))
+	}
+
+	return nil
+}
+
+// DependenciesOf returns the dependencies of a command of the group.
+func (g *CommandGroup) DependenciesOf(commandId string) []CommandDependency {
+	dependencies := make([]CommandDependency, 0)
+	for _, dependency := range g.Dependencies {
+		if dependency.CommandId == commandId {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	return dependencies
+}
+
+// StartOrder sorts the commands so each one comes after the ones it depends on,
+// keeping the group order among commands that don't depend on each other.
+// The dependencies must have been validated, as commands in a cycle are left out.
+func (g *CommandGroup) StartOrder() []domain.Command {
+	started := make(map[string]bool, len(g.Commands))
+	ordered := make([]domain.Command, 0, len(g.Commands))
+
+	for len(ordered) < len(g.Commands) {
+		progressed := false
+
+		for _, command := range g.Commands {
+			if started[command.Id] {
+				continue
+			}
+
+			ready := true
+			for _, dependency := range g.DependenciesOf(command.Id) {
+				if !started[dependency.DependsOnId] {
+					ready = false
+					break
+				}
+			}
+
+			if ready {
+				started[command.Id] = true
+				ordered = append(ordered, command)
+				progressed = true
+				// Start again from the top, so the group order is kept as much as possible
+				break
+			}
+		}
+
+		if !progressed {
+			break
+		}
+	}
+
+	return ordered
+}
+
+// StopOrder is the reverse of the start order, so commands are stopped before the ones they depend on.
+func (g *CommandGroup) StopOrder() []domain.Command {
+	ordered := g.StartOrder()
+	slices.Reverse(ordered)
+	return ordered
+}
+
+// RemoveCommand removes a command from the group along with the dependencies referencing it.
+func (g *CommandGroup) RemoveCommand(commandId string) {
+	g.Commands = array.Filter(g.Commands, func(command domain.Command) bool {
+		return command.Id != commandId
+	})
+	g.Dependencies = slices.DeleteFunc(g.Dependencies, func(dependency CommandDependency) bool {
+		return dependency.CommandId == commandId || dependency.DependsOnId == commandId
+	})
+}
+
+// findCycle returns the ids of the commands forming a dependency cycle, if any.
+func (g *CommandGroup) findCycle() []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	states := make(map[string]int, len(g.Commands))
+	var path []string
+
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		states[id] = visiting
+		path = append(path, id)
+
+		for _, dependency := range g.DependenciesOf(id) {
+			switch states[dependency.DependsOnId] {
+			case visiting:
+				start := slices.Index(path, dependency.DependsOnId)
+				return append(slices.Clone(path[start:]), dependency.DependsOnId)
+			case unvisited:
+				if cycle := visit(dependency.DependsOnId); cycle != nil {
+					return cycle
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		states[id] = visited
+		return nil
+	}
+
+	for _, command := range g.Commands {
+		if states[command.Id] == unvisited {
+			if cycle := visit(command.Id); cycle != nil {
+				return cycle
+			}
+		}
+	}
+
+	return nil
+}
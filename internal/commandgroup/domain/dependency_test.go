@@ -0,0 +1,164 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commanddomain "gomander/internal/command/domain"
+	"gomander/internal/command/domain/test"
+	"gomander/internal/commandgroup/domain"
+	test2 "gomander/internal/commandgroup/domain/test"
+)
+
+func TestCommandGroup_ValidateDependencies(t *testing.T) {
+	database := test.NewCommandBuilder().WithId("database").WithName("Database").Build()
+	api := test.NewCommandBuilder().WithId("api").WithName("API").Build()
+	web := test.NewCommandBuilder().WithId("web").WithName("Web").Build()
+
+	t.Run("Should accept dependencies forming a DAG", func(t *testing.T) {
+		// Arrange
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithCommands(web, api, database).
+			WithDependencies(
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionReady},
+				domain.CommandDependency{CommandId: web.Id, DependsOnId: api.Id, Condition: domain.DependencyConditionRunning},
+				domain.CommandDependency{CommandId: web.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionExitedOk},
+			).
+			Build()
+
+		// Act
+		err := cmdGroup.ValidateDependencies()
+
+		// Assert
+		assert.NoError(t, err)
+	})
+	t.Run("Should reject a dependency cycle", func(t *testing.T) {
+		// Arrange
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithCommands(web, api, database).
+			WithDependencies(
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionRunning},
+				domain.CommandDependency{CommandId: database.Id, DependsOnId: web.Id, Condition: domain.DependencyConditionRunning},
+				domain.CommandDependency{CommandId: web.Id, DependsOnId: api.Id, Condition: domain.DependencyConditionRunning},
+			).
+			Build()
+
+		// Act
+		err := cmdGroup.ValidateDependencies()
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+		assert.ErrorContains(t, err, "Web -> API -> Database -> Web")
+	})
+	t.Run("Should reject a command depending on itself", func(t *testing.T) {
+		// Arrange
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithCommands(api).
+			WithDependencies(
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: api.Id, Condition: domain.DependencyConditionRunning},
+			).
+			Build()
+
+		// Act
+		err := cmdGroup.ValidateDependencies()
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+	})
+	t.Run("Should reject a dependency on a command outside the group", func(t *testing.T) {
+		// Arrange
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithCommands(api).
+			WithDependencies(
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionRunning},
+			).
+			Build()
+
+		// Act
+		err := cmdGroup.ValidateDependencies()
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrDependencyOutsideGroup)
+	})
+	t.Run("Should reject an unknown condition", func(t *testing.T) {
+		// Arrange
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithCommands(api, database).
+			WithDependencies(
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: "healthy"},
+			).
+			Build()
+
+		// Act
+		err := cmdGroup.ValidateDependencies()
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidDependencyCondition)
+	})
+}
+
+func TestCommandGroup_StartOrder(t *testing.T) {
+	database := test.NewCommandBuilder().WithId("database").Build()
+	api := test.NewCommandBuilder().WithId("api").Build()
+	web := test.NewCommandBuilder().WithId("web").Build()
+	docs := test.NewCommandBuilder().WithId("docs").Build()
+
+	t.Run("Should keep the group order when there are no dependencies", func(t *testing.T) {
+		// Arrange
+		cmdGroup := test2.NewCommandGroupBuilder().WithCommands(web, api, database).Build()
+
+		// Act
+		startOrder := cmdGroup.StartOrder()
+		stopOrder := cmdGroup.StopOrder()
+
+		// Assert
+		assert.Equal(t, []commanddomain.Command{web, api, database}, startOrder)
+		assert.Equal(t, []commanddomain.Command{database, api, web}, stopOrder)
+	})
+	t.Run("Should start commands after their dependencies and stop them before", func(t *testing.T) {
+		// Arrange
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithCommands(web, docs, api, database).
+			WithDependencies(
+				domain.CommandDependency{CommandId: web.Id, DependsOnId: api.Id, Condition: domain.DependencyConditionReady},
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionReady},
+			).
+			Build()
+
+		// Act
+		startOrder := cmdGroup.StartOrder()
+		stopOrder := cmdGroup.StopOrder()
+
+		// Assert
+		assert.Equal(t, []commanddomain.Command{docs, database, api, web}, startOrder)
+		assert.Equal(t, []commanddomain.Command{web, api, database, docs}, stopOrder)
+	})
+}
+
+func TestCommandGroup_RemoveCommand(t *testing.T) {
+	t.Run("Should remove the command and the dependencies referencing it", func(t *testing.T) {
+		// Arrange
+		database := test.NewCommandBuilder().WithId("database").Build()
+		api := test.NewCommandBuilder().WithId("api").Build()
+		web := test.NewCommandBuilder().WithId("web").Build()
+
+		webDependency := domain.CommandDependency{CommandId: web.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionRunning}
+
+		cmdGroup := test2.NewCommandGroupBuilder().
+			WithCommands(web, api, database).
+			WithDependencies(
+				domain.CommandDependency{CommandId: web.Id, DependsOnId: api.Id, Condition: domain.DependencyConditionRunning},
+				webDependency,
+				domain.CommandDependency{CommandId: api.Id, DependsOnId: database.Id, Condition: domain.DependencyConditionRunning},
+			).
+			Build()
+
+		// Act
+		cmdGroup.RemoveCommand(api.Id)
+
+		// Assert
+		assert.Equal(t, []commanddomain.Command{web, database}, cmdGroup.Commands)
+		assert.Equal(t, []domain.CommandDependency{webDependency}, cmdGroup.Dependencies)
+	})
+}
@@ -8,11 +8,14 @@ import (
 )
 
 type CommandGroupData struct {
-	Id        string
-	ProjectId string
-	Name      string
-	Position  int
-	Commands  []domain.Command
+	Id                string
+	ProjectId         string
+	Name              string
+	Position          int
+	Commands          []domain.Command
+	Dependencies      []commandgroupdomain.CommandDependency
+	Mode              commandgroupdomain.Mode
+	ContinueOnFailure bool
 }
 
 type CommandGroupBuilder struct {
@@ -56,13 +59,27 @@ func (b *CommandGroupBuilder) WithCommands(commands ...domain.Command) *CommandG
 	return b
 }
 
+func (b *CommandGroupBuilder) WithDependencies(dependencies ...commandgroupdomain.CommandDependency) *CommandGroupBuilder {
+	b.data.Dependencies = dependencies
+	return b
+}
+
+func (b *CommandGroupBuilder) WithMode(mode commandgroupdomain.Mode, continueOnFailure bool) *CommandGroupBuilder {
+	b.data.Mode = mode
+	b.data.ContinueOnFailure = continueOnFailure
+	return b
+}
+
 func (b *CommandGroupBuilder) Build() commandgroupdomain.CommandGroup {
 	return commandgroupdomain.CommandGroup{
-		Id:        b.data.Id,
-		ProjectId: b.data.ProjectId,
-		Name:      b.data.Name,
-		Commands:  b.data.Commands,
-		Position:  b.data.Position,
+		Id:                b.data.Id,
+		ProjectId:         b.data.ProjectId,
+		Name:              b.data.Name,
+		Commands:          b.data.Commands,
+		Position:          b.data.Position,
+		Dependencies:      b.data.Dependencies,
+		Mode:              b.data.Mode,
+		ContinueOnFailure: b.data.ContinueOnFailure,
 	}
 }
 
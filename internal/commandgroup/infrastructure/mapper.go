@@ -7,20 +7,52 @@ import (
 )
 
 func ToDomainCommandGroup(commandGroupModel CommandGroupModel) *domain.CommandGroup {
-	return &domain.CommandGroup{
-		Id:        commandGroupModel.Id,
-		Name:      commandGroupModel.Name,
-		ProjectId: commandGroupModel.ProjectId,
-		Position:  commandGroupModel.Position,
-		Commands:  array.Map(commandGroupModel.Commands, infrastructure.ToDomainCommand),
+	commandGroup := &domain.CommandGroup{
+		Id:                commandGroupModel.Id,
+		Name:              commandGroupModel.Name,
+		ProjectId:         commandGroupModel.ProjectId,
+		Position:          commandGroupModel.Position,
+		Commands:          array.Map(commandGroupModel.Commands, infrastructure.ToDomainCommand),
+		Mode:              domain.Mode(commandGroupModel.Mode),
+		ContinueOnFailure: commandGroupModel.ContinueOnFailure,
 	}
+
+	if len(commandGroupModel.Dependencies) > 0 {
+		commandGroup.Dependencies = array.Map(commandGroupModel.Dependencies, ToDomainCommandDependency)
+	}
+
+	return commandGroup
 }
 
 func ToCommandGroupModel(domainCommandGroup *domain.CommandGroup) CommandGroupModel {
 	return CommandGroupModel{
-		Id:        domainCommandGroup.Id,
-		Name:      domainCommandGroup.Name,
-		ProjectId: domainCommandGroup.ProjectId,
-		Position:  domainCommandGroup.Position,
+		Id:                domainCommandGroup.Id,
+		Name:              domainCommandGroup.Name,
+		ProjectId:         domainCommandGroup.ProjectId,
+		Position:          domainCommandGroup.Position,
+		Mode:              string(domainCommandGroup.Mode),
+		ContinueOnFailure: domainCommandGroup.ContinueOnFailure,
+	}
+}
+
+func ToDomainCommandDependency(dependencyModel CommandGroupDependencyModel) domain.CommandDependency {
+	return domain.CommandDependency{
+		CommandId:   dependencyModel.CommandId,
+		DependsOnId: dependencyModel.DependsOnCommandId,
+		Condition:   domain.DependencyCondition(dependencyModel.Condition),
+	}
+}
+
+func ToCommandGroupDependencyModels(domainCommandGroup *domain.CommandGroup) []CommandGroupDependencyModel {
+	dependencyModels := make([]CommandGroupDependencyModel, 0, len(domainCommandGroup.Dependencies))
+	for i, dependency := range domainCommandGroup.Dependencies {
+		dependencyModels = append(dependencyModels, CommandGroupDependencyModel{
+			CommandGroupId:     domainCommandGroup.Id,
+			CommandId:          dependency.CommandId,
+			DependsOnCommandId: dependency.DependsOnId,
+			Condition:          string(dependency.Condition),
+			Position:           i,
+		})
 	}
+	return dependencyModels
 }
@@ -3,11 +3,14 @@ package infrastructure
 import "gomander/internal/command/infrastructure"
 
 type CommandGroupModel struct {
-	Id        string                        `gorm:"primaryKey;column:id"`
-	ProjectId string                        `gorm:"column:project_id"`
-	Name      string                        `gorm:"column:name"`
-	Position  int                           `gorm:"column:position"`
-	Commands  []infrastructure.CommandModel `gorm:"many2many:command_group_command;foreignKey:id;references:id;joinForeignKey:command_group_id;joinReferences:command_id;"`
+	Id                string                        `gorm:"primaryKey;column:id"`
+	ProjectId         string                        `gorm:"column:project_id"`
+	Name              string                        `gorm:"column:name"`
+	Position          int                           `gorm:"column:position"`
+	Commands          []infrastructure.CommandModel `gorm:"many2many:command_group_command;foreignKey:id;references:id;joinForeignKey:command_group_id;joinReferences:command_id;"`
+	Dependencies      []CommandGroupDependencyModel `gorm:"foreignKey:CommandGroupId;references:Id"`
+	Mode              string                        `gorm:"column:mode"`
+	ContinueOnFailure bool                          `gorm:"column:continue_on_failure"`
 }
 
 func (CommandGroupModel) TableName() string {
@@ -23,3 +26,15 @@ type CommandToCommandGroupModel struct {
 func (CommandToCommandGroupModel) TableName() string {
 	return "command_group_command"
 }
+
+type CommandGroupDependencyModel struct {
+	CommandGroupId     string `gorm:"primaryKey;column:command_group_id"`
+	CommandId          string `gorm:"primaryKey;column:command_id"`
+	DependsOnCommandId string `gorm:"primaryKey;column:depends_on_command_id"`
+	Condition          string `gorm:"column:condition"`
+	Position           int    `gorm:"column:position"`
+}
+
+func (CommandGroupDependencyModel) TableName() string {
+	return "command_group_dependency"
+}
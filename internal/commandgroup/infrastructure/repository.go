@@ -58,6 +58,9 @@ func (r GormCommandGroupRepository) Get(id string) (*domain.CommandGroup, error)
 				Joins("JOIN command_group_command ON command_group_command.command_id = command.id AND command_group_command.command_group_id = ?", id).
 				Order("command_group_command.position")
 		}).
+		Preload("Dependencies", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position")
+		}).
 		First(&cgModel).Error
 
 	if err != nil {
@@ -94,7 +97,7 @@ func (r GormCommandGroupRepository) Create(commandGroup *domain.CommandGroup) er
 			}
 		}
 
-		return nil
+		return createDependencies(tx, r.ctx, commandGroup)
 	})
 
 	if err != nil {
@@ -135,7 +138,15 @@ func (r GormCommandGroupRepository) Update(commandGroup *domain.CommandGroup) er
 			}
 		}
 
-		return nil
+		// Replace the dependencies between the commands
+		_, err = gorm.G[CommandGroupDependencyModel](tx).
+			Where("command_group_id = ?", commandGroupModel.Id).
+			Delete(r.ctx)
+		if err != nil {
+			return err
+		}
+
+		return createDependencies(tx, r.ctx, commandGroup)
 	})
 
 	if err != nil {
@@ -169,6 +180,13 @@ func (r GormCommandGroupRepository) Delete(commandGroupId string) error {
 			return err
 		}
 
+		_, err = gorm.G[CommandGroupDependencyModel](tx).
+			Where("command_group_id = ?", commandGroupId).
+			Delete(r.ctx)
+		if err != nil {
+			return err
+		}
+
 		// Decrease the position of all command groups with a higher position
 		_, err = gorm.G[CommandGroupModel](tx).
 			Where("project_id = ? AND position > ?", existingGroup.ProjectId, existingGroup.Position).
@@ -217,6 +235,15 @@ func (r GormCommandGroupRepository) RemoveCommandFromCommandGroups(commandId str
 			return err
 		}
 
+		// Delete the dependencies from and to the command
+		_, err = gorm.G[CommandGroupDependencyModel](tx).
+			Where("command_id = ? OR depends_on_command_id = ?", commandId, commandId).
+			Delete(r.ctx)
+
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 
@@ -246,7 +273,17 @@ func (r GormCommandGroupRepository) DeleteEmpty() ([]string, error) {
 		return nil, err
 	}
 
-	return array.Map(entriesToDelete, func(entry CommandGroupModel) string { return entry.Id }), nil
+	deletedIds := array.Map(entriesToDelete, func(entry CommandGroupModel) string { return entry.Id })
+
+	_, err = gorm.G[CommandGroupDependencyModel](r.db).
+		Where("command_group_id IN ?", deletedIds).
+		Delete(r.ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedIds, nil
 }
 
 func (r GormCommandGroupRepository) DeleteAll(projectId string) ([]string, error) {
@@ -272,6 +309,11 @@ func (r GormCommandGroupRepository) DeleteAll(projectId string) ([]string, error
 			return err
 		}
 
+		_, err = gorm.G[CommandGroupDependencyModel](tx).Where("command_group_id IN ?", commandGroupIds).Delete(r.ctx)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	})
 
@@ -281,3 +323,13 @@ func (r GormCommandGroupRepository) DeleteAll(projectId string) ([]string, error
 
 	return commandGroupIds, nil
 }
+
+func createDependencies(tx *gorm.DB, ctx context.Context, commandGroup *domain.CommandGroup) error {
+	for _, dependencyModel := range ToCommandGroupDependencyModels(commandGroup) {
+		err := gorm.G[CommandGroupDependencyModel](tx).Create(ctx, &dependencyModel)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -237,6 +237,46 @@ func TestGormCommandGroupRepository_Create(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, &cmdGroup1, result)
 	})
+	t.Run("Should create a new command group with its dependencies and mode", func(t *testing.T) {
+		// Arrange
+		projectId := "project1"
+
+		cmd1 := test.NewCommandBuilder().WithName("Command 1").WithProjectId(projectId).Build()
+		cmd2 := test.NewCommandBuilder().WithName("Command 2").WithProjectId(projectId).Build()
+		cmd3 := test.NewCommandBuilder().WithName("Command 3").WithProjectId(projectId).Build()
+
+		cmdGroup1 := test2.NewCommandGroupBuilder().
+			WithName("Group 1").
+			WithProjectId(projectId).
+			WithCommands(cmd1, cmd2, cmd3).
+			WithDependencies(
+				domain.CommandDependency{CommandId: cmd3.Id, DependsOnId: cmd2.Id, Condition: domain.DependencyConditionReady},
+				domain.CommandDependency{CommandId: cmd2.Id, DependsOnId: cmd1.Id, Condition: domain.DependencyConditionExitedOk},
+			).
+			WithMode(domain.ModePipeline, true).
+			Build()
+
+		helper := newTestHelper(
+			t,
+			[]commandinfrastructure.CommandModel{
+				commandinfrastructure.ToCommandModel(&cmd1),
+				commandinfrastructure.ToCommandModel(&cmd2),
+				commandinfrastructure.ToCommandModel(&cmd3),
+			},
+			nil,
+			nil,
+		)
+
+		// Act
+		err := helper.repo.Create(&cmdGroup1)
+
+		// Assert
+		assert.Nil(t, err)
+
+		result, err := helper.repo.Get(cmdGroup1.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, &cmdGroup1, result)
+	})
 }
 
 func TestGormCommandGroupRepository_Update(t *testing.T) {
@@ -288,6 +328,39 @@ func TestGormCommandGroupRepository_Update(t *testing.T) {
 		err := helper.repo.Update(&updatedGroup)
 		assert.Nil(t, err)
 
+		result, err := helper.repo.Get(updatedGroup.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, &updatedGroup, result)
+	})
+	t.Run("Should replace the dependencies of an existing command group", func(t *testing.T) {
+		projectId := "project1"
+
+		cmd1 := test.NewCommandBuilder().WithName("Command 1").WithProjectId(projectId).Build()
+		cmd2 := test.NewCommandBuilder().WithName("Command 2").WithProjectId(projectId).Build()
+
+		commandModels := []commandinfrastructure.CommandModel{
+			commandinfrastructure.ToCommandModel(&cmd1),
+			commandinfrastructure.ToCommandModel(&cmd2),
+		}
+
+		cmdGroup1Builder := test2.NewCommandGroupBuilder().
+			WithName("Group 1").
+			WithProjectId(projectId).
+			WithCommands(cmd1, cmd2).
+			WithDependencies(domain.CommandDependency{CommandId: cmd2.Id, DependsOnId: cmd1.Id, Condition: domain.DependencyConditionRunning})
+		cmdGroup1 := cmdGroup1Builder.Build()
+
+		helper := newTestHelper(t, commandModels, nil, nil)
+		err := helper.repo.Create(&cmdGroup1)
+		assert.Nil(t, err)
+
+		updatedGroup := cmdGroup1Builder.
+			WithDependencies(domain.CommandDependency{CommandId: cmd1.Id, DependsOnId: cmd2.Id, Condition: domain.DependencyConditionReady}).
+			Build()
+
+		err = helper.repo.Update(&updatedGroup)
+		assert.Nil(t, err)
+
 		result, err := helper.repo.Get(updatedGroup.Id)
 		assert.Nil(t, err)
 		assert.Equal(t, &updatedGroup, result)
@@ -439,6 +512,42 @@ func TestGormCommandGroupRepository_RemoveCommandFromCommandGroups(t *testing.T)
 		assert.Equal(t, expectedGroup1Commands, group1.Commands)
 		assert.Equal(t, expectedGroup2Commands, group2.Commands)
 	})
+	t.Run("Should remove the dependencies from and to the command", func(t *testing.T) {
+		projectId := "project1"
+		cmd1 := test.NewCommandBuilder().WithName("Command 1").WithProjectId(projectId).Build()
+		cmd2 := test.NewCommandBuilder().WithName("Command 2").WithProjectId(projectId).Build()
+		cmd3 := test.NewCommandBuilder().WithName("Command 3").WithProjectId(projectId).Build()
+
+		commandModels := []commandinfrastructure.CommandModel{
+			commandinfrastructure.ToCommandModel(&cmd1),
+			commandinfrastructure.ToCommandModel(&cmd2),
+			commandinfrastructure.ToCommandModel(&cmd3),
+		}
+
+		remainingDependency := domain.CommandDependency{CommandId: cmd3.Id, DependsOnId: cmd2.Id, Condition: domain.DependencyConditionRunning}
+
+		cmdGroup1 := test2.NewCommandGroupBuilder().
+			WithName("Group 1").
+			WithProjectId(projectId).
+			WithCommands(cmd1, cmd2, cmd3).
+			WithDependencies(
+				domain.CommandDependency{CommandId: cmd2.Id, DependsOnId: cmd1.Id, Condition: domain.DependencyConditionRunning},
+				domain.CommandDependency{CommandId: cmd1.Id, DependsOnId: cmd3.Id, Condition: domain.DependencyConditionRunning},
+				remainingDependency,
+			).
+			Build()
+
+		helper := newTestHelper(t, commandModels, nil, nil)
+		err := helper.repo.Create(&cmdGroup1)
+		assert.Nil(t, err)
+
+		err = helper.repo.RemoveCommandFromCommandGroups(cmd1.Id)
+		assert.Nil(t, err)
+
+		group1, _ := helper.repo.Get(cmdGroup1.Id)
+
+		assert.Equal(t, []domain.CommandDependency{remainingDependency}, group1.Dependencies)
+	})
 }
 
 func TestGormCommandGroupRepository_DeleteEmpty(t *testing.T) {
@@ -613,6 +722,10 @@ func arrange(
 	if err != nil {
 		panic(err)
 	}
+	_, err = gorm.G[infrastructure.CommandGroupDependencyModel](gormDb).Where("true").Delete(ctx)
+	if err != nil {
+		panic(err)
+	}
 
 	for _, m := range preloadedCommandModels {
 		err = gorm.G[commandinfrastructure.CommandModel](gormDb).Create(ctx, &m)
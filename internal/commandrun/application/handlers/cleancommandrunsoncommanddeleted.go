@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	commanddomainevent "gomander/internal/command/domain/event"
+	commandrundomain "gomander/internal/commandrun/domain"
+	"gomander/internal/eventbus"
+)
+
+type CleanCommandRunsOnCommandDeleted interface {
+	Execute(e eventbus.Event) error
+	GetEvent() eventbus.Event
+}
+
+type DefaultCleanCommandRunsOnCommandDeleted struct {
+	commandRunRepository commandrundomain.Repository
+}
+
+func (h *DefaultCleanCommandRunsOnCommandDeleted) GetEvent() eventbus.Event {
+	return commanddomainevent.CommandDeletedEvent{}
+}
+
+func NewCleanCommandRunsOnCommandDeleted(commandRunRepository commandrundomain.Repository) *DefaultCleanCommandRunsOnCommandDeleted {
+	return &DefaultCleanCommandRunsOnCommandDeleted{
+		commandRunRepository: commandRunRepository,
+	}
+}
+
+func (h *DefaultCleanCommandRunsOnCommandDeleted) Execute(e eventbus.Event) error {
+	event, ok := e.(commanddomainevent.CommandDeletedEvent)
+	if !ok {
+		return nil
+	}
+
+	err := h.commandRunRepository.DeleteAll(event.CommandId)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
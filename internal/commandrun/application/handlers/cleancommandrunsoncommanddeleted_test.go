@@ -0,0 +1,62 @@
+package handlers_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	commanddomainevent "gomander/internal/command/domain/event"
+	"gomander/internal/commandrun/application/handlers"
+	"gomander/internal/commandrun/domain/test"
+)
+
+type FakeEvent struct{}
+
+func (FakeEvent) GetName() string { return "fake" }
+
+func TestDefaultCleanCommandRunsOnCommandDeleted(t *testing.T) {
+	t.Run("Should delete the runs of the command", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(test.MockCommandRunRepository)
+		handler := handlers.NewCleanCommandRunsOnCommandDeleted(mockRepo)
+
+		mockRepo.On("DeleteAll", "cmd-123").Return(nil).Once()
+
+		// Act
+		err := handler.Execute(commanddomainevent.CommandDeletedEvent{CommandId: "cmd-123"})
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should return error if failing to delete the runs", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(test.MockCommandRunRepository)
+		handler := handlers.NewCleanCommandRunsOnCommandDeleted(mockRepo)
+
+		expectedErr := errors.New("delete error")
+		mockRepo.On("DeleteAll", "cmd-123").Return(expectedErr).Once()
+
+		// Act
+		err := handler.Execute(commanddomainevent.CommandDeletedEvent{CommandId: "cmd-123"})
+
+		// Assert
+		assert.ErrorIs(t, err, expectedErr)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Should ignore other events", func(t *testing.T) {
+		// Arrange
+		mockRepo := new(test.MockCommandRunRepository)
+		handler := handlers.NewCleanCommandRunsOnCommandDeleted(mockRepo)
+
+		// Act
+		err := handler.Execute(FakeEvent{})
+
+		// Assert
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "DeleteAll")
+	})
+}
@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"os"
+	"time"
+
+	"gomander/internal/commandrun/domain"
+	"gomander/internal/runner"
+)
+
+type DetectOrphanedProcesses interface {
+	Execute() ([]domain.CommandRun, error)
+}
+
+type DefaultDetectOrphanedProcesses struct {
+	commandRunRepository domain.Repository
+	commandRunner        runner.Runner
+}
+
+func NewDetectOrphanedProcesses(commandRunRepo domain.Repository, runner runner.Runner) *DefaultDetectOrphanedProcesses {
+	return &DefaultDetectOrphanedProcesses{
+		commandRunRepository: commandRunRepo,
+		commandRunner:        runner,
+	}
+}
+
+// Execute looks for the runs left unfinished by a Gomander that is gone, usually because it crashed. The ones
+// whose processes are still running, and weren't replaced by others reusing their pid, are handed to the
+// runner as orphaned and returned. The others are recorded as killed when they are found, as the moment they
+// ended is unknown. Runs of a Gomander still running, such as the supervisor, are left alone.
+func (uc *DefaultDetectOrphanedProcesses) Execute() ([]domain.CommandRun, error) {
+	runs, err := uc.commandRunRepository.GetAll(domain.Filter{Status: string(runner.RunStatusRunning)})
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := make([]domain.CommandRun, 0)
+	orphanedCommandIds := make(map[string]struct{})
+
+	// Runs come from the most recent, so an older run of a command with an orphaned process waits for it to end
+	for _, run := range runs {
+		if run.FinishedAt != nil || run.OwnerPid == os.Getpid() || runner.IsProcessRunning(run.OwnerPid) {
+			continue
+		}
+		if _, exists := orphanedCommandIds[run.CommandId]; exists {
+			continue
+		}
+
+		if runner.IsRunProcessRunning(run) {
+			uc.commandRunner.AddOrphanedProcess(run)
+			orphans = append(orphans, run)
+			orphanedCommandIds[run.CommandId] = struct{}{}
+			continue
+		}
+
+		finishedAt := time.Now()
+		run.Status = string(runner.RunStatusKilled)
+		run.FinishedAt = &finishedAt
+		err = uc.commandRunRepository.Update(&run)
+		if err != nil {
+			return orphans, err
+		}
+	}
+
+	return orphans, nil
+}
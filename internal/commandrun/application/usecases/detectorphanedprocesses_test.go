@@ -0,0 +1,177 @@
+package usecases_test
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/commandrun/application/usecases"
+	"gomander/internal/commandrun/domain"
+	"gomander/internal/commandrun/domain/test"
+	"gomander/internal/runner"
+	runnertest "gomander/internal/runner/test"
+)
+
+// exitedPid returns the pid of a process that already exited, standing for a Gomander that crashed
+func exitedPid(t *testing.T) int {
+	cmd := exec.Command("true")
+	assert.NoError(t, cmd.Run())
+	return cmd.Process.Pid
+}
+
+// startProcessGroup starts a process leading its own group, as the runner does
+func startProcessGroup(t *testing.T) int {
+	cmd := exec.Command("sleep", "30")
+	runner.SetProcAttributes(cmd)
+	assert.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+	return cmd.Process.Pid
+}
+
+func TestDefaultDetectOrphanedProcesses_Execute(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The processes are started with POSIX commands")
+	}
+
+	runningFilter := domain.Filter{Status: string(runner.RunStatusRunning)}
+
+	t.Run("Should hand the processes still running to the runner", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockCommandRunRepository)
+		mockRunner := new(runnertest.MockRunner)
+		sut := usecases.NewDetectOrphanedProcesses(mockRepository, mockRunner)
+
+		pgid := startProcessGroup(t)
+		run := domain.CommandRun{
+			Id:               "run-1",
+			CommandId:        "api",
+			Status:           string(runner.RunStatusRunning),
+			StartedAt:        time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC),
+			Pid:              pgid,
+			Pgid:             pgid,
+			OwnerPid:         exitedPid(t),
+			ProcessStartTime: runner.ProcessStartTime(pgid),
+		}
+		mockRepository.On("GetAll", runningFilter).Return([]domain.CommandRun{run}, nil)
+		mockRunner.On("AddOrphanedProcess", run).Return()
+
+		// Act
+		result, err := sut.Execute()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.CommandRun{run}, result)
+		mock.AssertExpectationsForObjects(t, mockRepository, mockRunner)
+	})
+
+	t.Run("Should record the runs whose processes are gone as killed", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockCommandRunRepository)
+		mockRunner := new(runnertest.MockRunner)
+		sut := usecases.NewDetectOrphanedProcesses(mockRepository, mockRunner)
+
+		pid := exitedPid(t)
+		run := domain.CommandRun{
+			Id:        "run-1",
+			CommandId: "api",
+			Status:    string(runner.RunStatusRunning),
+			Pid:       pid,
+			Pgid:      pid,
+			OwnerPid:  exitedPid(t),
+		}
+		mockRepository.On("GetAll", runningFilter).Return([]domain.CommandRun{run}, nil)
+		mockRepository.On("Update", mock.MatchedBy(func(updated *domain.CommandRun) bool {
+			return updated.Id == run.Id && updated.Status == string(runner.RunStatusKilled) && updated.FinishedAt != nil
+		})).Return(nil)
+
+		// Act
+		result, err := sut.Execute()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		mock.AssertExpectationsForObjects(t, mockRepository, mockRunner)
+	})
+
+	t.Run("Should record the runs whose pid was reused by another process as killed", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockCommandRunRepository)
+		mockRunner := new(runnertest.MockRunner)
+		sut := usecases.NewDetectOrphanedProcesses(mockRepository, mockRunner)
+
+		pgid := startProcessGroup(t)
+		startTime := runner.ProcessStartTime(pgid)
+		if startTime == 0 {
+			t.Skip("The start time of the processes is not read on this system")
+		}
+		run := domain.CommandRun{
+			Id:               "run-1",
+			CommandId:        "api",
+			Status:           string(runner.RunStatusRunning),
+			Pid:              pgid,
+			Pgid:             pgid,
+			OwnerPid:         exitedPid(t),
+			ProcessStartTime: startTime - 1,
+		}
+		mockRepository.On("GetAll", runningFilter).Return([]domain.CommandRun{run}, nil)
+		mockRepository.On("Update", mock.MatchedBy(func(updated *domain.CommandRun) bool {
+			return updated.Id == run.Id && updated.Status == string(runner.RunStatusKilled) && updated.FinishedAt != nil
+		})).Return(nil)
+
+		// Act
+		result, err := sut.Execute()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		mock.AssertExpectationsForObjects(t, mockRepository, mockRunner)
+	})
+
+	t.Run("Should leave the runs of a Gomander still running alone", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockCommandRunRepository)
+		mockRunner := new(runnertest.MockRunner)
+		sut := usecases.NewDetectOrphanedProcesses(mockRepository, mockRunner)
+
+		pgid := startProcessGroup(t)
+		runs := []domain.CommandRun{
+			{Id: "run-1", CommandId: "api", Pid: pgid, Pgid: pgid, OwnerPid: os.Getpid()},
+			{Id: "run-2", CommandId: "worker", Pid: pgid, Pgid: pgid, OwnerPid: os.Getppid()},
+		}
+		mockRepository.On("GetAll", runningFilter).Return(runs, nil)
+
+		// Act
+		result, err := sut.Execute()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		mock.AssertExpectationsForObjects(t, mockRepository, mockRunner)
+	})
+
+	t.Run("Should return an error if the runs cannot be retrieved", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockCommandRunRepository)
+		mockRunner := new(runnertest.MockRunner)
+		sut := usecases.NewDetectOrphanedProcesses(mockRepository, mockRunner)
+
+		expectedErr := errors.New("database error")
+		mockRepository.On("GetAll", runningFilter).Return([]domain.CommandRun(nil), expectedErr)
+
+		// Act
+		_, err := sut.Execute()
+
+		// Assert
+		assert.ErrorIs(t, err, expectedErr)
+		mockRepository.AssertExpectations(t)
+	})
+}
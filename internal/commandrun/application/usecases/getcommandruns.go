@@ -0,0 +1,22 @@
+package usecases
+
+import "gomander/internal/commandrun/domain"
+
+type GetCommandRuns interface {
+	Execute(filter domain.Filter) ([]domain.CommandRun, error)
+}
+
+type DefaultGetCommandRuns struct {
+	commandRunRepository domain.Repository
+}
+
+func NewGetCommandRuns(commandRunRepo domain.Repository) *DefaultGetCommandRuns {
+	return &DefaultGetCommandRuns{
+		commandRunRepository: commandRunRepo,
+	}
+}
+
+// Execute returns the runs matching the filter, the most recent first.
+func (uc *DefaultGetCommandRuns) Execute(filter domain.Filter) ([]domain.CommandRun, error) {
+	return uc.commandRunRepository.GetAll(filter)
+}
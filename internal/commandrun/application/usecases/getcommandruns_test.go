@@ -0,0 +1,61 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/commandrun/application/usecases"
+	"gomander/internal/commandrun/domain"
+	"gomander/internal/commandrun/domain/test"
+)
+
+func TestDefaultGetCommandRuns_Execute(t *testing.T) {
+	t.Run("Should return the runs matching the filter", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockCommandRunRepository)
+		sut := usecases.NewGetCommandRuns(mockRepository)
+
+		exitCode := 2
+		finishedAt := time.Date(2026, 10, 17, 14, 20, 0, 0, time.UTC)
+		expectedRuns := []domain.CommandRun{
+			{
+				Id:         "run-1",
+				CommandId:  "worker",
+				Trigger:    domain.TriggerUI,
+				Status:     "exited-error",
+				StartedAt:  time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC),
+				FinishedAt: &finishedAt,
+				ExitCode:   &exitCode,
+			},
+		}
+		filter := domain.Filter{CommandId: "worker", Status: "exited-error", Limit: 1}
+		mockRepository.On("GetAll", filter).Return(expectedRuns, nil)
+
+		// Act
+		result, err := sut.Execute(filter)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedRuns, result)
+		mockRepository.AssertExpectations(t)
+	})
+
+	t.Run("Should return an error if the runs cannot be retrieved", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockCommandRunRepository)
+		sut := usecases.NewGetCommandRuns(mockRepository)
+
+		expectedErr := errors.New("database error")
+		mockRepository.On("GetAll", domain.Filter{}).Return([]domain.CommandRun(nil), expectedErr)
+
+		// Act
+		_, err := sut.Execute(domain.Filter{})
+
+		// Assert
+		assert.ErrorIs(t, err, expectedErr)
+		mockRepository.AssertExpectations(t)
+	})
+}
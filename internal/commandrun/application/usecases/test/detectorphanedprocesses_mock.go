@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/commandrun/domain"
+)
+
+type MockDetectOrphanedProcesses struct {
+	mock.Mock
+}
+
+func (m *MockDetectOrphanedProcesses) Execute() ([]domain.CommandRun, error) {
+	args := m.Called()
+	return args.Get(0).([]domain.CommandRun), args.Error(1)
+}
@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// Trigger is what started a command run.
+type Trigger string
+
+const (
+	TriggerUI      Trigger = "ui"
+	TriggerGroup   Trigger = "group"
+	TriggerHTTP    Trigger = "http"
+	TriggerRestart Trigger = "restart"
+)
+
+// CommandRun is a single execution of a command. Command and WorkingDirectory are the ones resolved when it was
+// started, so the history is not affected by later edits. FinishedAt and ExitCode are nil while it is running,
+// and ExitCode also when the process could not be started or was killed by a signal.
+// Pid and Pgid identify the process and its group, and OwnerPid the Gomander process running it, so the processes
+// surviving a crash can be found on the next launch. ProcessStartTime tells the process apart from a later one
+// reusing its pid, and is 0 when unknown.
+type CommandRun struct {
+	Id               string     `json:"id"`
+	CommandId        string     `json:"commandId"`
+	Command          string     `json:"command"`
+	WorkingDirectory string     `json:"workingDirectory"`
+	Trigger          Trigger    `json:"trigger"`
+	Status           string     `json:"status"`
+	StartedAt        time.Time  `json:"startedAt"`
+	FinishedAt       *time.Time `json:"finishedAt"`
+	ExitCode         *int       `json:"exitCode"`
+	ErrorDetected    bool       `json:"errorDetected"`
+	Pid              int        `json:"pid"`
+	Pgid             int        `json:"pgid"`
+	OwnerPid         int        `json:"ownerPid"`
+	ProcessStartTime int64      `json:"processStartTime"`
+}
+
+// Duration returns how long the command has been up, until now if it is still running.
+func (r CommandRun) Duration() time.Duration {
+	if r.FinishedAt == nil {
+		return time.Since(r.StartedAt)
+	}
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// Filter narrows down the command runs, leaving empty fields out. Runs are returned from the most recent,
+// and Limit caps how many of them when greater than 0.
+type Filter struct {
+	CommandId     string     `json:"commandId"`
+	Trigger       Trigger    `json:"trigger"`
+	Status        string     `json:"status"`
+	ErrorDetected *bool      `json:"errorDetected"`
+	StartedAfter  *time.Time `json:"startedAfter"`
+	StartedBefore *time.Time `json:"startedBefore"`
+	Limit         int        `json:"limit"`
+}
@@ -0,0 +1,8 @@
+package domain
+
+type Repository interface {
+	GetAll(filter Filter) ([]CommandRun, error)
+	Create(run *CommandRun) error
+	Update(run *CommandRun) error
+	DeleteAll(commandId string) error
+}
@@ -0,0 +1,31 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	commandrundomain "gomander/internal/commandrun/domain"
+)
+
+type MockCommandRunRepository struct {
+	mock.Mock
+}
+
+func (m *MockCommandRunRepository) GetAll(filter commandrundomain.Filter) ([]commandrundomain.CommandRun, error) {
+	args := m.Called(filter)
+	return args.Get(0).([]commandrundomain.CommandRun), args.Error(1)
+}
+
+func (m *MockCommandRunRepository) Create(run *commandrundomain.CommandRun) error {
+	args := m.Called(run)
+	return args.Error(0)
+}
+
+func (m *MockCommandRunRepository) Update(run *commandrundomain.CommandRun) error {
+	args := m.Called(run)
+	return args.Error(0)
+}
+
+func (m *MockCommandRunRepository) DeleteAll(commandId string) error {
+	args := m.Called(commandId)
+	return args.Error(0)
+}
@@ -0,0 +1,41 @@
+package infrastructure
+
+import "gomander/internal/commandrun/domain"
+
+func ToDomainCommandRun(model CommandRunModel) domain.CommandRun {
+	return domain.CommandRun{
+		Id:               model.Id,
+		CommandId:        model.CommandId,
+		Command:          model.Command,
+		WorkingDirectory: model.WorkingDirectory,
+		Trigger:          domain.Trigger(model.TriggerSource),
+		Status:           model.Status,
+		StartedAt:        model.StartedAt,
+		FinishedAt:       model.FinishedAt,
+		ExitCode:         model.ExitCode,
+		ErrorDetected:    model.ErrorDetected,
+		Pid:              model.Pid,
+		Pgid:             model.Pgid,
+		OwnerPid:         model.OwnerPid,
+		ProcessStartTime: model.ProcessStartTime,
+	}
+}
+
+func ToCommandRunModel(run *domain.CommandRun) CommandRunModel {
+	return CommandRunModel{
+		Id:               run.Id,
+		CommandId:        run.CommandId,
+		Command:          run.Command,
+		WorkingDirectory: run.WorkingDirectory,
+		TriggerSource:    string(run.Trigger),
+		Status:           run.Status,
+		StartedAt:        run.StartedAt,
+		FinishedAt:       run.FinishedAt,
+		ExitCode:         run.ExitCode,
+		ErrorDetected:    run.ErrorDetected,
+		Pid:              run.Pid,
+		Pgid:             run.Pgid,
+		OwnerPid:         run.OwnerPid,
+		ProcessStartTime: run.ProcessStartTime,
+	}
+}
@@ -0,0 +1,24 @@
+package infrastructure
+
+import "time"
+
+type CommandRunModel struct {
+	Id               string     `gorm:"primaryKey;column:id"`
+	CommandId        string     `gorm:"column:command_id"`
+	Command          string     `gorm:"column:command"`
+	WorkingDirectory string     `gorm:"column:working_directory"`
+	TriggerSource    string     `gorm:"column:trigger_source"`
+	Status           string     `gorm:"column:status"`
+	StartedAt        time.Time  `gorm:"column:started_at"`
+	FinishedAt       *time.Time `gorm:"column:finished_at"`
+	ExitCode         *int       `gorm:"column:exit_code"`
+	ErrorDetected    bool       `gorm:"column:error_detected"`
+	Pid              int        `gorm:"column:pid"`
+	Pgid             int        `gorm:"column:pgid"`
+	OwnerPid         int        `gorm:"column:owner_pid"`
+	ProcessStartTime int64      `gorm:"column:process_start_time"`
+}
+
+func (CommandRunModel) TableName() string {
+	return "command_run"
+}
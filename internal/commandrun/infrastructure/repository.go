@@ -0,0 +1,85 @@
+package infrastructure
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"gomander/internal/commandrun/domain"
+	"gomander/internal/helpers/array"
+)
+
+type GormCommandRunRepository struct {
+	db  *gorm.DB
+	ctx context.Context
+}
+
+func NewGormCommandRunRepository(db *gorm.DB, ctx context.Context) *GormCommandRunRepository {
+	return &GormCommandRunRepository{
+		db:  db,
+		ctx: ctx,
+	}
+}
+
+func (r GormCommandRunRepository) GetAll(filter domain.Filter) ([]domain.CommandRun, error) {
+	query := gorm.G[CommandRunModel](r.db).Order("started_at DESC")
+	if filter.CommandId != "" {
+		query = query.Where("command_id = ?", filter.CommandId)
+	}
+	if filter.Trigger != "" {
+		query = query.Where("trigger_source = ?", string(filter.Trigger))
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.ErrorDetected != nil {
+		query = query.Where("error_detected = ?", *filter.ErrorDetected)
+	}
+	if filter.StartedAfter != nil {
+		query = query.Where("started_at >= ?", *filter.StartedAfter)
+	}
+	if filter.StartedBefore != nil {
+		query = query.Where("started_at < ?", *filter.StartedBefore)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+
+	runs, err := query.Find(r.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return array.Map(runs, ToDomainCommandRun), nil
+}
+
+func (r GormCommandRunRepository) Create(run *domain.CommandRun) error {
+	runModel := ToCommandRunModel(run)
+
+	err := gorm.G[CommandRunModel](r.db).Create(r.ctx, &runModel)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r GormCommandRunRepository) Update(run *domain.CommandRun) error {
+	runModel := ToCommandRunModel(run)
+
+	_, err := gorm.G[CommandRunModel](r.db).Where("id = ?", runModel.Id).Select("*").Updates(r.ctx, runModel)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r GormCommandRunRepository) DeleteAll(commandId string) error {
+	_, err := gorm.G[CommandRunModel](r.db).Where("command_id = ?", commandId).Delete(r.ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
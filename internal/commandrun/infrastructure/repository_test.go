@@ -0,0 +1,162 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"gomander/internal/commandrun/domain"
+	_ "gomander/migrations"
+)
+
+var baseTime = time.Date(2026, 10, 17, 14, 0, 0, 0, time.UTC)
+
+func newCommandRun(id string, commandId string, trigger domain.Trigger, startedAt time.Time) domain.CommandRun {
+	return domain.CommandRun{
+		Id:               id,
+		CommandId:        commandId,
+		Command:          "go run ./worker",
+		WorkingDirectory: "/projects/app",
+		Trigger:          trigger,
+		Status:           "running",
+		StartedAt:        startedAt,
+		Pid:              4200,
+		Pgid:             4200,
+		OwnerPid:         4100,
+		ProcessStartTime: 5300,
+	}
+}
+
+func TestGormCommandRunRepository_GetAll(t *testing.T) {
+	crashed := newCommandRun("run-1", "worker", domain.TriggerUI, baseTime)
+	crashedAt := baseTime.Add(20 * time.Minute)
+	exitCode := 2
+	crashed.Status = "exited-error"
+	crashed.FinishedAt = &crashedAt
+	crashed.ExitCode = &exitCode
+	crashed.ErrorDetected = true
+
+	restarted := newCommandRun("run-2", "worker", domain.TriggerRestart, baseTime.Add(21*time.Minute))
+	other := newCommandRun("run-3", "api", domain.TriggerHTTP, baseTime.Add(time.Minute))
+
+	t.Run("Should return the runs of a command from the most recent", func(t *testing.T) {
+		// Arrange
+		repo := arrange(t, crashed, restarted, other)
+
+		// Act
+		runs, err := repo.GetAll(domain.Filter{CommandId: "worker"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.CommandRun{restarted, crashed}, runs)
+	})
+	t.Run("Should filter the runs", func(t *testing.T) {
+		// Arrange
+		repo := arrange(t, crashed, restarted, other)
+		errorDetected := true
+		startedBefore := baseTime.Add(30 * time.Minute)
+
+		// Act
+		runs, err := repo.GetAll(domain.Filter{
+			Status:        "exited-error",
+			ErrorDetected: &errorDetected,
+			StartedBefore: &startedBefore,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.CommandRun{crashed}, runs)
+	})
+	t.Run("Should limit the runs returned", func(t *testing.T) {
+		// Arrange
+		repo := arrange(t, crashed, restarted, other)
+		startedAfter := baseTime.Add(time.Minute)
+
+		// Act
+		runs, err := repo.GetAll(domain.Filter{StartedAfter: &startedAfter, Limit: 1})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []domain.CommandRun{restarted}, runs)
+	})
+}
+
+func TestGormCommandRunRepository_Update(t *testing.T) {
+	t.Run("Should record the end of the run", func(t *testing.T) {
+		// Arrange
+		run := newCommandRun("run-1", "worker", domain.TriggerGroup, baseTime)
+		repo := arrange(t, run)
+
+		finishedAt := baseTime.Add(time.Hour)
+		exitCode := 0
+		run.Status = "exited-ok"
+		run.FinishedAt = &finishedAt
+		run.ExitCode = &exitCode
+
+		// Act
+		err := repo.Update(&run)
+
+		// Assert
+		assert.NoError(t, err)
+		runs, _ := repo.GetAll(domain.Filter{})
+		assert.Equal(t, []domain.CommandRun{run}, runs)
+		assert.Equal(t, time.Hour, runs[0].Duration())
+	})
+}
+
+func TestGormCommandRunRepository_DeleteAll(t *testing.T) {
+	t.Run("Should delete the runs of the command", func(t *testing.T) {
+		// Arrange
+		worker := newCommandRun("run-1", "worker", domain.TriggerUI, baseTime)
+		api := newCommandRun("run-2", "api", domain.TriggerUI, baseTime)
+		repo := arrange(t, worker, api)
+
+		// Act
+		err := repo.DeleteAll("worker")
+
+		// Assert
+		assert.NoError(t, err)
+		runs, _ := repo.GetAll(domain.Filter{})
+		assert.Equal(t, []domain.CommandRun{api}, runs)
+	})
+}
+
+func arrange(t *testing.T, preloadedRuns ...domain.CommandRun) *GormCommandRunRepository {
+	// Initialize the database
+	ctx := context.Background()
+	gormDb, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		panic(err)
+	}
+
+	db, err := gormDb.DB()
+	if err != nil {
+		panic(err)
+	}
+
+	// Execute migrations
+	err = goose.SetDialect("sqlite3")
+	if err != nil {
+		panic(err)
+	}
+
+	err = goose.UpContext(ctx, db, ".")
+	if err != nil {
+		panic(err)
+	}
+
+	repo := NewGormCommandRunRepository(gormDb, ctx)
+	for _, run := range preloadedRuns {
+		err = repo.Create(&run)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return repo
+}
@@ -0,0 +1,40 @@
+package usecases
+
+import "gomander/internal/config/domain"
+
+type GetIntegrationsAccess interface {
+	Execute() (*domain.IntegrationsAccess, error)
+}
+
+type DefaultGetIntegrationsAccess struct {
+	repository domain.Repository
+}
+
+func NewGetIntegrationsAccess(repository domain.Repository) *DefaultGetIntegrationsAccess {
+	return &DefaultGetIntegrationsAccess{repository: repository}
+}
+
+// Execute returns the integrations access settings, generating and storing the token on first use
+func (uc *DefaultGetIntegrationsAccess) Execute() (*domain.IntegrationsAccess, error) {
+	config, err := uc.repository.GetOrCreate()
+	if err != nil {
+		return nil, err
+	}
+
+	if config.IntegrationsToken == "" {
+		token, err := domain.NewIntegrationsToken()
+		if err != nil {
+			return nil, err
+		}
+
+		config.IntegrationsToken = token
+		if err := uc.repository.Update(config); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.IntegrationsAccess{
+		Token:     config.IntegrationsToken,
+		LanAccess: config.IntegrationsLanAccess,
+	}, nil
+}
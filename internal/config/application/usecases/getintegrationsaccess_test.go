@@ -0,0 +1,78 @@
+package usecases_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/config/application/usecases"
+	"gomander/internal/config/domain"
+	"gomander/internal/config/domain/test"
+)
+
+func TestDefaultGetIntegrationsAccess_Execute(t *testing.T) {
+	t.Run("Should return the stored integrations access", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockConfigRepository)
+
+		sut := usecases.NewGetIntegrationsAccess(mockRepository)
+
+		mockRepository.On("GetOrCreate").Return(&domain.Config{
+			IntegrationsToken:     "token",
+			IntegrationsLanAccess: true,
+		}, nil)
+
+		// Act
+		result, err := sut.Execute()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.IntegrationsAccess{Token: "token", LanAccess: true}, result)
+
+		mockRepository.AssertNotCalled(t, "Update", mock.Anything)
+		mock.AssertExpectationsForObjects(t, mockRepository)
+	})
+
+	t.Run("Should generate and store the token if missing", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockConfigRepository)
+
+		sut := usecases.NewGetIntegrationsAccess(mockRepository)
+
+		mockRepository.On("GetOrCreate").Return(&domain.Config{LogLineLimit: 100}, nil)
+		mockRepository.On("Update", mock.MatchedBy(func(config *domain.Config) bool {
+			return len(config.IntegrationsToken) == 64 && config.LogLineLimit == 100
+		})).Return(nil)
+
+		// Act
+		result, err := sut.Execute()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, result.Token, 64)
+		assert.False(t, result.LanAccess)
+
+		mock.AssertExpectationsForObjects(t, mockRepository)
+	})
+
+	t.Run("Should return an error if failing to store the token", func(t *testing.T) {
+		// Arrange
+		mockRepository := new(test.MockConfigRepository)
+
+		sut := usecases.NewGetIntegrationsAccess(mockRepository)
+
+		mockRepository.On("GetOrCreate").Return(&domain.Config{}, nil)
+		mockRepository.On("Update", mock.Anything).Return(errors.New("failed to save user configuration"))
+
+		// Act
+		result, err := sut.Execute()
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		mock.AssertExpectationsForObjects(t, mockRepository)
+	})
+}
@@ -0,0 +1,19 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/config/domain"
+)
+
+type MockGetUserConfig struct {
+	mock.Mock
+}
+
+func (m *MockGetUserConfig) Execute() (*domain.Config, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Config), args.Error(1)
+}
@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/config/domain"
+)
+
+type MockSaveUserConfig struct {
+	mock.Mock
+}
+
+func (m *MockSaveUserConfig) Execute(config domain.Config) error {
+	args := m.Called(config)
+	return args.Error(0)
+}
@@ -1,13 +1,39 @@
 package domain
 
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
 type EnvironmentPath struct {
 	Id   string `json:"id"`
 	Path string `json:"path"`
 }
 
 type Config struct {
-	LastOpenedProjectId string            `json:"lastOpenedProjectId"`
-	EnvironmentPaths    []EnvironmentPath `json:"environmentPaths"`
-	LogLineLimit        int               `json:"logLineLimit"`
-	Locale              string            `json:"locale"`
+	LastOpenedProjectId   string            `json:"lastOpenedProjectId"`
+	EnvironmentPaths      []EnvironmentPath `json:"environmentPaths"`
+	LogLineLimit          int               `json:"logLineLimit"`
+	Locale                string            `json:"locale"`
+	IntegrationsToken     string            `json:"integrationsToken"`
+	IntegrationsLanAccess bool              `json:"integrationsLanAccess"`
+	// DetachedProcesses runs the commands in a background supervisor, so they outlive the window.
+	// It takes effect on the next launch.
+	DetachedProcesses bool `json:"detachedProcesses"`
+}
+
+// NewIntegrationsToken generates a random bearer token for the third-party integrations server
+func NewIntegrationsToken() (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
+// IntegrationsAccess holds the settings protecting the third-party integrations server
+type IntegrationsAccess struct {
+	Token     string
+	LanAccess bool
 }
@@ -8,10 +8,13 @@ func ToDomainConfig(model *ConfigModel, paths []EnvironmentPathModel) *domain.Co
 	}
 
 	config := &domain.Config{
-		LastOpenedProjectId: model.LastOpenedProjectId,
-		EnvironmentPaths:    make([]domain.EnvironmentPath, 0),
-		LogLineLimit:        model.LogLineLimit,
-		Locale:              model.Locale,
+		LastOpenedProjectId:   model.LastOpenedProjectId,
+		EnvironmentPaths:      make([]domain.EnvironmentPath, 0),
+		LogLineLimit:          model.LogLineLimit,
+		Locale:                model.Locale,
+		IntegrationsToken:     model.IntegrationsToken,
+		IntegrationsLanAccess: model.IntegrationsLanAccess,
+		DetachedProcesses:     model.DetachedProcesses,
 	}
 
 	for _, pathModel := range paths {
@@ -30,10 +33,13 @@ func ToModelConfig(config *domain.Config) (*ConfigModel, []EnvironmentPathModel)
 	}
 
 	model := &ConfigModel{
-		Id:                  1,
-		LastOpenedProjectId: config.LastOpenedProjectId,
-		LogLineLimit:        config.LogLineLimit,
-		Locale:              config.Locale,
+		Id:                    1,
+		LastOpenedProjectId:   config.LastOpenedProjectId,
+		LogLineLimit:          config.LogLineLimit,
+		Locale:                config.Locale,
+		IntegrationsToken:     config.IntegrationsToken,
+		IntegrationsLanAccess: config.IntegrationsLanAccess,
+		DetachedProcesses:     config.DetachedProcesses,
 	}
 
 	var pathModels []EnvironmentPathModel
@@ -1,10 +1,13 @@
 package infrastructure
 
 type ConfigModel struct {
-	Id                  int    `gorm:"primaryKey;column:id"`
-	LastOpenedProjectId string `gorm:"column:last_opened_project_id"`
-	LogLineLimit        int    `gorm:"column:log_line_limit"`
-	Locale              string `gorm:"column:locale"`
+	Id                    int    `gorm:"primaryKey;column:id"`
+	LastOpenedProjectId   string `gorm:"column:last_opened_project_id"`
+	LogLineLimit          int    `gorm:"column:log_line_limit"`
+	Locale                string `gorm:"column:locale"`
+	IntegrationsToken     string `gorm:"column:integrations_token"`
+	IntegrationsLanAccess bool   `gorm:"column:integrations_lan_access"`
+	DetachedProcesses     bool   `gorm:"column:detached_processes"`
 }
 
 func (ConfigModel) TableName() string {
@@ -55,7 +55,13 @@ func (g GormConfigRepository) Update(config *domain.Config) error {
 		return errors.New("config cannot be nil")
 	}
 
-	_, err := gorm.G[ConfigModel](g.db).Where("id = ?", 1).Select("*").Updates(g.ctx, *configModel)
+	query := gorm.G[ConfigModel](g.db).Where("id = ?", 1).Select("*")
+	if configModel.IntegrationsToken == "" {
+		// Clients saving the config without the token must not revoke it
+		query = query.Omit("integrations_token")
+	}
+
+	_, err := query.Updates(g.ctx, *configModel)
 	if err != nil {
 		return err
 	}
@@ -117,6 +117,43 @@ func TestGormConfigRepository_Update(t *testing.T) {
 			LogLineLimit: 500,
 		}, got)
 	})
+
+	t.Run("Should keep the integrations token when saving a config without it", func(t *testing.T) {
+		// Arrange
+		preloadedConfig := &ConfigModel{Id: 1, LogLineLimit: 100, IntegrationsToken: "token"}
+		helper := newTestHelper(t, preloadedConfig, nil)
+
+		newConfig := &domain.Config{
+			LogLineLimit:          100,
+			IntegrationsLanAccess: true,
+		}
+
+		// Act
+		err := helper.repo.Update(newConfig)
+
+		// Assert
+		assert.NoError(t, err)
+
+		got, err := helper.repo.GetOrCreate()
+		assert.NoError(t, err)
+		assert.Equal(t, "token", got.IntegrationsToken)
+		assert.True(t, got.IntegrationsLanAccess)
+	})
+
+	t.Run("Should persist the detached processes setting", func(t *testing.T) {
+		// Arrange
+		helper := newTestHelper(t, &ConfigModel{Id: 1, LogLineLimit: 100}, nil)
+
+		// Act
+		err := helper.repo.Update(&domain.Config{LogLineLimit: 100, DetachedProcesses: true})
+
+		// Assert
+		assert.NoError(t, err)
+
+		got, err := helper.repo.GetOrCreate()
+		assert.NoError(t, err)
+		assert.True(t, got.DetachedProcesses)
+	})
 }
 
 func arrange(preloadedConfig *ConfigModel, preloadedPaths []*EnvironmentPathModel) (repo *GormConfigRepository) {
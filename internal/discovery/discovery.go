@@ -0,0 +1,142 @@
+package discovery
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	filePrefix      = "gomander-"
+	fileExtension   = ".json"
+	socketExtension = ".sock"
+)
+
+// Instance describes a running Gomander, so clients can reach its integrations server without scanning ports.
+type Instance struct {
+	Pid        int       `json:"pid"`
+	Port       int       `json:"port"`
+	Socket     string    `json:"socket,omitempty"`
+	Token      string    `json:"token"`
+	Version    string    `json:"version"`
+	APIVersion string    `json:"apiVersion"`
+	BasePath   string    `json:"basePath"`
+	StartedAt  time.Time `json:"startedAt"`
+}
+
+// FilePath returns the path of the discovery file of the process. Each process writes its own file,
+// so several instances never overwrite each other.
+func FilePath(directory string, pid int) string {
+	return filepath.Join(directory, filePrefix+strconv.Itoa(pid)+fileExtension)
+}
+
+// SocketPath returns the path of the Unix socket of the process, next to its discovery file.
+func SocketPath(directory string, pid int) string {
+	return filepath.Join(directory, filePrefix+strconv.Itoa(pid)+socketExtension)
+}
+
+// Write stores the discovery file of the instance. The file holds the token, so it's only readable by its owner.
+// It's written to a temporary file first, so readers never see it half-written.
+func Write(directory string, instance Instance) error {
+	err := os.MkdirAll(directory, 0700)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(instance, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(directory, "."+filePrefix+"*")
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(data)
+	closeErr := file.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(file.Name(), FilePath(directory, instance.Pid))
+	}
+	if err != nil {
+		_ = os.Remove(file.Name())
+		return err
+	}
+
+	return nil
+}
+
+// Remove deletes the discovery file of the process, if any.
+func Remove(directory string, pid int) error {
+	err := os.Remove(FilePath(directory, pid))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// List returns the running instances, the most recently started first.
+// The files left behind by processes that are no longer running, or that can't be read, are removed
+// along with their sockets.
+func List(directory string) ([]Instance, error) {
+	entries, err := os.ReadDir(directory)
+	if errors.Is(err, os.ErrNotExist) {
+		return []Instance{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]Instance, 0)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, filePrefix) || !strings.HasSuffix(name, fileExtension) {
+			continue
+		}
+
+		path := filepath.Join(directory, name)
+		instance, err := read(path)
+		if err != nil || FilePath(directory, instance.Pid) != path || !isProcessRunning(instance.Pid) {
+			_ = os.Remove(path)
+			if err == nil && instance.Socket == SocketPath(directory, instance.Pid) {
+				_ = os.Remove(instance.Socket)
+			}
+			continue
+		}
+
+		instances = append(instances, instance)
+	}
+
+	slices.SortFunc(instances, func(a, b Instance) int {
+		return b.StartedAt.Compare(a.StartedAt)
+	})
+
+	return instances, nil
+}
+
+func read(path string) (Instance, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Instance{}, err
+	}
+
+	var instance Instance
+	err = json.Unmarshal(data, &instance)
+	if err != nil {
+		return Instance{}, err
+	}
+	if instance.Pid <= 0 || instance.Port <= 0 {
+		return Instance{}, fmt.Errorf("invalid discovery file %s", path)
+	}
+
+	return instance, nil
+}
@@ -0,0 +1,153 @@
+package discovery_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/discovery"
+)
+
+func exitedProcessPid(t *testing.T) int {
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	assert.NoError(t, cmd.Run())
+	return cmd.Process.Pid
+}
+
+func TestWrite(t *testing.T) {
+	t.Run("Should write the discovery file of the instance", func(t *testing.T) {
+		// Arrange
+		directory := filepath.Join(t.TempDir(), "run")
+		instance := discovery.Instance{
+			Pid:       os.Getpid(),
+			Port:      9002,
+			Token:     "token",
+			Version:   "1.4.0",
+			StartedAt: time.Now().UTC(),
+		}
+
+		// Act
+		err := discovery.Write(directory, instance)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.FileExists(t, discovery.FilePath(directory, instance.Pid))
+
+		instances, err := discovery.List(directory)
+		assert.NoError(t, err)
+		assert.Len(t, instances, 1)
+		assert.Equal(t, instance.Port, instances[0].Port)
+		assert.Equal(t, instance.Token, instances[0].Token)
+
+		entries, err := os.ReadDir(directory)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1, "The temporary file should have been renamed")
+	})
+}
+
+func TestRemove(t *testing.T) {
+	t.Run("Should remove the discovery file of the process", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		assert.NoError(t, discovery.Write(directory, discovery.Instance{Pid: os.Getpid(), Port: 9002}))
+
+		// Act
+		err := discovery.Remove(directory, os.Getpid())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoFileExists(t, discovery.FilePath(directory, os.Getpid()))
+	})
+
+	t.Run("Should not fail when the file does not exist", func(t *testing.T) {
+		// Act
+		err := discovery.Remove(t.TempDir(), os.Getpid())
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("Should return the most recently started instances first", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		now := time.Now().UTC()
+		assert.NoError(t, discovery.Write(directory, discovery.Instance{Pid: os.Getpid(), Port: 9002, StartedAt: now}))
+		assert.NoError(t, discovery.Write(directory, discovery.Instance{Pid: os.Getppid(), Port: 9003, StartedAt: now.Add(-time.Minute)}))
+
+		// Act
+		instances, err := discovery.List(directory)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, instances, 2)
+		assert.Equal(t, 9002, instances[0].Port)
+		assert.Equal(t, 9003, instances[1].Port)
+	})
+
+	t.Run("Should remove the files of processes that are no longer running", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		stalePid := exitedProcessPid(t)
+		assert.NoError(t, discovery.Write(directory, discovery.Instance{Pid: stalePid, Port: 9002}))
+		assert.NoError(t, discovery.Write(directory, discovery.Instance{Pid: os.Getpid(), Port: 9003}))
+
+		// Act
+		instances, err := discovery.List(directory)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, instances, 1)
+		assert.Equal(t, os.Getpid(), instances[0].Pid)
+		assert.NoFileExists(t, discovery.FilePath(directory, stalePid))
+	})
+
+	t.Run("Should remove the socket of processes that are no longer running", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		stalePid := exitedProcessPid(t)
+		socketPath := discovery.SocketPath(directory, stalePid)
+		assert.NoError(t, os.WriteFile(socketPath, nil, 0600))
+		assert.NoError(t, discovery.Write(directory, discovery.Instance{Pid: stalePid, Port: 9002, Socket: socketPath}))
+
+		// Act
+		instances, err := discovery.List(directory)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, instances)
+		assert.NoFileExists(t, socketPath)
+	})
+
+	t.Run("Should remove the files that can't be read", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		corrupted := discovery.FilePath(directory, os.Getpid())
+		assert.NoError(t, os.WriteFile(corrupted, []byte("{"), 0600))
+		unrelated := filepath.Join(directory, "other.json")
+		assert.NoError(t, os.WriteFile(unrelated, []byte("{"), 0600))
+
+		// Act
+		instances, err := discovery.List(directory)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, instances)
+		assert.NoFileExists(t, corrupted)
+		assert.FileExists(t, unrelated)
+	})
+
+	t.Run("Should return no instances when the directory does not exist", func(t *testing.T) {
+		// Act
+		instances, err := discovery.List(filepath.Join(t.TempDir(), "missing"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, instances)
+	})
+}
@@ -0,0 +1,14 @@
+//go:build !windows
+
+package discovery
+
+import (
+	"errors"
+	"syscall"
+)
+
+func isProcessRunning(pid int) bool {
+	// Signal 0 only checks whether the process exists. EPERM means it exists but belongs to another user.
+	err := syscall.Kill(pid, 0)
+	return err == nil || errors.Is(err, syscall.EPERM)
+}
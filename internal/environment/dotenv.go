@@ -0,0 +1,295 @@
+package environment
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseDotEnv parses the content of a dotenv file.
+// It supports comments, `export` prefixes, single and double-quoted values (which may span several lines)
+// and `$VAR`, `${VAR}` and `${VAR:-default}` expansion in unquoted and double-quoted values.
+// Variables are expanded with the ones defined earlier in the same file, falling back to lookup.
+func ParseDotEnv(data string, lookup func(key string) (string, bool)) ([]Variable, error) {
+	p := &dotEnvParser{
+		data:   strings.ReplaceAll(data, "\r\n", "\n"),
+		line:   1,
+		lookup: lookup,
+		values: make(map[string]string),
+	}
+
+	return p.parse()
+}
+
+type dotEnvParser struct {
+	data   string
+	pos    int
+	line   int
+	lookup func(key string) (string, bool)
+	values map[string]string
+}
+
+func (p *dotEnvParser) parse() ([]Variable, error) {
+	variables := make([]Variable, 0)
+
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return variables, nil
+		}
+
+		if p.peek() == '#' {
+			p.skipLine()
+			continue
+		}
+
+		variable, err := p.parseVariable()
+		if err != nil {
+			return nil, err
+		}
+
+		p.values[variable.Key] = variable.Value
+		variables = append(variables, variable)
+	}
+}
+
+func (p *dotEnvParser) parseVariable() (Variable, error) {
+	key := p.readKey()
+	if key == "export" && !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.skipSpaces()
+		key = p.readKey()
+	}
+
+	if key == "" {
+		return Variable{}, p.errorf("expected a variable name")
+	}
+
+	p.skipSpaces()
+	if p.eof() || p.peek() != '=' {
+		return Variable{}, p.errorf("expected '=' after %s", key)
+	}
+	p.pos++
+	p.skipSpaces()
+
+	var value string
+	var err error
+
+	switch {
+	case p.eof() || p.peek() == '\n':
+		value = ""
+	case p.peek() == '\'':
+		value, err = p.readSingleQuoted()
+	case p.peek() == '"':
+		value, err = p.readDoubleQuoted()
+	default:
+		value = p.expand(p.readUnquoted())
+	}
+	if err != nil {
+		return Variable{}, err
+	}
+
+	return Variable{Key: key, Value: value}, p.finishLine()
+}
+
+func (p *dotEnvParser) readKey() string {
+	start := p.pos
+	for !p.eof() && isKeyChar(p.peek(), p.pos == start) {
+		p.pos++
+	}
+	return p.data[start:p.pos]
+}
+
+func (p *dotEnvParser) readSingleQuoted() (string, error) {
+	startLine := p.line
+	p.pos++ // Opening quote
+
+	end := strings.IndexByte(p.data[p.pos:], '\'')
+	if end == -1 {
+		p.line = startLine
+		return "", p.errorf("unterminated single-quoted value")
+	}
+
+	value := p.data[p.pos : p.pos+end]
+	p.line += strings.Count(value, "\n")
+	p.pos += end + 1
+
+	return value, nil
+}
+
+func (p *dotEnvParser) readDoubleQuoted() (string, error) {
+	startLine := p.line
+	p.pos++ // Opening quote
+
+	var value strings.Builder
+	for !p.eof() {
+		c := p.peek()
+		p.pos++
+
+		switch c {
+		case '"':
+			return value.String(), nil
+		case '\\':
+			if p.eof() {
+				continue
+			}
+			escaped := p.peek()
+			p.pos++
+			switch escaped {
+			case 'n':
+				value.WriteByte('\n')
+			case 'r':
+				value.WriteByte('\r')
+			case 't':
+				value.WriteByte('\t')
+			case '"', '\\', '$':
+				value.WriteByte(escaped)
+			default:
+				value.WriteByte('\\')
+				value.WriteByte(escaped)
+			}
+		case '$':
+			p.pos--
+			value.WriteString(p.readReference())
+		default:
+			if c == '\n' {
+				p.line++
+			}
+			value.WriteByte(c)
+		}
+	}
+
+	p.line = startLine
+	return "", p.errorf("unterminated double-quoted value")
+}
+
+func (p *dotEnvParser) readUnquoted() string {
+	start := p.pos
+	for !p.eof() && p.peek() != '\n' {
+		// An inline comment needs to be preceded by a space
+		if p.peek() == '#' && p.pos > start && (p.data[p.pos-1] == ' ' || p.data[p.pos-1] == '\t') {
+			break
+		}
+		p.pos++
+	}
+	return strings.TrimRight(p.data[start:p.pos], " \t")
+}
+
+// expand resolves the variable references of an unquoted value.
+func (p *dotEnvParser) expand(value string) string {
+	inner := &dotEnvParser{data: value, lookup: p.lookup, values: p.values}
+
+	var result strings.Builder
+	for !inner.eof() {
+		c := inner.peek()
+		if c == '\\' && inner.pos+1 < len(inner.data) && inner.data[inner.pos+1] == '$' {
+			result.WriteByte('$')
+			inner.pos += 2
+			continue
+		}
+		if c == '$' {
+			result.WriteString(inner.readReference())
+			continue
+		}
+		result.WriteByte(c)
+		inner.pos++
+	}
+	return result.String()
+}
+
+// readReference reads a $VAR, ${VAR} or ${VAR:-default} reference and returns its value.
+func (p *dotEnvParser) readReference() string {
+	p.pos++ // $
+
+	if !p.eof() && p.peek() == '{' {
+		end := strings.IndexByte(p.data[p.pos:], '}')
+		if end == -1 {
+			return "$"
+		}
+		reference := p.data[p.pos+1 : p.pos+end]
+		p.pos += end + 1
+
+		key, defaultValue, hasDefault := strings.Cut(reference, ":-")
+		value, found := p.resolve(key)
+		if (!found || value == "") && hasDefault {
+			return defaultValue
+		}
+		return value
+	}
+
+	key := p.readKey()
+	if key == "" {
+		return "$"
+	}
+	value, _ := p.resolve(key)
+	return value
+}
+
+func (p *dotEnvParser) resolve(key string) (string, bool) {
+	if value, found := p.values[key]; found {
+		return value, true
+	}
+	if p.lookup != nil {
+		return p.lookup(key)
+	}
+	return "", false
+}
+
+// finishLine makes sure nothing but spaces or a comment follows a value.
+func (p *dotEnvParser) finishLine() error {
+	p.skipSpaces()
+	if p.eof() {
+		return nil
+	}
+	switch p.peek() {
+	case '\n':
+		return nil
+	case '#':
+		p.skipLine()
+		return nil
+	default:
+		return p.errorf("unexpected character %q after value", p.peek())
+	}
+}
+
+func (p *dotEnvParser) skipBlank() {
+	for !p.eof() {
+		switch p.peek() {
+		case '\n':
+			p.line++
+		case ' ', '\t':
+		default:
+			return
+		}
+		p.pos++
+	}
+}
+
+func (p *dotEnvParser) skipSpaces() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+func (p *dotEnvParser) skipLine() {
+	for !p.eof() && p.peek() != '\n' {
+		p.pos++
+	}
+}
+
+func (p *dotEnvParser) eof() bool {
+	return p.pos >= len(p.data)
+}
+
+func (p *dotEnvParser) peek() byte {
+	return p.data[p.pos]
+}
+
+func (p *dotEnvParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+func isKeyChar(c byte, first bool) bool {
+	if c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+		return true
+	}
+	return !first && c >= '0' && c <= '9'
+}
@@ -0,0 +1,93 @@
+package environment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/environment"
+)
+
+func TestParseDotEnv(t *testing.T) {
+	noLookup := func(string) (string, bool) { return "", false }
+
+	t.Run("Should parse variables skipping comments and blank lines", func(t *testing.T) {
+		// Arrange
+		data := "# Database\n\nDB_HOST=localhost\nexport DB_PORT = 5432 # default port\nEMPTY=\n"
+
+		// Act
+		result, err := environment.ParseDotEnv(data, noLookup)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []environment.Variable{
+			{Key: "DB_HOST", Value: "localhost"},
+			{Key: "DB_PORT", Value: "5432"},
+			{Key: "EMPTY", Value: ""},
+		}, result)
+	})
+
+	t.Run("Should parse quoted values", func(t *testing.T) {
+		// Arrange
+		data := "SINGLE='keep $HOME # as is'\nDOUBLE=\"line\\nbreak \\\"quoted\\\" # not a comment\"\nMULTILINE=\"first\nsecond\"\nHASH=abc#def\n"
+
+		// Act
+		result, err := environment.ParseDotEnv(data, noLookup)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []environment.Variable{
+			{Key: "SINGLE", Value: "keep $HOME # as is"},
+			{Key: "DOUBLE", Value: "line\nbreak \"quoted\" # not a comment"},
+			{Key: "MULTILINE", Value: "first\nsecond"},
+			{Key: "HASH", Value: "abc#def"},
+		}, result)
+	})
+
+	t.Run("Should expand variables from the file and the lookup", func(t *testing.T) {
+		// Arrange
+		data := "HOST=localhost\nURL=http://${HOST}:$PORT/path\nQUOTED=\"$HOME/app\"\nDEFAULT=${MISSING:-fallback}\nESCAPED=\\$HOME\n"
+		lookup := func(key string) (string, bool) {
+			values := map[string]string{"PORT": "8080", "HOME": "/home/user"}
+			value, found := values[key]
+			return value, found
+		}
+
+		// Act
+		result, err := environment.ParseDotEnv(data, lookup)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []environment.Variable{
+			{Key: "HOST", Value: "localhost"},
+			{Key: "URL", Value: "http://localhost:8080/path"},
+			{Key: "QUOTED", Value: "/home/user/app"},
+			{Key: "DEFAULT", Value: "fallback"},
+			{Key: "ESCAPED", Value: "$HOME"},
+		}, result)
+	})
+
+	t.Run("Should return an error with the line number when the file is invalid", func(t *testing.T) {
+		testCases := []struct {
+			name     string
+			data     string
+			expected string
+		}{
+			{name: "missing equals", data: "VALID=1\nINVALID\n", expected: "line 2: expected '=' after INVALID"},
+			{name: "invalid name", data: "1INVALID=1", expected: "line 1: expected a variable name"},
+			{name: "unterminated quote", data: "A=1\nB=\"open\nC=3", expected: "line 2: unterminated double-quoted value"},
+			{name: "trailing characters", data: "A='quoted' trailing", expected: "line 1: unexpected character 't' after value"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				// Act
+				result, err := environment.ParseDotEnv(tc.data, noLookup)
+
+				// Assert
+				assert.Nil(t, result)
+				assert.EqualError(t, err, tc.expected)
+			})
+		}
+	})
+}
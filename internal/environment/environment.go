@@ -0,0 +1,80 @@
+package environment
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+type Variable struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ToEnv converts the variables to the KEY=VALUE format used by exec.Cmd, skipping the ones without key.
+func ToEnv(variables []Variable) []string {
+	env := make([]string, 0, len(variables))
+	for _, variable := range variables {
+		if variable.Key == "" {
+			continue
+		}
+		env = append(env, variable.Key+"="+variable.Value)
+	}
+	return env
+}
+
+// Lookup returns the value of key in an environment in the KEY=VALUE format, where later entries take precedence.
+func Lookup(env []string, key string) (string, bool) {
+	for i := len(env) - 1; i >= 0; i-- {
+		entryKey, value, found := strings.Cut(env[i], "=")
+		if found && entryKey == key {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// MarshalVariables serializes the variables to be stored in a single column.
+func MarshalVariables(variables []Variable) string {
+	if len(variables) == 0 {
+		return ""
+	}
+
+	data, err := json.Marshal(variables)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
+
+// UnmarshalVariables deserializes the variables stored by MarshalVariables.
+// Invalid data is treated as no variables at all.
+func UnmarshalVariables(data string) []Variable {
+	if data == "" {
+		return nil
+	}
+
+	var variables []Variable
+	err := json.Unmarshal([]byte(data), &variables)
+	if err != nil {
+		return nil
+	}
+
+	return variables
+}
+
+// MarshalEnvFiles serializes the env file paths to be stored in a single column, one per line.
+func MarshalEnvFiles(envFiles []string) string {
+	return strings.Join(envFiles, "\n")
+}
+
+// UnmarshalEnvFiles deserializes the env file paths stored by MarshalEnvFiles, skipping empty lines.
+func UnmarshalEnvFiles(data string) []string {
+	var envFiles []string
+	for _, envFile := range strings.Split(data, "\n") {
+		if envFile != "" {
+			envFiles = append(envFiles, envFile)
+		}
+	}
+	return envFiles
+}
@@ -0,0 +1,105 @@
+package environment_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/environment"
+)
+
+func TestToEnv(t *testing.T) {
+	t.Run("Should convert variables to KEY=VALUE entries", func(t *testing.T) {
+		// Arrange
+		variables := []environment.Variable{
+			{Key: "FOO", Value: "bar"},
+			{Key: "EMPTY", Value: ""},
+			{Key: "WITH_EQUALS", Value: "a=b"},
+		}
+
+		// Act
+		result := environment.ToEnv(variables)
+
+		// Assert
+		assert.Equal(t, []string{"FOO=bar", "EMPTY=", "WITH_EQUALS=a=b"}, result)
+	})
+
+	t.Run("Should skip variables without key", func(t *testing.T) {
+		// Arrange
+		variables := []environment.Variable{
+			{Key: "", Value: "ignored"},
+			{Key: "FOO", Value: "bar"},
+		}
+
+		// Act
+		result := environment.ToEnv(variables)
+
+		// Assert
+		assert.Equal(t, []string{"FOO=bar"}, result)
+	})
+}
+
+func TestMarshalVariables(t *testing.T) {
+	t.Run("Should round trip the variables", func(t *testing.T) {
+		// Arrange
+		variables := []environment.Variable{
+			{Key: "FOO", Value: "bar"},
+			{Key: "MULTILINE", Value: "a\nb"},
+		}
+
+		// Act
+		result := environment.UnmarshalVariables(environment.MarshalVariables(variables))
+
+		// Assert
+		assert.Equal(t, variables, result)
+	})
+
+	t.Run("Should marshal no variables as an empty string", func(t *testing.T) {
+		assert.Equal(t, "", environment.MarshalVariables(nil))
+		assert.Equal(t, "", environment.MarshalVariables([]environment.Variable{}))
+	})
+
+	t.Run("Should unmarshal empty or invalid data as no variables", func(t *testing.T) {
+		assert.Nil(t, environment.UnmarshalVariables(""))
+		assert.Nil(t, environment.UnmarshalVariables("not json"))
+	})
+}
+
+func TestLookup(t *testing.T) {
+	t.Run("Should return the last value of the key", func(t *testing.T) {
+		// Arrange
+		env := []string{"FOO=first", "BAR=bar", "FOO=second"}
+
+		// Act
+		value, found := environment.Lookup(env, "FOO")
+
+		// Assert
+		assert.True(t, found)
+		assert.Equal(t, "second", value)
+	})
+
+	t.Run("Should not find missing keys", func(t *testing.T) {
+		// Act
+		_, found := environment.Lookup([]string{"FOO=bar"}, "FO")
+
+		// Assert
+		assert.False(t, found)
+	})
+}
+
+func TestMarshalEnvFiles(t *testing.T) {
+	t.Run("Should round trip the env files", func(t *testing.T) {
+		// Arrange
+		envFiles := []string{".env", "config/.env.local"}
+
+		// Act
+		result := environment.UnmarshalEnvFiles(environment.MarshalEnvFiles(envFiles))
+
+		// Assert
+		assert.Equal(t, envFiles, result)
+	})
+
+	t.Run("Should unmarshal empty data as no env files", func(t *testing.T) {
+		assert.Nil(t, environment.UnmarshalEnvFiles(""))
+	})
+}
@@ -0,0 +1,79 @@
+package event
+
+import "sync"
+
+// SubscriptionBufferSize is the number of messages kept for a subscriber that is not keeping up.
+// Messages are dropped for that subscriber once its buffer is full, so a slow consumer never blocks the emitters.
+var SubscriptionBufferSize = 256
+
+type Message struct {
+	Event   Event       `json:"event"`
+	Payload interface{} `json:"payload"`
+}
+
+type Subscriber interface {
+	// Subscribe returns the channel receiving the emitted messages accepted by the filter, and the function ending the
+	// subscription, which closes the channel.
+	Subscribe(filter func(Message) bool) (<-chan Message, func())
+}
+
+// Broadcaster forwards the events to the wrapped emitter and to its subscribers
+type Broadcaster struct {
+	emitter     EventEmitter
+	mutex       sync.RWMutex
+	subscribers map[*subscription]struct{}
+}
+
+type subscription struct {
+	messages chan Message
+	filter   func(Message) bool
+}
+
+func NewBroadcaster(emitter EventEmitter) *Broadcaster {
+	return &Broadcaster{
+		emitter:     emitter,
+		subscribers: make(map[*subscription]struct{}),
+	}
+}
+
+func (b *Broadcaster) EmitEvent(event Event, payload interface{}) {
+	b.emitter.EmitEvent(event, payload)
+
+	message := Message{Event: event, Payload: payload}
+
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for s := range b.subscribers {
+		if s.filter != nil && !s.filter(message) {
+			continue
+		}
+		select {
+		case s.messages <- message:
+		default:
+		}
+	}
+}
+
+func (b *Broadcaster) Subscribe(filter func(Message) bool) (<-chan Message, func()) {
+	s := &subscription{
+		messages: make(chan Message, SubscriptionBufferSize),
+		filter:   filter,
+	}
+
+	b.mutex.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mutex.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mutex.Lock()
+			delete(b.subscribers, s)
+			b.mutex.Unlock()
+			close(s.messages)
+		})
+	}
+
+	return s.messages, unsubscribe
+}
@@ -0,0 +1,90 @@
+package event_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/event"
+	"gomander/internal/event/test"
+)
+
+func TestBroadcaster_EmitEvent(t *testing.T) {
+	t.Run("Should forward the event to the wrapped emitter and the subscribers", func(t *testing.T) {
+		// Arrange
+		mockEmitter := new(test.MockEventEmitter)
+		mockEmitter.On("EmitEvent", event.ProcessStarted, "cmd-1").Return()
+
+		sut := event.NewBroadcaster(mockEmitter)
+		messages, unsubscribe := sut.Subscribe(nil)
+		defer unsubscribe()
+
+		// Act
+		sut.EmitEvent(event.ProcessStarted, "cmd-1")
+
+		// Assert
+		assert.Equal(t, event.Message{Event: event.ProcessStarted, Payload: "cmd-1"}, <-messages)
+		mock.AssertExpectationsForObjects(t, mockEmitter)
+	})
+
+	t.Run("Should only send the messages accepted by the filter of the subscription", func(t *testing.T) {
+		// Arrange
+		mockEmitter := new(test.MockEventEmitter)
+		mockEmitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
+
+		sut := event.NewBroadcaster(mockEmitter)
+		messages, unsubscribe := sut.Subscribe(func(message event.Message) bool {
+			return message.Payload == "cmd-2"
+		})
+		defer unsubscribe()
+
+		// Act
+		sut.EmitEvent(event.ProcessStarted, "cmd-1")
+		sut.EmitEvent(event.ProcessStarted, "cmd-2")
+
+		// Assert
+		assert.Equal(t, event.Message{Event: event.ProcessStarted, Payload: "cmd-2"}, <-messages)
+		assert.Empty(t, messages)
+	})
+
+	t.Run("Should drop the messages of a subscriber not keeping up instead of blocking", func(t *testing.T) {
+		// Arrange
+		previousBufferSize := event.SubscriptionBufferSize
+		event.SubscriptionBufferSize = 1
+		t.Cleanup(func() { event.SubscriptionBufferSize = previousBufferSize })
+
+		mockEmitter := new(test.MockEventEmitter)
+		mockEmitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
+
+		sut := event.NewBroadcaster(mockEmitter)
+		messages, unsubscribe := sut.Subscribe(nil)
+		defer unsubscribe()
+
+		// Act
+		sut.EmitEvent(event.NewLogEntry, "first")
+		sut.EmitEvent(event.NewLogEntry, "second")
+
+		// Assert
+		assert.Equal(t, "first", (<-messages).Payload)
+		assert.Empty(t, messages)
+	})
+
+	t.Run("Should close the channel and stop sending messages once unsubscribed", func(t *testing.T) {
+		// Arrange
+		mockEmitter := new(test.MockEventEmitter)
+		mockEmitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
+
+		sut := event.NewBroadcaster(mockEmitter)
+		messages, unsubscribe := sut.Subscribe(nil)
+
+		// Act
+		unsubscribe()
+		sut.EmitEvent(event.ProcessStarted, "cmd-1")
+		unsubscribe()
+
+		// Assert
+		_, open := <-messages
+		assert.False(t, open)
+	})
+}
@@ -7,11 +7,18 @@ import (
 type Event string
 
 const (
-	ProcessStarted       Event = "process_started"
-	ProcessFinished      Event = "process_finished"
-	NewLogEntry          Event = "new_log_entry"
-	CommandGroupDeleted  Event = "command_group_deleted"
-	CommandErrorDetected Event = "command_error_detected"
+	ProcessStarted          Event = "process_started"
+	ProcessFinished         Event = "process_finished"
+	NewLogEntry             Event = "new_log_entry"
+	CommandGroupDeleted     Event = "command_group_deleted"
+	CommandErrorDetected    Event = "command_error_detected"
+	ProcessRestarting       Event = "process_restarting"
+	ProcessStopping         Event = "process_stopping"
+	ProcessReady            Event = "process_ready"
+	ProcessReadinessTimeout Event = "process_readiness_timeout"
+	PipelineStarted         Event = "pipeline_started"
+	PipelineStepStarted     Event = "pipeline_step_started"
+	PipelineFinished        Event = "pipeline_finished"
 )
 
 var Events = []struct {
@@ -23,4 +30,11 @@ var Events = []struct {
 	{Value: NewLogEntry, TSName: strings.ToUpper(string(NewLogEntry))},
 	{Value: CommandGroupDeleted, TSName: strings.ToUpper(string(CommandGroupDeleted))},
 	{Value: CommandErrorDetected, TSName: strings.ToUpper(string(CommandErrorDetected))},
+	{Value: ProcessRestarting, TSName: strings.ToUpper(string(ProcessRestarting))},
+	{Value: ProcessStopping, TSName: strings.ToUpper(string(ProcessStopping))},
+	{Value: ProcessReady, TSName: strings.ToUpper(string(ProcessReady))},
+	{Value: ProcessReadinessTimeout, TSName: strings.ToUpper(string(ProcessReadinessTimeout))},
+	{Value: PipelineStarted, TSName: strings.ToUpper(string(PipelineStarted))},
+	{Value: PipelineStepStarted, TSName: strings.ToUpper(string(PipelineStepStarted))},
+	{Value: PipelineFinished, TSName: strings.ToUpper(string(PipelineFinished))},
 }
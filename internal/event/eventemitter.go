@@ -25,3 +25,8 @@ func NewDefaultEventEmitter(ctx context.Context, runtime facade.RuntimeFacade) *
 func (e *DefaultEventEmitter) EmitEvent(event Event, payload interface{}) {
 	e.runtime.EventsEmit(e.ctx, string(event), payload)
 }
+
+// DiscardEventEmitter drops the events, for the processes without a frontend to send them to
+type DiscardEventEmitter struct{}
+
+func (e DiscardEventEmitter) EmitEvent(_ Event, _ interface{}) {}
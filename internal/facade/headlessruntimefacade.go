@@ -0,0 +1,40 @@
+package facade
+
+import (
+	"context"
+	"errors"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+var ErrHeadless = errors.New("not available without a window")
+
+// HeadlessRuntimeFacade replaces the Wails runtime when running without a window.
+// Dialogs fail, events and logs are dropped, use a logger.ConsoleLogger instead.
+type HeadlessRuntimeFacade struct{}
+
+func (h HeadlessRuntimeFacade) SaveFileDialog(_ context.Context, _ runtime.SaveDialogOptions) (string, error) {
+	return "", ErrHeadless
+}
+
+func (h HeadlessRuntimeFacade) OpenFileDialog(_ context.Context, _ runtime.OpenDialogOptions) (string, error) {
+	return "", ErrHeadless
+}
+
+func (h HeadlessRuntimeFacade) OpenDirectoryDialog(_ context.Context, _ runtime.OpenDialogOptions) (string, error) {
+	return "", ErrHeadless
+}
+
+func (h HeadlessRuntimeFacade) EventsEmit(_ context.Context, _ string, _ interface{}) {}
+
+func (h HeadlessRuntimeFacade) LogInfo(_ context.Context, _ string) {}
+
+func (h HeadlessRuntimeFacade) LogDebug(_ context.Context, _ string) {}
+
+func (h HeadlessRuntimeFacade) LogError(_ context.Context, _ string) {}
+
+func (h HeadlessRuntimeFacade) OpenFolderInFileManager(_ string) error {
+	return ErrHeadless
+}
+
+func (h HeadlessRuntimeFacade) CloseApp(_ context.Context) {}
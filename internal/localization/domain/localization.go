@@ -18,6 +18,9 @@ type Localization struct {
 	SidebarCommandsTitle             string `json:"sidebar.commands.title"`
 	SidebarCommandsAdd               string `json:"sidebar.commands.add"`
 	SidebarCommandsRemoveFromGroup   string `json:"sidebar.commands.removeFromGroup"`
+	SidebarCommandsOrphaned          string `json:"sidebar.commands.orphaned"`
+	SidebarCommandsAdopt             string `json:"sidebar.commands.adopt"`
+	SidebarCommandsKill              string `json:"sidebar.commands.kill"`
 	SidebarCommandGroupsTitle        string `json:"sidebar.commandGroups.title"`
 	SidebarCommandGroupsAdd          string `json:"sidebar.commandGroups.add"`
 	SidebarCommandGroupsApplyReorder string `json:"sidebar.commandGroups.applyReorder"`
@@ -95,25 +98,37 @@ type Localization struct {
 	ModalImportProjectAdvancedTrigger string `json:"modal.importProject.advancedTrigger"`
 
 	// commandForm
-	CommandFormNameLabel                 string `json:"commandForm.nameLabel"`
-	CommandFormCommandLabel              string `json:"commandForm.commandLabel"`
-	CommandFormErrorPatternsLabel        string `json:"commandForm.errorPatternsLabel"`
-	CommandFormErrorPatternsDescription  string `json:"commandForm.errorPatternsDescription"`
-	CommandFormLinkLabel                 string `json:"commandForm.linkLabel"`
-	CommandFormWorkingDirectoryLabel     string `json:"commandForm.workingDirectoryLabel"`
-	CommandFormComputedPath              string `json:"commandForm.computedPath"`
-	CommandFormValidationNameRequired    string `json:"commandForm.validation.nameRequired"`
-	CommandFormValidationCommandRequired string `json:"commandForm.validation.commandRequired"`
+	CommandFormNameLabel                    string `json:"commandForm.nameLabel"`
+	CommandFormCommandLabel                 string `json:"commandForm.commandLabel"`
+	CommandFormErrorPatternsLabel           string `json:"commandForm.errorPatternsLabel"`
+	CommandFormErrorPatternsDescription     string `json:"commandForm.errorPatternsDescription"`
+	CommandFormErrorPatternLabelPlaceholder string `json:"commandForm.errorPatternLabelPlaceholder"`
+	CommandFormErrorPatternSeverityError    string `json:"commandForm.errorPatternSeverityError"`
+	CommandFormErrorPatternSeverityWarning  string `json:"commandForm.errorPatternSeverityWarning"`
+	CommandFormErrorPatternSeverityInfo     string `json:"commandForm.errorPatternSeverityInfo"`
+	CommandFormErrorPatternRegexLabel       string `json:"commandForm.errorPatternRegexLabel"`
+	CommandFormLinkLabel                    string `json:"commandForm.linkLabel"`
+	CommandFormWorkingDirectoryLabel        string `json:"commandForm.workingDirectoryLabel"`
+	CommandFormComputedPath                 string `json:"commandForm.computedPath"`
+	CommandFormValidationNameRequired       string `json:"commandForm.validation.nameRequired"`
+	CommandFormValidationCommandRequired    string `json:"commandForm.validation.commandRequired"`
+	CommandFormValidationPatternRequired    string `json:"commandForm.validation.patternRequired"`
 
 	// commandGroupForm
-	CommandGroupFormNameLabel                  string `json:"commandGroupForm.nameLabel"`
-	CommandGroupFormCommandsDescription        string `json:"commandGroupForm.commandsDescription"`
-	CommandGroupFormAvailableCommands          string `json:"commandGroupForm.availableCommands"`
-	CommandGroupFormGroupCommands              string `json:"commandGroupForm.groupCommands"`
-	CommandGroupFormEmptyAvailable             string `json:"commandGroupForm.emptyAvailable"`
-	CommandGroupFormEmptyGroup                 string `json:"commandGroupForm.emptyGroup"`
-	CommandGroupFormValidationNameRequired     string `json:"commandGroupForm.validation.nameRequired"`
-	CommandGroupFormValidationCommandsRequired string `json:"commandGroupForm.validation.commandsRequired"`
+	CommandGroupFormNameLabel                    string `json:"commandGroupForm.nameLabel"`
+	CommandGroupFormCommandsDescription          string `json:"commandGroupForm.commandsDescription"`
+	CommandGroupFormAvailableCommands            string `json:"commandGroupForm.availableCommands"`
+	CommandGroupFormGroupCommands                string `json:"commandGroupForm.groupCommands"`
+	CommandGroupFormEmptyAvailable               string `json:"commandGroupForm.emptyAvailable"`
+	CommandGroupFormEmptyGroup                   string `json:"commandGroupForm.emptyGroup"`
+	CommandGroupFormModeLabel                    string `json:"commandGroupForm.modeLabel"`
+	CommandGroupFormModeParallel                 string `json:"commandGroupForm.modeParallel"`
+	CommandGroupFormModePipeline                 string `json:"commandGroupForm.modePipeline"`
+	CommandGroupFormModeDescription              string `json:"commandGroupForm.modeDescription"`
+	CommandGroupFormContinueOnFailureLabel       string `json:"commandGroupForm.continueOnFailureLabel"`
+	CommandGroupFormContinueOnFailureDescription string `json:"commandGroupForm.continueOnFailureDescription"`
+	CommandGroupFormValidationNameRequired       string `json:"commandGroupForm.validation.nameRequired"`
+	CommandGroupFormValidationCommandsRequired   string `json:"commandGroupForm.validation.commandsRequired"`
 
 	// projectForm
 	ProjectFormNameLabel                    string `json:"projectForm.nameLabel"`
@@ -126,25 +141,35 @@ type Localization struct {
 	ProjectFormValidationBaseDirRequired    string `json:"projectForm.validation.baseDirRequired"`
 
 	// userSettingsForm
-	UserSettingsFormEnvPathsTitle          string `json:"userSettingsForm.envPathsTitle"`
-	UserSettingsFormEnvPathsDescription    string `json:"userSettingsForm.envPathsDescription"`
-	UserSettingsFormEnvPathsHelpBody       string `json:"userSettingsForm.envPathsHelpBody"`
-	UserSettingsFormEnvPathsHelpExample    string `json:"userSettingsForm.envPathsHelpExample"`
-	UserSettingsFormPreferencesTitle       string `json:"userSettingsForm.preferencesTitle"`
-	UserSettingsFormPreferencesDescription string `json:"userSettingsForm.preferencesDescription"`
-	UserSettingsFormLanguageLabel          string `json:"userSettingsForm.languageLabel"`
-	UserSettingsFormLanguagePlaceholder    string `json:"userSettingsForm.languagePlaceholder"`
-	UserSettingsFormThemeLabel             string `json:"userSettingsForm.themeLabel"`
-	UserSettingsFormThemePlaceholder       string `json:"userSettingsForm.themePlaceholder"`
-	UserSettingsFormThemeSystem            string `json:"userSettingsForm.themeSystem"`
-	UserSettingsFormThemeLight             string `json:"userSettingsForm.themeLight"`
-	UserSettingsFormThemeDark              string `json:"userSettingsForm.themeDark"`
-	UserSettingsFormThemeDescription       string `json:"userSettingsForm.themeDescription"`
-	UserSettingsFormLogLimitLabel          string `json:"userSettingsForm.logLimitLabel"`
-	UserSettingsFormLogLimitDescription    string `json:"userSettingsForm.logLimitDescription"`
-	UserSettingsFormValidationPathEmpty    string `json:"userSettingsForm.validation.pathEmpty"`
-	UserSettingsFormValidationLogLimitMin  string `json:"userSettingsForm.validation.logLimitMin"`
-	UserSettingsFormValidationLogLimitMax  string `json:"userSettingsForm.validation.logLimitMax"`
+	UserSettingsFormEnvPathsTitle                    string `json:"userSettingsForm.envPathsTitle"`
+	UserSettingsFormEnvPathsDescription              string `json:"userSettingsForm.envPathsDescription"`
+	UserSettingsFormEnvPathsHelpBody                 string `json:"userSettingsForm.envPathsHelpBody"`
+	UserSettingsFormEnvPathsHelpExample              string `json:"userSettingsForm.envPathsHelpExample"`
+	UserSettingsFormPreferencesTitle                 string `json:"userSettingsForm.preferencesTitle"`
+	UserSettingsFormPreferencesDescription           string `json:"userSettingsForm.preferencesDescription"`
+	UserSettingsFormLanguageLabel                    string `json:"userSettingsForm.languageLabel"`
+	UserSettingsFormLanguagePlaceholder              string `json:"userSettingsForm.languagePlaceholder"`
+	UserSettingsFormThemeLabel                       string `json:"userSettingsForm.themeLabel"`
+	UserSettingsFormThemePlaceholder                 string `json:"userSettingsForm.themePlaceholder"`
+	UserSettingsFormThemeSystem                      string `json:"userSettingsForm.themeSystem"`
+	UserSettingsFormThemeLight                       string `json:"userSettingsForm.themeLight"`
+	UserSettingsFormThemeDark                        string `json:"userSettingsForm.themeDark"`
+	UserSettingsFormThemeDescription                 string `json:"userSettingsForm.themeDescription"`
+	UserSettingsFormLogLimitLabel                    string `json:"userSettingsForm.logLimitLabel"`
+	UserSettingsFormLogLimitDescription              string `json:"userSettingsForm.logLimitDescription"`
+	UserSettingsFormDetachedProcessesLabel           string `json:"userSettingsForm.detachedProcessesLabel"`
+	UserSettingsFormDetachedProcessesDescription     string `json:"userSettingsForm.detachedProcessesDescription"`
+	UserSettingsFormIntegrationsTitle                string `json:"userSettingsForm.integrationsTitle"`
+	UserSettingsFormIntegrationsDescription          string `json:"userSettingsForm.integrationsDescription"`
+	UserSettingsFormIntegrationsTokenLabel           string `json:"userSettingsForm.integrationsTokenLabel"`
+	UserSettingsFormIntegrationsTokenDescription     string `json:"userSettingsForm.integrationsTokenDescription"`
+	UserSettingsFormIntegrationsTokenCopy            string `json:"userSettingsForm.integrationsTokenCopy"`
+	UserSettingsFormIntegrationsTokenCopied          string `json:"userSettingsForm.integrationsTokenCopied"`
+	UserSettingsFormIntegrationsLanAccessLabel       string `json:"userSettingsForm.integrationsLanAccessLabel"`
+	UserSettingsFormIntegrationsLanAccessDescription string `json:"userSettingsForm.integrationsLanAccessDescription"`
+	UserSettingsFormValidationPathEmpty              string `json:"userSettingsForm.validation.pathEmpty"`
+	UserSettingsFormValidationLogLimitMin            string `json:"userSettingsForm.validation.logLimitMin"`
+	UserSettingsFormValidationLogLimitMax            string `json:"userSettingsForm.validation.logLimitMax"`
 
 	// projectSettingsForm
 	ProjectSettingsFormSectionTitle       string `json:"projectSettingsForm.sectionTitle"`
@@ -153,6 +178,12 @@ type Localization struct {
 	// toast.command
 	ToastCommandRunFailed              string `json:"toast.command.runFailed"`
 	ToastCommandStopFailed             string `json:"toast.command.stopFailed"`
+	ToastCommandAdoptFailed            string `json:"toast.command.adoptFailed"`
+	ToastCommandKillFailed             string `json:"toast.command.killFailed"`
+	ToastCommandRestarting             string `json:"toast.command.restarting"`
+	ToastCommandRestartingUnlimited    string `json:"toast.command.restartingUnlimited"`
+	ToastCommandReady                  string `json:"toast.command.ready"`
+	ToastCommandReadinessTimeout       string `json:"toast.command.readinessTimeout"`
 	ToastCommandCreateSuccess          string `json:"toast.command.createSuccess"`
 	ToastCommandCreateFailed           string `json:"toast.command.createFailed"`
 	ToastCommandUpdateSuccess          string `json:"toast.command.updateSuccess"`
@@ -2,6 +2,8 @@ package logger
 
 import (
 	"context"
+	"io"
+	"log"
 
 	"gomander/internal/facade"
 )
@@ -35,3 +37,31 @@ func (l *DefaultLogger) Debug(message string) {
 func (l *DefaultLogger) Error(message string) {
 	l.runtime.LogError(l.ctx, message)
 }
+
+// ConsoleLogger writes the messages to a stream, for the processes that run without the Wails runtime.
+// Debug messages are only written when enabled.
+type ConsoleLogger struct {
+	logger *log.Logger
+	debug  bool
+}
+
+func NewConsoleLogger(out io.Writer, debug bool) *ConsoleLogger {
+	return &ConsoleLogger{
+		logger: log.New(out, "", log.LstdFlags),
+		debug:  debug,
+	}
+}
+
+func (l *ConsoleLogger) Info(message string) {
+	l.logger.Println("INF", message)
+}
+
+func (l *ConsoleLogger) Debug(message string) {
+	if l.debug {
+		l.logger.Println("DEB", message)
+	}
+}
+
+func (l *ConsoleLogger) Error(message string) {
+	l.logger.Println("ERR", message)
+}
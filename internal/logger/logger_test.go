@@ -1,9 +1,11 @@
 package logger_test
 
 import (
+	"bytes"
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	"gomander/internal/facade/test"
@@ -66,3 +68,34 @@ func TestDefaultLogger_Error(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, mockRuntimeFacade)
 	})
 }
+
+func TestConsoleLogger(t *testing.T) {
+	t.Run("Should write the messages with their level", func(t *testing.T) {
+		// Arrange
+		var out bytes.Buffer
+		l := logger.NewConsoleLogger(&out, false)
+
+		// Act
+		l.Info("info message")
+		l.Error("error message")
+
+		// Assert
+		assert.Contains(t, out.String(), "INF info message\n")
+		assert.Contains(t, out.String(), "ERR error message\n")
+	})
+
+	t.Run("Should only write the debug messages when enabled", func(t *testing.T) {
+		// Arrange
+		var disabledOut, enabledOut bytes.Buffer
+		disabled := logger.NewConsoleLogger(&disabledOut, false)
+		enabled := logger.NewConsoleLogger(&enabledOut, true)
+
+		// Act
+		disabled.Debug("debug message")
+		enabled.Debug("debug message")
+
+		// Assert
+		assert.Empty(t, disabledOut.String())
+		assert.Contains(t, enabledOut.String(), "DEB debug message\n")
+	})
+}
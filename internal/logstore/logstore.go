@@ -0,0 +1,537 @@
+package logstore
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// MaxFileSize is the size after which the log file of a run is rotated.
+	MaxFileSize int64 = 10 * 1024 * 1024
+	// MaxFilesPerRun limits the files kept for a single run, removing the oldest ones first.
+	MaxFilesPerRun = 5
+	// MaxRunsPerCommand limits the runs kept for each command, removing the oldest ones first.
+	MaxRunsPerCommand = 20
+	// MaxAge is how long the logs of a run are kept since they were last written.
+	MaxAge = 7 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidCommandId = errors.New("invalid command id")
+	ErrRunNotFound      = errors.New("log run not found")
+)
+
+// runIdLayout sorts alphabetically in chronological order.
+const runIdLayout = "20060102T150405.000000000"
+
+// Run is a single execution of a command whose output has been persisted.
+type Run struct {
+	Id        string    `json:"id"`
+	StartedAt time.Time `json:"startedAt"`
+	Size      int64     `json:"size"`
+}
+
+// Page is a slice of the lines of a run. TotalLines counts the lines of the whole run,
+// so the previous pages can be requested from the end.
+type Page struct {
+	RunId      string   `json:"runId"`
+	Lines      []string `json:"lines"`
+	Offset     int      `json:"offset"`
+	TotalLines int      `json:"totalLines"`
+}
+
+type LogStore interface {
+	StartRun(commandId string) error
+	Append(commandId string, data string) error
+	EndRun(commandId string) error
+	GetRuns(commandId string) ([]Run, error)
+	GetLines(commandId string, runId string, offset int, limit int) (Page, error)
+}
+
+type openRun struct {
+	id      string
+	segment int
+	file    *os.File
+	size    int64
+}
+
+// DefaultLogStore writes the output of each run of a command to its own files, under a folder per command.
+// Files are named after the run start and a segment number, which grows every time the file is rotated.
+type DefaultLogStore struct {
+	directory string
+	openRuns  map[string]*openRun
+	mutex     sync.Mutex
+}
+
+func NewDefaultLogStore(directory string) *DefaultLogStore {
+	return &DefaultLogStore{
+		directory: directory,
+		openRuns:  make(map[string]*openRun),
+	}
+}
+
+// StartRun closes the current run of the command, if any, and starts writing to a new one.
+// Old runs are pruned beforehand.
+func (s *DefaultLogStore) StartRun(commandId string) error {
+	commandDirectory, err := s.commandDirectory(commandId)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.closeRun(commandId)
+
+	err = os.MkdirAll(commandDirectory, 0755)
+	if err != nil {
+		return err
+	}
+
+	err = s.prune(commandDirectory)
+	if err != nil {
+		return err
+	}
+
+	return s.openRun(commandId, &openRun{id: time.Now().UTC().Format(runIdLayout)})
+}
+
+// Append writes the data to the current run of the command. Output written after the run ended,
+// such as the errors reported once the process exits, is appended to the last run.
+func (s *DefaultLogStore) Append(commandId string, data string) error {
+	commandDirectory, err := s.commandDirectory(commandId)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	run, exists := s.openRuns[commandId]
+	if !exists {
+		run, err = s.reopenLastRun(commandId, commandDirectory)
+		if err != nil {
+			return err
+		}
+	}
+
+	if run.size > 0 && run.size+int64(len(data)) > MaxFileSize {
+		err = s.rotate(commandId, commandDirectory, run)
+		if err != nil {
+			return err
+		}
+		run = s.openRuns[commandId]
+	}
+
+	n, err := run.file.WriteString(data)
+	run.size += int64(n)
+	return err
+}
+
+// EndRun closes the files of the current run of the command.
+func (s *DefaultLogStore) EndRun(commandId string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.closeRun(commandId)
+}
+
+// GetRuns returns the persisted runs of the command, the most recent first.
+func (s *DefaultLogStore) GetRuns(commandId string) ([]Run, error) {
+	commandDirectory, err := s.commandDirectory(commandId)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	files, err := listFiles(commandDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]Run, 0)
+	for _, file := range files {
+		if len(runs) > 0 && runs[len(runs)-1].Id == file.runId {
+			runs[len(runs)-1].Size += file.size
+			continue
+		}
+		startedAt, _ := time.Parse(runIdLayout, file.runId)
+		runs = append(runs, Run{Id: file.runId, StartedAt: startedAt, Size: file.size})
+	}
+	slices.Reverse(runs)
+
+	return runs, nil
+}
+
+// GetLines returns up to limit lines of the run starting at offset. A negative offset counts from the end,
+// so -100 returns the last 100 lines. When runId is empty, the lines of the last run are returned.
+func (s *DefaultLogStore) GetLines(commandId string, runId string, offset int, limit int) (Page, error) {
+	commandDirectory, err := s.commandDirectory(commandId)
+	if err != nil {
+		return Page{}, err
+	}
+
+	// The files are only listed with the mutex held, so reading them doesn't block the output being appended
+	s.mutex.Lock()
+	files, err := listFiles(commandDirectory)
+	s.mutex.Unlock()
+	if err != nil {
+		return Page{}, err
+	}
+
+	if runId == "" && len(files) > 0 {
+		runId = files[len(files)-1].runId
+	}
+
+	segments := make([]segment, 0)
+	totalLines := 0
+	found := false
+	for _, file := range files {
+		if file.runId != runId {
+			continue
+		}
+		found = true
+
+		// Only the size listed is read, so the lines appended meanwhile don't shift the count
+		seg := segment{path: filepath.Join(commandDirectory, file.name), size: file.size}
+		seg.lines, err = countLines(seg.path, seg.size)
+		if errors.Is(err, os.ErrNotExist) {
+			// Removed by a rotation since it was listed
+			continue
+		}
+		if err != nil {
+			return Page{}, err
+		}
+
+		segments = append(segments, seg)
+		totalLines += seg.lines
+	}
+
+	if !found {
+		return Page{}, ErrRunNotFound
+	}
+
+	if offset < 0 {
+		offset = max(totalLines+offset, 0)
+	}
+	offset = min(offset, totalLines)
+	end := totalLines
+	if limit > 0 {
+		end = min(offset+limit, totalLines)
+	}
+
+	lines := make([]string, 0, end-offset)
+	first := 0
+	for _, seg := range segments {
+		if len(lines) == end-offset {
+			break
+		}
+		if first+seg.lines <= offset {
+			first += seg.lines
+			continue
+		}
+
+		skip := max(offset-first, 0)
+		lines, err = appendLines(lines, seg, skip, end-offset-len(lines))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return Page{}, err
+		}
+		first += seg.lines
+	}
+
+	return Page{
+		RunId:      runId,
+		Lines:      lines,
+		Offset:     offset,
+		TotalLines: totalLines,
+	}, nil
+}
+
+// commandDirectory rejects ids that would escape the logs directory.
+func (s *DefaultLogStore) commandDirectory(commandId string) (string, error) {
+	if commandId == "" || commandId != filepath.Base(commandId) || commandId == "." || commandId == ".." {
+		return "", ErrInvalidCommandId
+	}
+	return filepath.Join(s.directory, commandId), nil
+}
+
+// openRun must be called with the mutex held.
+func (s *DefaultLogStore) openRun(commandId string, run *openRun) error {
+	commandDirectory, err := s.commandDirectory(commandId)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filepath.Join(commandDirectory, fileName(run.id, run.segment)), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	run.file = file
+	run.size = info.Size()
+	s.openRuns[commandId] = run
+	return nil
+}
+
+// closeRun must be called with the mutex held.
+func (s *DefaultLogStore) closeRun(commandId string) error {
+	run, exists := s.openRuns[commandId]
+	if !exists {
+		return nil
+	}
+
+	delete(s.openRuns, commandId)
+	return run.file.Close()
+}
+
+// reopenLastRun must be called with the mutex held. It starts a new run when the command has none.
+func (s *DefaultLogStore) reopenLastRun(commandId string, commandDirectory string) (*openRun, error) {
+	err := os.MkdirAll(commandDirectory, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := listFiles(commandDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &openRun{id: time.Now().UTC().Format(runIdLayout)}
+	if len(files) > 0 {
+		lastFile := files[len(files)-1]
+		run = &openRun{id: lastFile.runId, segment: lastFile.segment}
+	}
+
+	err = s.openRun(commandId, run)
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// rotate must be called with the mutex held. It moves the run to its next file,
+// removing the oldest files of the run above MaxFilesPerRun.
+func (s *DefaultLogStore) rotate(commandId string, commandDirectory string, run *openRun) error {
+	err := s.closeRun(commandId)
+	if err != nil {
+		return err
+	}
+
+	err = s.openRun(commandId, &openRun{id: run.id, segment: run.segment + 1})
+	if err != nil {
+		return err
+	}
+
+	files, err := listFiles(commandDirectory)
+	if err != nil {
+		return err
+	}
+
+	runFiles := slices.DeleteFunc(files, func(file logFile) bool {
+		return file.runId != run.id
+	})
+	for len(runFiles) > MaxFilesPerRun {
+		err = os.Remove(filepath.Join(commandDirectory, runFiles[0].name))
+		if err != nil {
+			return err
+		}
+		runFiles = runFiles[1:]
+	}
+
+	return nil
+}
+
+// prune must be called with the mutex held. It removes the runs last written before MaxAge,
+// and the oldest ones leaving room for a new run within MaxRunsPerCommand.
+func (s *DefaultLogStore) prune(commandDirectory string) error {
+	files, err := listFiles(commandDirectory)
+	if err != nil {
+		return err
+	}
+
+	lastWrites := make(map[string]time.Time)
+	runIds := make([]string, 0)
+	for _, file := range files {
+		if _, exists := lastWrites[file.runId]; !exists {
+			runIds = append(runIds, file.runId)
+		}
+		if file.modifiedAt.After(lastWrites[file.runId]) {
+			lastWrites[file.runId] = file.modifiedAt
+		}
+	}
+
+	expiredRuns := make(map[string]bool)
+	for i, runId := range runIds {
+		if len(runIds)-i >= MaxRunsPerCommand || time.Since(lastWrites[runId]) > MaxAge {
+			expiredRuns[runId] = true
+		}
+	}
+
+	for _, file := range files {
+		if !expiredRuns[file.runId] {
+			continue
+		}
+		err = os.Remove(filepath.Join(commandDirectory, file.name))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type logFile struct {
+	name       string
+	runId      string
+	segment    int
+	size       int64
+	modifiedAt time.Time
+}
+
+func fileName(runId string, segment int) string {
+	return fmt.Sprintf("%s_%03d.log", runId, segment)
+}
+
+// listFiles returns the log files of a command sorted by run and segment. Unknown files are ignored.
+func listFiles(commandDirectory string) ([]logFile, error) {
+	entries, err := os.ReadDir(commandDirectory)
+	if errors.Is(err, os.ErrNotExist) {
+		return []logFile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]logFile, 0, len(entries))
+	for _, entry := range entries {
+		var file logFile
+		runId, segment, found := strings.Cut(strings.TrimSuffix(entry.Name(), ".log"), "_")
+		if entry.IsDir() || !found || !strings.HasSuffix(entry.Name(), ".log") {
+			continue
+		}
+		if _, err := fmt.Sscanf(segment, "%d", &file.segment); err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		file.name = entry.Name()
+		file.runId = runId
+		file.size = info.Size()
+		file.modifiedAt = info.ModTime()
+		files = append(files, file)
+	}
+
+	slices.SortFunc(files, func(a, b logFile) int {
+		if a.runId != b.runId {
+			return strings.Compare(a.runId, b.runId)
+		}
+		return a.segment - b.segment
+	})
+
+	return files, nil
+}
+
+// segment is a log file of the run being read, up to the size it had when listed.
+type segment struct {
+	path  string
+	size  int64
+	lines int
+}
+
+// countLines counts the lines of the file without keeping them. A last line without a newline counts too.
+func countLines(filePath string, size int64) (int, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	lines := 0
+	lastByte := byte('\n')
+	buffer := make([]byte, 32*1024)
+	reader := io.LimitReader(file, size)
+	for {
+		n, err := reader.Read(buffer)
+		if n > 0 {
+			lines += bytes.Count(buffer[:n], []byte{'\n'})
+			lastByte = buffer[n-1]
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if lastByte != '\n' {
+		lines++
+	}
+	return lines, nil
+}
+
+// appendLines appends up to limit lines of the segment, after skipping its first lines.
+func appendLines(lines []string, seg segment, skip int, limit int) ([]string, error) {
+	file, err := os.Open(seg.path)
+	if err != nil {
+		return lines, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(io.LimitReader(file, seg.size))
+	for range skip {
+		err = skipLine(reader)
+		if errors.Is(err, io.EOF) {
+			return lines, nil
+		}
+		if err != nil {
+			return lines, err
+		}
+	}
+
+	for limit > 0 {
+		line, err := reader.ReadString('\n')
+		if line != "" {
+			lines = append(lines, strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r"))
+			limit--
+		}
+		if errors.Is(err, io.EOF) {
+			return lines, nil
+		}
+		if err != nil {
+			return lines, err
+		}
+	}
+	return lines, nil
+}
+
+// skipLine discards the next line without allocating it.
+func skipLine(reader *bufio.Reader) error {
+	for {
+		_, err := reader.ReadSlice('\n')
+		if !errors.Is(err, bufio.ErrBufferFull) {
+			return err
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package logstore_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"gomander/internal/logstore"
+)
+
+func withLimits(t *testing.T, maxFileSize int64, maxFilesPerRun int, maxRunsPerCommand int) {
+	previousFileSize, previousFilesPerRun, previousRunsPerCommand := logstore.MaxFileSize, logstore.MaxFilesPerRun, logstore.MaxRunsPerCommand
+	logstore.MaxFileSize, logstore.MaxFilesPerRun, logstore.MaxRunsPerCommand = maxFileSize, maxFilesPerRun, maxRunsPerCommand
+	t.Cleanup(func() {
+		logstore.MaxFileSize, logstore.MaxFilesPerRun, logstore.MaxRunsPerCommand = previousFileSize, previousFilesPerRun, previousRunsPerCommand
+	})
+}
+
+func writeRun(t *testing.T, store *logstore.DefaultLogStore, commandId string, lines ...string) {
+	assert.NoError(t, store.StartRun(commandId))
+	for _, line := range lines {
+		assert.NoError(t, store.Append(commandId, line+"\n"))
+	}
+	assert.NoError(t, store.EndRun(commandId))
+}
+
+func TestDefaultLogStore_GetLines(t *testing.T) {
+	t.Run("Should return the lines of the last run", func(t *testing.T) {
+		// Arrange
+		sut := logstore.NewDefaultLogStore(t.TempDir())
+		writeRun(t, sut, "cmd-1", "first run")
+		writeRun(t, sut, "cmd-1", "a", "b", "c")
+
+		// Act
+		page, err := sut.GetLines("cmd-1", "", 0, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, page.Lines)
+		assert.Equal(t, 3, page.TotalLines)
+	})
+
+	t.Run("Should page through the lines, counting negative offsets from the end", func(t *testing.T) {
+		// Arrange
+		sut := logstore.NewDefaultLogStore(t.TempDir())
+		writeRun(t, sut, "cmd-1", "a", "b", "c", "d", "e")
+
+		// Act
+		firstPage, err1 := sut.GetLines("cmd-1", "", 1, 2)
+		lastPage, err2 := sut.GetLines("cmd-1", "", -2, 10)
+
+		// Assert
+		assert.NoError(t, err1)
+		assert.Equal(t, []string{"b", "c"}, firstPage.Lines)
+		assert.Equal(t, 1, firstPage.Offset)
+		assert.NoError(t, err2)
+		assert.Equal(t, []string{"d", "e"}, lastPage.Lines)
+		assert.Equal(t, 3, lastPage.Offset)
+	})
+
+	t.Run("Should page across the files of a rotated run", func(t *testing.T) {
+		// Arrange
+		withLimits(t, 4, 5, 20)
+		sut := logstore.NewDefaultLogStore(t.TempDir())
+		writeRun(t, sut, "cmd-1", "a", "b", "c", "d", "e")
+
+		// Act
+		middlePage, err1 := sut.GetLines("cmd-1", "", 1, 3)
+		lastPage, err2 := sut.GetLines("cmd-1", "", -2, 10)
+
+		// Assert
+		assert.NoError(t, err1)
+		assert.Equal(t, []string{"b", "c", "d"}, middlePage.Lines)
+		assert.Equal(t, 5, middlePage.TotalLines)
+		assert.NoError(t, err2)
+		assert.Equal(t, []string{"d", "e"}, lastPage.Lines)
+		assert.Equal(t, 3, lastPage.Offset)
+	})
+
+	t.Run("Should append the output written after the run ended to the last run", func(t *testing.T) {
+		// Arrange
+		sut := logstore.NewDefaultLogStore(t.TempDir())
+		writeRun(t, sut, "cmd-1", "a")
+
+		// Act
+		err := sut.Append("cmd-1", "exit status 1\n")
+		page, _ := sut.GetLines("cmd-1", "", 0, 0)
+		runs, _ := sut.GetRuns("cmd-1")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "exit status 1"}, page.Lines)
+		assert.Len(t, runs, 1)
+	})
+
+	t.Run("Should return an error if the run does not exist", func(t *testing.T) {
+		// Arrange
+		sut := logstore.NewDefaultLogStore(t.TempDir())
+
+		// Act
+		_, err := sut.GetLines("cmd-1", "", 0, 10)
+
+		// Assert
+		assert.ErrorIs(t, err, logstore.ErrRunNotFound)
+	})
+
+	t.Run("Should reject command ids escaping the logs directory", func(t *testing.T) {
+		// Arrange
+		sut := logstore.NewDefaultLogStore(t.TempDir())
+
+		// Act
+		_, err := sut.GetLines("../cmd-1", "", 0, 10)
+
+		// Assert
+		assert.ErrorIs(t, err, logstore.ErrInvalidCommandId)
+	})
+}
+
+func TestDefaultLogStore_Rotation(t *testing.T) {
+	t.Run("Should rotate the file of a run once it exceeds the maximum size, removing the oldest files", func(t *testing.T) {
+		// Arrange
+		withLimits(t, 4, 2, 20)
+		directory := t.TempDir()
+		sut := logstore.NewDefaultLogStore(directory)
+
+		// Act
+		writeRun(t, sut, "cmd-1", "a", "b", "c", "d", "e")
+
+		// Assert
+		entries, _ := os.ReadDir(filepath.Join(directory, "cmd-1"))
+		assert.Len(t, entries, 2)
+
+		page, err := sut.GetLines("cmd-1", "", 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"c", "d", "e"}, page.Lines)
+	})
+
+	t.Run("Should keep the most recent runs up to the maximum", func(t *testing.T) {
+		// Arrange
+		withLimits(t, 1024, 5, 2)
+		sut := logstore.NewDefaultLogStore(t.TempDir())
+
+		// Act
+		writeRun(t, sut, "cmd-1", "first")
+		writeRun(t, sut, "cmd-1", "second")
+		writeRun(t, sut, "cmd-1", "third")
+		runs, err := sut.GetRuns("cmd-1")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, runs, 2)
+		page, _ := sut.GetLines("cmd-1", runs[1].Id, 0, 0)
+		assert.Equal(t, []string{"second"}, page.Lines)
+	})
+
+	t.Run("Should remove the runs older than the maximum age", func(t *testing.T) {
+		// Arrange
+		directory := t.TempDir()
+		sut := logstore.NewDefaultLogStore(directory)
+		writeRun(t, sut, "cmd-1", "old")
+
+		entries, _ := os.ReadDir(filepath.Join(directory, "cmd-1"))
+		oldFile := filepath.Join(directory, "cmd-1", entries[0].Name())
+		expiredAt := time.Now().Add(-logstore.MaxAge - time.Hour)
+		assert.NoError(t, os.Chtimes(oldFile, expiredAt, expiredAt))
+
+		// Act
+		writeRun(t, sut, "cmd-1", "new")
+		runs, err := sut.GetRuns("cmd-1")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, runs, 1)
+		assert.NoFileExists(t, oldFile)
+		assert.False(t, strings.Contains(oldFile, runs[0].Id))
+	})
+}
@@ -0,0 +1,36 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/logstore"
+)
+
+type MockLogStore struct {
+	mock.Mock
+}
+
+func (m *MockLogStore) StartRun(commandId string) error {
+	args := m.Called(commandId)
+	return args.Error(0)
+}
+
+func (m *MockLogStore) Append(commandId string, data string) error {
+	args := m.Called(commandId, data)
+	return args.Error(0)
+}
+
+func (m *MockLogStore) EndRun(commandId string) error {
+	args := m.Called(commandId)
+	return args.Error(0)
+}
+
+func (m *MockLogStore) GetRuns(commandId string) ([]logstore.Run, error) {
+	args := m.Called(commandId)
+	return args.Get(0).([]logstore.Run), args.Error(1)
+}
+
+func (m *MockLogStore) GetLines(commandId string, runId string, offset int, limit int) (logstore.Page, error) {
+	args := m.Called(commandId, runId, offset, limit)
+	return args.Get(0).(logstore.Page), args.Error(1)
+}
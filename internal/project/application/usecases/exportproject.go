@@ -68,26 +68,43 @@ func (uc *DefaultExportProject) Execute(projectId string) (string, error) {
 	}
 
 	exportData := projectdomain.ProjectExportJSONv1{
-		Version: 1,
-		Name:    project.Name,
+		Version:              1,
+		Name:                 project.Name,
+		EnvironmentVariables: project.EnvironmentVariables,
+		EnvFiles:             project.EnvFiles,
 	}
 
 	// Prepare commands for export
 	for _, cmd := range commands {
 		exportData.Commands = append(exportData.Commands, projectdomain.CommandJSONv1{
-			Id:               cmd.Id,
-			Name:             cmd.Name,
-			Command:          cmd.Command,
-			WorkingDirectory: cmd.WorkingDirectory,
+			Id:                   cmd.Id,
+			Name:                 cmd.Name,
+			Command:              cmd.Command,
+			WorkingDirectory:     cmd.WorkingDirectory,
+			TerminalMode:         cmd.TerminalMode,
+			Interactive:          cmd.Interactive,
+			EnvironmentVariables: cmd.EnvironmentVariables,
+			EnvFiles:             cmd.EnvFiles,
+			RestartPolicy:        string(cmd.RestartPolicy),
+			MaxRestarts:          cmd.MaxRestarts,
+			ReadinessProbe:       cmd.ReadinessProbe,
+			StopSignal:           string(cmd.StopSignal),
+			StopTimeoutSeconds:   cmd.StopTimeoutSeconds,
+			StopCommand:          cmd.StopCommand,
+			RestartMode:          string(cmd.RestartMode),
+			FileWatch:            cmd.FileWatch,
 		})
 	}
 
 	// Prepare command groups for export
 	for _, group := range commandGroups {
 		exportData.CommandGroups = append(exportData.CommandGroups, projectdomain.CommandGroupJSONv1{
-			Id:         group.Id,
-			Name:       group.Name,
-			CommandIds: array.Map(group.Commands, func(cmd domain.Command) string { return cmd.Id }),
+			Id:                group.Id,
+			Name:              group.Name,
+			CommandIds:        array.Map(group.Commands, func(cmd domain.Command) string { return cmd.Id }),
+			Dependencies:      group.Dependencies,
+			Mode:              string(group.Mode),
+			ContinueOnFailure: group.ContinueOnFailure,
 		})
 	}
 
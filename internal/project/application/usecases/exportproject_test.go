@@ -14,6 +14,7 @@ import (
 	"gomander/internal/command/domain/test"
 	commandgroupdomain "gomander/internal/commandgroup/domain"
 	test2 "gomander/internal/commandgroup/domain/test"
+	"gomander/internal/environment"
 	test4 "gomander/internal/facade/test"
 	"gomander/internal/helpers/array"
 	"gomander/internal/project/application/usecases"
@@ -34,16 +35,41 @@ func TestDefaultExportProject_Execute(t *testing.T) {
 		mockRuntimeFacade := new(test4.MockRuntimeFacade)
 
 		project := projectdomain.Project{
-			Id:   projectId,
-			Name: "test",
+			Id:                   projectId,
+			Name:                 "test",
+			EnvironmentVariables: []environment.Variable{{Key: "PROJECT_VAR", Value: "1"}},
+			EnvFiles:             []string{".env"},
 		}
 
-		cmd1 := test.NewCommandBuilder().WithProjectId(projectId).Build()
+		cmd1 := test.NewCommandBuilder().
+			WithProjectId(projectId).
+			WithEnvironmentVariables([]environment.Variable{{Key: "COMMAND_VAR", Value: "2"}}).
+			WithEnvFiles([]string{".env.local"}).
+			WithRestartPolicy(domain.RestartPolicyOnFailure, 3).
+			WithReadinessProbe(&domain.ReadinessProbe{Type: domain.ReadinessProbeLog, Target: "ready"}).
+			WithStop(domain.StopSignalInterrupt, 30, "docker compose down").
+			WithRestartMode(domain.RestartModeReload).
+			WithTerminalMode(true).
+			WithInteractive(true).
+			WithFileWatch(&domain.FileWatch{Patterns: []string{"**/*.py"}, Ignore: []string{"venv"}}).
+			Build()
 		cmd2 := test.NewCommandBuilder().WithProjectId(projectId).Build()
 		cmd3 := test.NewCommandBuilder().WithProjectId(projectId).Build()
 
-		cmdGroup1 := test2.NewCommandGroupBuilder().WithProjectId(projectId).WithCommands(cmd1, cmd2, cmd3).Build()
-		cmdGroup2 := test2.NewCommandGroupBuilder().WithProjectId(projectId).WithCommands(cmd3, cmd1).Build()
+		cmdGroup1 := test2.NewCommandGroupBuilder().
+			WithProjectId(projectId).
+			WithCommands(cmd1, cmd2, cmd3).
+			WithDependencies(commandgroupdomain.CommandDependency{
+				CommandId:   cmd3.Id,
+				DependsOnId: cmd2.Id,
+				Condition:   commandgroupdomain.DependencyConditionExitedOk,
+			}).
+			Build()
+		cmdGroup2 := test2.NewCommandGroupBuilder().
+			WithProjectId(projectId).
+			WithCommands(cmd3, cmd1).
+			WithMode(commandgroupdomain.ModePipeline, true).
+			Build()
 
 		sut := usecases.NewExportProject(
 			context.Background(),
@@ -62,21 +88,38 @@ func TestDefaultExportProject_Execute(t *testing.T) {
 		mockRuntimeFacade.On("SaveFileDialog", mock.Anything, mock.Anything).Return(selectedPath, nil)
 
 		expectedExportJSON := projectdomain.ProjectExportJSONv1{
-			Version: 1,
-			Name:    project.Name,
+			Version:              1,
+			Name:                 project.Name,
+			EnvironmentVariables: project.EnvironmentVariables,
+			EnvFiles:             project.EnvFiles,
 			Commands: array.Map([]domain.Command{cmd1, cmd2, cmd3}, func(cmd domain.Command) projectdomain.CommandJSONv1 {
 				return projectdomain.CommandJSONv1{
-					Id:               cmd.Id,
-					Name:             cmd.Name,
-					Command:          cmd.Command,
-					WorkingDirectory: cmd.WorkingDirectory,
+					Id:                   cmd.Id,
+					Name:                 cmd.Name,
+					Command:              cmd.Command,
+					WorkingDirectory:     cmd.WorkingDirectory,
+					EnvironmentVariables: cmd.EnvironmentVariables,
+					EnvFiles:             cmd.EnvFiles,
+					RestartPolicy:        string(cmd.RestartPolicy),
+					MaxRestarts:          cmd.MaxRestarts,
+					ReadinessProbe:       cmd.ReadinessProbe,
+					StopSignal:           string(cmd.StopSignal),
+					StopTimeoutSeconds:   cmd.StopTimeoutSeconds,
+					StopCommand:          cmd.StopCommand,
+					RestartMode:          string(cmd.RestartMode),
+					TerminalMode:         cmd.TerminalMode,
+					Interactive:          cmd.Interactive,
+					FileWatch:            cmd.FileWatch,
 				}
 			}),
 			CommandGroups: array.Map([]commandgroupdomain.CommandGroup{cmdGroup1, cmdGroup2}, func(group commandgroupdomain.CommandGroup) projectdomain.CommandGroupJSONv1 {
 				return projectdomain.CommandGroupJSONv1{
-					Id:         group.Id,
-					Name:       group.Name,
-					CommandIds: array.Map(group.Commands, func(cmd domain.Command) string { return cmd.Id }),
+					Id:                group.Id,
+					Name:              group.Name,
+					CommandIds:        array.Map(group.Commands, func(cmd domain.Command) string { return cmd.Id }),
+					Dependencies:      group.Dependencies,
+					Mode:              string(group.Mode),
+					ContinueOnFailure: group.ContinueOnFailure,
 				}
 			}),
 		}
@@ -1,6 +1,8 @@
 package usecases
 
 import (
+	"fmt"
+
 	"github.com/google/uuid"
 
 	"gomander/internal/command/domain"
@@ -32,9 +34,11 @@ func NewImportProject(
 
 func (uc *DefaultImportProject) Execute(projectJSON projectdomain.ProjectExportJSONv1, name, workingDirectory string) error {
 	project := projectdomain.Project{
-		Id:               uuid.New().String(),
-		Name:             name,
-		WorkingDirectory: workingDirectory,
+		Id:                   uuid.New().String(),
+		Name:                 name,
+		WorkingDirectory:     workingDirectory,
+		EnvironmentVariables: projectJSON.EnvironmentVariables,
+		EnvFiles:             projectJSON.EnvFiles,
 	}
 
 	commands := make([]domain.Command, 0, len(projectJSON.Commands))
@@ -45,12 +49,29 @@ func (uc *DefaultImportProject) Execute(projectJSON projectdomain.ProjectExportJ
 
 	for i, cmd := range projectJSON.Commands {
 		newCommand := domain.Command{
-			Id:               uuid.New().String(),
-			Name:             cmd.Name,
-			Command:          cmd.Command,
-			WorkingDirectory: cmd.WorkingDirectory,
-			ProjectId:        project.Id,
-			Position:         i,
+			Id:                   uuid.New().String(),
+			Name:                 cmd.Name,
+			Command:              cmd.Command,
+			WorkingDirectory:     cmd.WorkingDirectory,
+			ProjectId:            project.Id,
+			Position:             i,
+			TerminalMode:         cmd.TerminalMode,
+			Interactive:          cmd.Interactive,
+			EnvironmentVariables: cmd.EnvironmentVariables,
+			EnvFiles:             cmd.EnvFiles,
+			RestartPolicy:        domain.RestartPolicy(cmd.RestartPolicy),
+			MaxRestarts:          cmd.MaxRestarts,
+			ReadinessProbe:       cmd.ReadinessProbe,
+			StopSignal:           domain.StopSignal(cmd.StopSignal),
+			StopTimeoutSeconds:   cmd.StopTimeoutSeconds,
+			StopCommand:          cmd.StopCommand,
+			RestartMode:          domain.RestartMode(cmd.RestartMode),
+			FileWatch:            cmd.FileWatch,
+		}
+
+		err := newCommand.Validate()
+		if err != nil {
+			return fmt.Errorf("command %q: %w", cmd.Name, err)
 		}
 
 		commands = append(commands, newCommand)
@@ -60,10 +81,12 @@ func (uc *DefaultImportProject) Execute(projectJSON projectdomain.ProjectExportJ
 
 	for i, group := range projectJSON.CommandGroups {
 		newGroup := commandgroupdomain.CommandGroup{
-			Id:        uuid.New().String(),
-			Name:      group.Name,
-			ProjectId: project.Id,
-			Position:  i,
+			Id:                uuid.New().String(),
+			Name:              group.Name,
+			ProjectId:         project.Id,
+			Position:          i,
+			Mode:              commandgroupdomain.Mode(group.Mode),
+			ContinueOnFailure: group.ContinueOnFailure,
 		}
 
 		for _, cmdId := range group.CommandIds {
@@ -72,6 +95,23 @@ func (uc *DefaultImportProject) Execute(projectJSON projectdomain.ProjectExportJ
 			}
 		}
 
+		for _, dependency := range group.Dependencies {
+			newCmdId, commandExists := commandIdsToNewRandomIds[dependency.CommandId]
+			newDependsOnId, dependsOnExists := commandIdsToNewRandomIds[dependency.DependsOnId]
+			if commandExists && dependsOnExists {
+				newGroup.Dependencies = append(newGroup.Dependencies, commandgroupdomain.CommandDependency{
+					CommandId:   newCmdId,
+					DependsOnId: newDependsOnId,
+					Condition:   dependency.Condition,
+				})
+			}
+		}
+
+		// Imported dependencies are dropped if they cannot be resolved, rather than failing the whole import
+		if newGroup.ValidateDependencies() != nil {
+			newGroup.Dependencies = nil
+		}
+
 		commandGroups = append(commandGroups, newGroup)
 	}
 
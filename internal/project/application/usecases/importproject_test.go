@@ -10,6 +10,7 @@ import (
 	"gomander/internal/command/domain/test"
 	commandgroupdomain "gomander/internal/commandgroup/domain"
 	test2 "gomander/internal/commandgroup/domain/test"
+	"gomander/internal/environment"
 	test4 "gomander/internal/facade/test"
 	"gomander/internal/helpers/array"
 	"gomander/internal/project/application/usecases"
@@ -34,6 +35,15 @@ func TestDefaultImportProject_Execute(t *testing.T) {
 			WithName("Name 1").
 			WithCommand("echo 1").
 			WithWorkingDirectory("/1").
+			WithEnvironmentVariables([]environment.Variable{{Key: "COMMAND_VAR", Value: "2"}}).
+			WithEnvFiles([]string{".env.local"}).
+			WithRestartPolicy(domain.RestartPolicyOnFailure, 3).
+			WithReadinessProbe(&domain.ReadinessProbe{Type: domain.ReadinessProbeLog, Target: "ready"}).
+			WithStop(domain.StopSignalInterrupt, 30, "docker compose down").
+			WithRestartMode(domain.RestartModeReload).
+			WithTerminalMode(true).
+			WithInteractive(true).
+			WithFileWatch(&domain.FileWatch{Patterns: []string{"**/*.py"}, Ignore: []string{"venv"}}).
 			Build()
 		cmd2 := test.NewCommandBuilder().
 			WithProjectId(projectId).
@@ -47,8 +57,20 @@ func TestDefaultImportProject_Execute(t *testing.T) {
 			WithCommand("echo 3").
 			WithWorkingDirectory("/3").Build()
 
-		cmdGroup1 := test2.NewCommandGroupBuilder().WithProjectId(projectId).WithCommands(cmd1, cmd2, cmd3).Build()
-		cmdGroup2 := test2.NewCommandGroupBuilder().WithProjectId(projectId).WithCommands(cmd3, cmd1).Build()
+		cmdGroup1 := test2.NewCommandGroupBuilder().
+			WithProjectId(projectId).
+			WithCommands(cmd1, cmd2, cmd3).
+			WithDependencies(commandgroupdomain.CommandDependency{
+				CommandId:   cmd2.Id,
+				DependsOnId: cmd1.Id,
+				Condition:   commandgroupdomain.DependencyConditionReady,
+			}).
+			Build()
+		cmdGroup2 := test2.NewCommandGroupBuilder().
+			WithProjectId(projectId).
+			WithCommands(cmd3, cmd1).
+			WithMode(commandgroupdomain.ModePipeline, true).
+			Build()
 
 		newName := "Imported Project"
 		newWorkingDirectory := "/imported/project/dir"
@@ -56,21 +78,41 @@ func TestDefaultImportProject_Execute(t *testing.T) {
 		commands := []domain.Command{cmd1, cmd2, cmd3}
 		commandGroups := []commandgroupdomain.CommandGroup{cmdGroup1, cmdGroup2}
 
+		projectEnvironmentVariables := []environment.Variable{{Key: "PROJECT_VAR", Value: "1"}}
+		projectEnvFiles := []string{".env"}
+
 		projectJSON := projectdomain.ProjectExportJSONv1{
-			Version: 1,
-			Name:    "test",
+			Version:              1,
+			Name:                 "test",
+			EnvironmentVariables: projectEnvironmentVariables,
+			EnvFiles:             projectEnvFiles,
 			Commands: array.Map(commands, func(cmd domain.Command) projectdomain.CommandJSONv1 {
 				return projectdomain.CommandJSONv1{
-					Id:               cmd.Id,
-					Name:             cmd.Name,
-					Command:          cmd.Command,
-					WorkingDirectory: cmd.WorkingDirectory,
+					Id:                   cmd.Id,
+					Name:                 cmd.Name,
+					Command:              cmd.Command,
+					WorkingDirectory:     cmd.WorkingDirectory,
+					EnvironmentVariables: cmd.EnvironmentVariables,
+					EnvFiles:             cmd.EnvFiles,
+					RestartPolicy:        string(cmd.RestartPolicy),
+					MaxRestarts:          cmd.MaxRestarts,
+					ReadinessProbe:       cmd.ReadinessProbe,
+					StopSignal:           string(cmd.StopSignal),
+					StopTimeoutSeconds:   cmd.StopTimeoutSeconds,
+					StopCommand:          cmd.StopCommand,
+					RestartMode:          string(cmd.RestartMode),
+					TerminalMode:         cmd.TerminalMode,
+					Interactive:          cmd.Interactive,
+					FileWatch:            cmd.FileWatch,
 				}
 			}),
 			CommandGroups: array.Map(commandGroups, func(group commandgroupdomain.CommandGroup) projectdomain.CommandGroupJSONv1 {
 				return projectdomain.CommandGroupJSONv1{
-					Name:       group.Name,
-					CommandIds: array.Map(group.Commands, func(cmd domain.Command) string { return cmd.Id }),
+					Name:              group.Name,
+					CommandIds:        array.Map(group.Commands, func(cmd domain.Command) string { return cmd.Id }),
+					Dependencies:      group.Dependencies,
+					Mode:              string(group.Mode),
+					ContinueOnFailure: group.ContinueOnFailure,
 				}
 			}),
 		}
@@ -81,7 +123,10 @@ func TestDefaultImportProject_Execute(t *testing.T) {
 
 		mockProjectRepository.On("Create", mock.MatchedBy(func(p projectdomain.Project) bool {
 			newProjectId = p.Id // Capture the new project ID
-			return p.Name == newName && p.WorkingDirectory == newWorkingDirectory
+			return p.Name == newName &&
+				p.WorkingDirectory == newWorkingDirectory &&
+				assert.ObjectsAreEqual(projectEnvironmentVariables, p.EnvironmentVariables) &&
+				assert.ObjectsAreEqual(projectEnvFiles, p.EnvFiles)
 		})).Return(nil).Once()
 
 		// Testify doesn't allow for a custom matcher for pointers, so we capture all the calls and then check the values
@@ -105,6 +150,18 @@ func TestDefaultImportProject_Execute(t *testing.T) {
 			assert.Equal(t, expectedCmd.Name, capturedCommands[i].Name)
 			assert.Equal(t, expectedCmd.Command, capturedCommands[i].Command)
 			assert.Equal(t, expectedCmd.WorkingDirectory, capturedCommands[i].WorkingDirectory)
+			assert.Equal(t, expectedCmd.EnvironmentVariables, capturedCommands[i].EnvironmentVariables)
+			assert.Equal(t, expectedCmd.EnvFiles, capturedCommands[i].EnvFiles)
+			assert.Equal(t, expectedCmd.RestartPolicy, capturedCommands[i].RestartPolicy)
+			assert.Equal(t, expectedCmd.MaxRestarts, capturedCommands[i].MaxRestarts)
+			assert.Equal(t, expectedCmd.ReadinessProbe, capturedCommands[i].ReadinessProbe)
+			assert.Equal(t, expectedCmd.StopSignal, capturedCommands[i].StopSignal)
+			assert.Equal(t, expectedCmd.StopTimeoutSeconds, capturedCommands[i].StopTimeoutSeconds)
+			assert.Equal(t, expectedCmd.StopCommand, capturedCommands[i].StopCommand)
+			assert.Equal(t, expectedCmd.RestartMode, capturedCommands[i].RestartMode)
+			assert.Equal(t, expectedCmd.TerminalMode, capturedCommands[i].TerminalMode)
+			assert.Equal(t, expectedCmd.Interactive, capturedCommands[i].Interactive)
+			assert.Equal(t, expectedCmd.FileWatch, capturedCommands[i].FileWatch)
 			assert.Equal(t, i, capturedCommands[i].Position)
 			assert.Equal(t, newProjectId, capturedCommands[i].ProjectId) // Ensure the project ID is set correctly
 			assert.NotEmpty(t, capturedCommands[i].Id)                   // Random ID exists
@@ -114,6 +171,8 @@ func TestDefaultImportProject_Execute(t *testing.T) {
 			assert.Equal(t, expectedGroup.Name, capturedCommandGroups[i].Name)
 			assert.Equal(t, newProjectId, capturedCommandGroups[i].ProjectId) // Ensure the project ID is set correctly
 			assert.Equal(t, i, capturedCommandGroups[i].Position)
+			assert.Equal(t, expectedGroup.Mode, capturedCommandGroups[i].Mode)
+			assert.Equal(t, expectedGroup.ContinueOnFailure, capturedCommandGroups[i].ContinueOnFailure)
 			assert.NotEmpty(t, capturedCommandGroups[i].Id) // Random ID exists
 
 			for j, command := range expectedGroup.Commands {
@@ -123,6 +182,14 @@ func TestDefaultImportProject_Execute(t *testing.T) {
 			}
 		}
 
+		// Dependencies reference the new command ids
+		assert.Equal(t, []commandgroupdomain.CommandDependency{{
+			CommandId:   capturedCommands[1].Id,
+			DependsOnId: capturedCommands[0].Id,
+			Condition:   commandgroupdomain.DependencyConditionReady,
+		}}, capturedCommandGroups[0].Dependencies)
+		assert.Nil(t, capturedCommandGroups[1].Dependencies)
+
 		mock.AssertExpectationsForObjects(t,
 			mockProjectRepository,
 			mockCommandRepository,
@@ -131,6 +198,35 @@ func TestDefaultImportProject_Execute(t *testing.T) {
 			mockRuntimeFacade,
 		)
 	})
+	t.Run("Should return an error without saving anything if a command is invalid", func(t *testing.T) {
+		// Arrange
+		mockProjectRepository := new(test3.MockProjectRepository)
+		mockCommandRepository := new(test.MockCommandRepository)
+		mockCommandGroupRepository := new(test2.MockCommandGroupRepository)
+
+		projectJSON := projectdomain.ProjectExportJSONv1{
+			Version: 1,
+			Name:    "test",
+			Commands: []projectdomain.CommandJSONv1{
+				{Id: "cmd1", Name: "Command 1", Command: "echo 1", WorkingDirectory: "/1"},
+				{Id: "cmd2", Name: "Command 2", Command: "echo 2", WorkingDirectory: "/2", RestartPolicy: "sometimes"},
+			},
+		}
+
+		sut := usecases.NewImportProject(mockProjectRepository, mockCommandRepository, mockCommandGroupRepository)
+
+		// Act
+		err := sut.Execute(projectJSON, "Imported Project", "/imported/project/dir")
+
+		// Assert
+		assert.ErrorIs(t, err, domain.ErrInvalidCommand)
+
+		mock.AssertExpectationsForObjects(t,
+			mockProjectRepository,
+			mockCommandRepository,
+			mockCommandGroupRepository,
+		)
+	})
 	t.Run("Should return error if there is a problem saving the project", func(t *testing.T) {
 		// Arrange
 
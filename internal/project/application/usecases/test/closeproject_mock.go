@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockCloseProject struct {
+	mock.Mock
+}
+
+func (m *MockCloseProject) Execute() error {
+	args := m.Called()
+	return args.Error(0)
+}
@@ -0,0 +1,16 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/project/domain"
+)
+
+type MockCreateProject struct {
+	mock.Mock
+}
+
+func (m *MockCreateProject) Execute(project domain.Project) error {
+	args := m.Called(project)
+	return args.Error(0)
+}
@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockDeleteProject struct {
+	mock.Mock
+}
+
+func (m *MockDeleteProject) Execute(projectId string) error {
+	args := m.Called(projectId)
+	return args.Error(0)
+}
@@ -0,0 +1,19 @@
+package test
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/project/domain"
+)
+
+type MockGetAvailableProjects struct {
+	mock.Mock
+}
+
+func (m *MockGetAvailableProjects) Execute() ([]domain.Project, error) {
+	args := m.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]domain.Project), args.Error(1)
+}
@@ -0,0 +1,12 @@
+package test
+
+import "github.com/stretchr/testify/mock"
+
+type MockOpenProject struct {
+	mock.Mock
+}
+
+func (m *MockOpenProject) Execute(projectId string) error {
+	args := m.Called(projectId)
+	return args.Error(0)
+}
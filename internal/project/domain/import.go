@@ -1,22 +1,45 @@
 package domain
 
+import (
+	"gomander/internal/command/domain"
+	commandgroupdomain "gomander/internal/commandgroup/domain"
+	"gomander/internal/environment"
+)
+
 type CommandGroupJSONv1 struct {
-	Id         string   `json:"id"`
-	Name       string   `json:"name"`
-	CommandIds []string `json:"commandIds"`
+	Id                string                                 `json:"id"`
+	Name              string                                 `json:"name"`
+	CommandIds        []string                               `json:"commandIds"`
+	Dependencies      []commandgroupdomain.CommandDependency `json:"dependencies,omitempty"`
+	Mode              string                                 `json:"mode,omitempty"`
+	ContinueOnFailure bool                                   `json:"continueOnFailure,omitempty"`
 }
 
 type CommandJSONv1 struct {
-	Id               string `json:"id"`
-	Name             string `json:"name"`
-	Command          string `json:"command"`
-	WorkingDirectory string `json:"workingDirectory"`
+	Id                   string                 `json:"id"`
+	Name                 string                 `json:"name"`
+	Command              string                 `json:"command"`
+	WorkingDirectory     string                 `json:"workingDirectory"`
+	TerminalMode         bool                   `json:"terminalMode,omitempty"`
+	Interactive          bool                   `json:"interactive,omitempty"`
+	EnvironmentVariables []environment.Variable `json:"environmentVariables,omitempty"`
+	EnvFiles             []string               `json:"envFiles,omitempty"`
+	RestartPolicy        string                 `json:"restartPolicy,omitempty"`
+	MaxRestarts          int                    `json:"maxRestarts,omitempty"`
+	ReadinessProbe       *domain.ReadinessProbe `json:"readinessProbe,omitempty"`
+	StopSignal           string                 `json:"stopSignal,omitempty"`
+	StopTimeoutSeconds   int                    `json:"stopTimeoutSeconds,omitempty"`
+	StopCommand          string                 `json:"stopCommand,omitempty"`
+	RestartMode          string                 `json:"restartMode,omitempty"`
+	FileWatch            *domain.FileWatch      `json:"fileWatch,omitempty"`
 }
 
 type ProjectExportJSONv1 struct {
-	Version          int                  `json:"version"`
-	Name             string               `json:"name"`
-	WorkingDirectory string               `json:"workingDirectory"`
-	Commands         []CommandJSONv1      `json:"commands"`
-	CommandGroups    []CommandGroupJSONv1 `json:"commandGroups"`
+	Version              int                    `json:"version"`
+	Name                 string                 `json:"name"`
+	WorkingDirectory     string                 `json:"workingDirectory"`
+	EnvironmentVariables []environment.Variable `json:"environmentVariables,omitempty"`
+	EnvFiles             []string               `json:"envFiles,omitempty"`
+	Commands             []CommandJSONv1        `json:"commands"`
+	CommandGroups        []CommandGroupJSONv1   `json:"commandGroups"`
 }
@@ -1,7 +1,11 @@
 package domain
 
+import "gomander/internal/environment"
+
 type Project struct {
-	Id               string `json:"id"`
-	Name             string `json:"name"`
-	WorkingDirectory string `json:"workingDirectory"`
+	Id                   string                 `json:"id"`
+	Name                 string                 `json:"name"`
+	WorkingDirectory     string                 `json:"workingDirectory"`
+	EnvironmentVariables []environment.Variable `json:"environmentVariables"`
+	EnvFiles             []string               `json:"envFiles"`
 }
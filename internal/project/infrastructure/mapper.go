@@ -1,19 +1,26 @@
 package infrastructure
 
-import "gomander/internal/project/domain"
+import (
+	"gomander/internal/environment"
+	"gomander/internal/project/domain"
+)
 
 func ToDomainProject(model ProjectModel) domain.Project {
 	return domain.Project{
-		Id:               model.Id,
-		Name:             model.Name,
-		WorkingDirectory: model.WorkingDirectory,
+		Id:                   model.Id,
+		Name:                 model.Name,
+		WorkingDirectory:     model.WorkingDirectory,
+		EnvironmentVariables: environment.UnmarshalVariables(model.EnvironmentVariables),
+		EnvFiles:             environment.UnmarshalEnvFiles(model.EnvFiles),
 	}
 }
 
 func ToProjectModel(domainProject domain.Project) ProjectModel {
 	return ProjectModel{
-		Id:               domainProject.Id,
-		Name:             domainProject.Name,
-		WorkingDirectory: domainProject.WorkingDirectory,
+		Id:                   domainProject.Id,
+		Name:                 domainProject.Name,
+		WorkingDirectory:     domainProject.WorkingDirectory,
+		EnvironmentVariables: environment.MarshalVariables(domainProject.EnvironmentVariables),
+		EnvFiles:             environment.MarshalEnvFiles(domainProject.EnvFiles),
 	}
 }
@@ -1,9 +1,11 @@
 package infrastructure
 
 type ProjectModel struct {
-	Id               string `gorm:"primaryKey;column:id"`
-	Name             string `gorm:"column:name"`
-	WorkingDirectory string `gorm:"column:working_directory"`
+	Id                   string `gorm:"primaryKey;column:id"`
+	Name                 string `gorm:"column:name"`
+	WorkingDirectory     string `gorm:"column:working_directory"`
+	EnvironmentVariables string `gorm:"column:environment_variables"`
+	EnvFiles             string `gorm:"column:env_files"`
 }
 
 func (ProjectModel) TableName() string {
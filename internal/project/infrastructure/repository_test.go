@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"gorm.io/gorm"
 
+	"gomander/internal/environment"
 	"gomander/internal/project/domain"
 	_ "gomander/migrations"
 )
@@ -131,6 +132,34 @@ func TestGormProjectRepository_Update(t *testing.T) {
 		assert.Equal(t, "New Name", project.Name)
 		assert.Equal(t, "/tmp/new", project.WorkingDirectory)
 	})
+
+	t.Run("Should persist the environment variables and env files", func(t *testing.T) {
+		// Arrange
+		preloadedProjects := []*ProjectModel{
+			{Id: "p1", Name: "Project 1", WorkingDirectory: "/tmp/1"},
+		}
+		h := newTestHelper(t, preloadedProjects)
+		updated := domain.Project{
+			Id:               "p1",
+			Name:             "Project 1",
+			WorkingDirectory: "/tmp/1",
+			EnvironmentVariables: []environment.Variable{
+				{Key: "FOO", Value: "bar"},
+				{Key: "BAZ", Value: "qux"},
+			},
+			EnvFiles: []string{".env", ".env.local"},
+		}
+
+		// Act
+		err := h.repo.Update(updated)
+
+		// Assert
+		assert.NoError(t, err)
+
+		project, err := h.repo.Get("p1")
+		assert.NoError(t, err)
+		assert.Equal(t, &updated, project)
+	})
 }
 
 func TestGormProjectRepository_Delete(t *testing.T) {
@@ -0,0 +1,198 @@
+package runner
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gomander/internal/command/domain"
+	commandgroupdomain "gomander/internal/commandgroup/domain"
+	"gomander/internal/event"
+)
+
+// Dependency makes a command wait for another command to reach the condition before being started.
+type Dependency struct {
+	CommandId string
+	Condition commandgroupdomain.DependencyCondition
+}
+
+type dependencyResult int
+
+const (
+	dependencyPending dependencyResult = iota
+	dependencySatisfied
+	dependencyFailed
+)
+
+// evaluateCondition tells whether the run state of a dependency meets the condition, or never will.
+func evaluateCondition(condition commandgroupdomain.DependencyCondition, runState RunState, exists bool) dependencyResult {
+	if !exists || runState.Status == RunStatusWaiting || runState.Status == RunStatusStarting {
+		return dependencyPending
+	}
+
+	if condition == commandgroupdomain.DependencyConditionReady && runState.Readiness != "" {
+		switch runState.Readiness {
+		case ReadinessReady:
+			return dependencySatisfied
+		case ReadinessTimedOut:
+			return dependencyFailed
+		}
+		if runState.IsActive() {
+			return dependencyPending
+		}
+		return dependencyFailed
+	}
+
+	switch runState.Status {
+	case RunStatusExitedOk:
+		return dependencySatisfied
+	case RunStatusExitedError, RunStatusKilled:
+		return dependencyFailed
+	}
+
+	if condition == commandgroupdomain.DependencyConditionExitedOk {
+		return dependencyPending
+	}
+	return dependencySatisfied
+}
+
+// waitingCommand is a command of a group run that has not been started yet.
+type waitingCommand struct {
+	dependencies []Dependency
+	// commandNames are used to report the dependencies that failed
+	commandNames map[string]string
+}
+
+// errStartSuperseded means the waiting command was stopped or started by other means.
+var errStartSuperseded = errors.New("start superseded")
+
+// RunCommandsWithDependencies runs the commands, starting each one once its dependencies meet their condition.
+func (c *DefaultRunner) RunCommandsWithDependencies(commands []domain.Command, dependencies map[string][]Dependency, options RunOptions) error {
+	waitingCommands := make(map[string]*waitingCommand)
+
+	commandNames := make(map[string]string, len(commands))
+	for _, command := range commands {
+		commandNames[command.Id] = command.Name
+	}
+
+	c.mutex.Lock()
+	for _, command := range commands {
+		if _, exists := c.runningCommands[command.Id]; exists {
+			continue
+		}
+		if _, starting := c.startingCommands[command.Id]; starting || c.orphans[command.Id] != nil {
+			continue
+		}
+		if len(dependencies[command.Id]) == 0 {
+			// Marked as starting too, so the state of its previous run can't satisfy or fail a dependency
+			c.setRunState(RunState{
+				CommandId: command.Id,
+				Status:    RunStatusStarting,
+				StartedAt: time.Now(),
+			})
+			continue
+		}
+		if _, exists := c.waitingCommands[command.Id]; exists {
+			continue
+		}
+
+		c.cancelPendingRestart(command.Id)
+
+		// Marked before starting anything, so dependents don't take the state of a previous run into account
+		waiting := &waitingCommand{dependencies: dependencies[command.Id], commandNames: commandNames}
+		c.waitingCommands[command.Id] = waiting
+		waitingCommands[command.Id] = waiting
+		c.setRunState(RunState{
+			CommandId: command.Id,
+			Status:    RunStatusWaiting,
+			StartedAt: time.Now(),
+		})
+	}
+	c.mutex.Unlock()
+
+	for _, command := range commands {
+		waiting, exists := waitingCommands[command.Id]
+		if !exists {
+			continue
+		}
+
+		go func() {
+			err := c.waitForDependencies(&command, waiting)
+			if errors.Is(err, errStartSuperseded) {
+				return
+			}
+			if err != nil {
+				c.sendStreamLine(&command, err.Error())
+
+				c.mutex.Lock()
+				runState := c.runStates[command.Id]
+				c.mutex.Unlock()
+				c.eventEmitter.EmitEvent(event.ProcessFinished, runState)
+				return
+			}
+
+			err = c.RunCommand(&command, options)
+			if err != nil {
+				c.logger.Error("[ERROR - Running dependent command]: " + err.Error())
+			}
+		}()
+	}
+
+	var firstErr error
+	for _, command := range commands {
+		if len(dependencies[command.Id]) > 0 {
+			continue
+		}
+
+		err := c.RunCommand(&command, options)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// waitForDependencies blocks until the dependencies of the command meet their condition, or one of them fails.
+func (c *DefaultRunner) waitForDependencies(command *domain.Command, waiting *waitingCommand) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for {
+		if c.waitingCommands[command.Id] != waiting {
+			return errStartSuperseded
+		}
+
+		satisfied := true
+		for _, dependency := range waiting.dependencies {
+			runState, exists := c.runStates[dependency.CommandId]
+
+			switch evaluateCondition(dependency.Condition, runState, exists) {
+			case dependencyPending:
+				satisfied = false
+			case dependencyFailed:
+				delete(c.waitingCommands, command.Id)
+				c.failStart(command.Id)
+				return fmt.Errorf("Not started because %s did not reach the %s condition", waiting.commandNames[dependency.CommandId], dependency.Condition)
+			}
+		}
+
+		if satisfied {
+			delete(c.waitingCommands, command.Id)
+			return nil
+		}
+
+		c.stateChanged.Wait()
+	}
+}
+
+// cancelWaitingCommand must be called with the mutex held. It reports whether the command was waiting.
+func (c *DefaultRunner) cancelWaitingCommand(id string) bool {
+	if _, waiting := c.waitingCommands[id]; !waiting {
+		return false
+	}
+
+	delete(c.waitingCommands, id)
+	c.setRunState(c.runStates[id].finish(RunStatusKilled, nil))
+	return true
+}
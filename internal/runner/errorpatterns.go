@@ -0,0 +1,85 @@
+package runner
+
+import (
+	"regexp"
+	"strings"
+
+	"gomander/internal/command/domain"
+	"gomander/internal/event"
+)
+
+// ErrorDetectedPayload is the payload of the CommandErrorDetected event.
+type ErrorDetectedPayload struct {
+	Id       string                      `json:"id"`
+	Line     string                      `json:"line"`
+	Pattern  string                      `json:"pattern"`
+	Severity domain.ErrorPatternSeverity `json:"severity"`
+	Label    string                      `json:"label,omitempty"`
+}
+
+// errorMatcher is an error pattern of a command prepared for the current run.
+type errorMatcher struct {
+	pattern domain.ErrorPattern
+	regex   *regexp.Regexp
+}
+
+func (m errorMatcher) matches(line string) bool {
+	if m.regex != nil {
+		return m.regex.MatchString(line)
+	}
+	return strings.Contains(line, m.pattern.Pattern)
+}
+
+// prepareErrorPatterns compiles the error patterns of a command once per run, skipping the invalid ones.
+func (c *DefaultRunner) prepareErrorPatterns(command *domain.Command) {
+	matchers := make([]errorMatcher, 0, len(command.ErrorPatterns))
+	invalidPatterns := make([]error, 0)
+
+	for _, pattern := range command.ErrorPatterns {
+		err := pattern.Validate()
+		if err != nil {
+			invalidPatterns = append(invalidPatterns, err)
+			continue
+		}
+
+		matcher := errorMatcher{pattern: pattern}
+		if pattern.Regex {
+			matcher.regex = regexp.MustCompile(pattern.Pattern)
+		}
+		matchers = append(matchers, matcher)
+	}
+
+	c.errorMatchers.Store(command.Id, matchers)
+
+	for _, err := range invalidPatterns {
+		c.sendStreamLine(command, "Ignoring error pattern: "+err.Error())
+	}
+}
+
+// checkLineForErrors emits CommandErrorDetected for the first error pattern the line matches.
+func (c *DefaultRunner) checkLineForErrors(command *domain.Command, line string) {
+	matchers, exists := c.errorMatchers.Load(command.Id)
+	if !exists {
+		return
+	}
+
+	for _, matcher := range matchers.([]errorMatcher) {
+		if !matcher.matches(line) {
+			continue
+		}
+
+		severity := matcher.pattern.GetSeverity()
+		if severity == domain.ErrorPatternSeverityError {
+			c.markErrorDetected(command.Id)
+		}
+
+		c.eventEmitter.EmitEvent(event.CommandErrorDetected, ErrorDetectedPayload{
+			Id:       command.Id,
+			Line:     line,
+			Pattern:  matcher.pattern.Pattern,
+			Severity: severity,
+			Label:    matcher.pattern.Label,
+		})
+		return
+	}
+}
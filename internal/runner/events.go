@@ -0,0 +1,38 @@
+package runner
+
+import (
+	"encoding/json"
+
+	"gomander/internal/event"
+)
+
+// DecodeEventPayload restores the payload of an event emitted by a runner in another process with its original type.
+func DecodeEventPayload(e event.Event, data []byte) (interface{}, error) {
+	switch e {
+	case event.ProcessStarted, event.ProcessReady, event.ProcessReadinessTimeout:
+		return decodePayload[string](data)
+	case event.ProcessFinished:
+		return decodePayload[RunState](data)
+	case event.NewLogEntry:
+		return decodePayload[map[string]string](data)
+	case event.CommandErrorDetected:
+		return decodePayload[ErrorDetectedPayload](data)
+	case event.ProcessRestarting:
+		return decodePayload[RestartingPayload](data)
+	case event.ProcessStopping:
+		return decodePayload[StoppingPayload](data)
+	case event.PipelineStarted, event.PipelineStepStarted, event.PipelineFinished:
+		return decodePayload[PipelineState](data)
+	default:
+		return decodePayload[interface{}](data)
+	}
+}
+
+func decodePayload[T any](data []byte) (interface{}, error) {
+	var payload T
+	err := json.Unmarshal(data, &payload)
+	if err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
@@ -0,0 +1,57 @@
+package runner
+
+import (
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+
+	"gomander/internal/command/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+)
+
+func newCommandRun(command *domain.Command, workingDirectory string, options RunOptions, restarts int, startedAt time.Time) commandrundomain.CommandRun {
+	trigger := options.Trigger
+	if restarts > 0 {
+		trigger = commandrundomain.TriggerRestart
+	}
+
+	return commandrundomain.CommandRun{
+		Id:               uuid.NewString(),
+		CommandId:        command.Id,
+		Command:          command.Command,
+		WorkingDirectory: workingDirectory,
+		Trigger:          trigger,
+		Status:           string(RunStatusRunning),
+		StartedAt:        startedAt,
+		OwnerPid:         os.Getpid(),
+	}
+}
+
+// finishCommandRun copies the final run state to the history entry, along with whether an error pattern matched.
+func (c *DefaultRunner) finishCommandRun(run *commandrundomain.CommandRun, runState RunState) {
+	_, errorDetected := c.detectedErrors.Load(run.CommandId)
+
+	run.Status = string(runState.Status)
+	run.FinishedAt = runState.FinishedAt
+	run.ExitCode = runState.ExitCode
+	run.ErrorDetected = errorDetected
+}
+
+func (c *DefaultRunner) markErrorDetected(id string) {
+	c.detectedErrors.Store(id, struct{}{})
+}
+
+func (c *DefaultRunner) createCommandRun(run *commandrundomain.CommandRun) {
+	err := c.commandRunRepo.Create(run)
+	if err != nil {
+		c.logger.Error("[ERROR - Recording command run]: " + err.Error())
+	}
+}
+
+func (c *DefaultRunner) updateCommandRun(run *commandrundomain.CommandRun) {
+	err := c.commandRunRepo.Update(run)
+	if err != nil {
+		c.logger.Error("[ERROR - Recording command run]: " + err.Error())
+	}
+}
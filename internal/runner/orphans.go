@@ -0,0 +1,129 @@
+package runner
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	commandrundomain "gomander/internal/commandrun/domain"
+	"gomander/internal/event"
+)
+
+var ErrNotOrphaned = errors.New("command has no orphaned process")
+
+// OrphanCheckInterval is how often the orphaned processes are checked, as they can't be waited for
+var OrphanCheckInterval = time.Second
+
+// orphanedProcess is a process group left running by a previous Gomander, whose output can't be read.
+type orphanedProcess struct {
+	run           commandrundomain.CommandRun
+	adopted       bool
+	stopRequested bool
+	// done is closed once the end of the process is recorded
+	done chan struct{}
+}
+
+// AddOrphanedProcess shows the command of the run as orphaned until its process exits.
+func (c *DefaultRunner) AddOrphanedProcess(run commandrundomain.CommandRun) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, exists := c.runningCommands[run.CommandId]; exists || c.orphans[run.CommandId] != nil {
+		return
+	}
+
+	orphan := &orphanedProcess{run: run, done: make(chan struct{})}
+	c.orphans[run.CommandId] = orphan
+	c.detectedErrors.Delete(run.CommandId)
+	c.setRunState(RunState{
+		CommandId: run.CommandId,
+		Status:    RunStatusOrphaned,
+		StartedAt: run.StartedAt,
+	})
+
+	go c.watchOrphanedProcess(orphan)
+}
+
+// AdoptOrphanedProcess makes this Gomander the owner of the orphaned process, stopping it like the running commands.
+func (c *DefaultRunner) AdoptOrphanedProcess(id string) error {
+	c.mutex.Lock()
+	orphan, exists := c.orphans[id]
+	if !exists || orphan.adopted {
+		c.mutex.Unlock()
+		return ErrNotOrphaned
+	}
+
+	orphan.adopted = true
+	orphan.run.OwnerPid = os.Getpid()
+	run := orphan.run
+
+	runState := c.runStates[id]
+	runState.Status = RunStatusRunning
+	c.setRunState(runState)
+	c.mutex.Unlock()
+
+	c.updateCommandRun(&run)
+
+	line := "Adopted the process " + strconv.Itoa(run.Pid) + " left running by a previous session, its output is not available"
+	c.persistOutput(id, line+"\n")
+	c.eventEmitter.EmitEvent(event.NewLogEntry, map[string]string{
+		"id":   id,
+		"line": line,
+	})
+	c.eventEmitter.EmitEvent(event.ProcessStarted, id)
+	return nil
+}
+
+// watchOrphanedProcess waits for the process group to exit, then records the end of its run.
+func (c *DefaultRunner) watchOrphanedProcess(orphan *orphanedProcess) {
+	defer close(orphan.done)
+
+	c.mutex.Lock()
+	process := orphan.run
+	c.mutex.Unlock()
+	id := process.CommandId
+
+	for IsRunProcessRunning(process) {
+		time.Sleep(OrphanCheckInterval)
+	}
+
+	c.mutex.Lock()
+	delete(c.orphans, id)
+	status := RunStatusExitedError
+	if orphan.stopRequested {
+		status = RunStatusKilled
+	}
+	runState := c.runStates[id].finish(status, nil)
+	c.setRunState(runState)
+	run := orphan.run
+	c.mutex.Unlock()
+
+	c.logger.Info("Orphaned process ended: " + id)
+	c.eventEmitter.EmitEvent(event.ProcessFinished, runState)
+
+	c.finishCommandRun(&run, runState)
+	c.updateCommandRun(&run)
+}
+
+// IsRunProcessRunning reports whether the process group of the run is running and its pid wasn't reused.
+func IsRunProcessRunning(run commandrundomain.CommandRun) bool {
+	if !IsProcessGroupRunning(run.Pgid) {
+		return false
+	}
+	if run.ProcessStartTime == 0 {
+		return true
+	}
+
+	// The group can outlive its leader, whose pid can't be reused meanwhile
+	startTime := ProcessStartTime(run.Pid)
+	return startTime == 0 || startTime == run.ProcessStartTime
+}
+
+// stopOrphanedProcess stops the process group of the run, unless its pid was reused by another process meanwhile.
+func stopOrphanedProcess(run commandrundomain.CommandRun) error {
+	if !IsRunProcessRunning(run) {
+		return nil
+	}
+	return StopProcessGroup(run.Pgid, DefaultStopTimeout)
+}
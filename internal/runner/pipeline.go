@@ -0,0 +1,160 @@
+package runner
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"gomander/internal/command/domain"
+	"gomander/internal/event"
+)
+
+type PipelineStatus string
+
+const (
+	PipelineStatusRunning   PipelineStatus = "running"
+	PipelineStatusSucceeded PipelineStatus = "succeeded"
+	PipelineStatusFailed    PipelineStatus = "failed"
+	PipelineStatusCancelled PipelineStatus = "cancelled"
+)
+
+// PipelineState describes the current or last run of a pipeline, which is the payload of the pipeline events.
+type PipelineState struct {
+	Id               string         `json:"id"`
+	Status           PipelineStatus `json:"status"`
+	CurrentCommandId string         `json:"currentCommandId,omitempty"`
+	CurrentStep      int            `json:"currentStep"`
+	TotalSteps       int            `json:"totalSteps"`
+	FailedCommandIds []string       `json:"failedCommandIds"`
+	StartedAt        time.Time      `json:"startedAt"`
+	FinishedAt       *time.Time     `json:"finishedAt,omitempty"`
+}
+
+type pipeline struct {
+	state     PipelineState
+	cancelled bool
+	// done is closed once the pipeline finished
+	done chan struct{}
+}
+
+// snapshot must be called with the mutex held.
+func (p *pipeline) snapshot() PipelineState {
+	state := p.state
+	state.FailedCommandIds = slices.Clone(p.state.FailedCommandIds)
+	return state
+}
+
+// RunPipeline runs the commands one after another in the background, each one once the previous exited with code 0.
+func (c *DefaultRunner) RunPipeline(id string, commands []domain.Command, continueOnFailure bool, options RunOptions) error {
+	c.mutex.Lock()
+	if existing, exists := c.pipelines[id]; exists && existing.state.Status == PipelineStatusRunning {
+		c.mutex.Unlock()
+		return nil
+	}
+
+	p := &pipeline{
+		done: make(chan struct{}),
+		state: PipelineState{
+			Id:               id,
+			Status:           PipelineStatusRunning,
+			TotalSteps:       len(commands),
+			FailedCommandIds: make([]string, 0),
+			StartedAt:        time.Now(),
+		},
+	}
+	c.pipelines[id] = p
+	state := p.snapshot()
+	c.mutex.Unlock()
+
+	c.eventEmitter.EmitEvent(event.PipelineStarted, state)
+
+	go c.runPipeline(p, commands, continueOnFailure, options)
+
+	return nil
+}
+
+func (c *DefaultRunner) runPipeline(p *pipeline, commands []domain.Command, continueOnFailure bool, options RunOptions) {
+	defer close(p.done)
+
+	for i, command := range commands {
+		c.mutex.Lock()
+		if p.cancelled {
+			c.mutex.Unlock()
+			break
+		}
+		p.state.CurrentStep = i + 1
+		p.state.CurrentCommandId = command.Id
+		state := p.snapshot()
+		c.mutex.Unlock()
+
+		c.eventEmitter.EmitEvent(event.PipelineStepStarted, state)
+
+		if c.runPipelineStep(&command, options) {
+			continue
+		}
+
+		c.mutex.Lock()
+		p.state.FailedCommandIds = append(p.state.FailedCommandIds, command.Id)
+		stop := p.cancelled || !continueOnFailure
+		c.mutex.Unlock()
+
+		if stop {
+			break
+		}
+	}
+
+	c.mutex.Lock()
+	finishedAt := time.Now()
+	p.state.FinishedAt = &finishedAt
+	p.state.CurrentCommandId = ""
+	switch {
+	case p.cancelled:
+		p.state.Status = PipelineStatusCancelled
+	case len(p.state.FailedCommandIds) > 0:
+		p.state.Status = PipelineStatusFailed
+	default:
+		p.state.Status = PipelineStatusSucceeded
+	}
+	state := p.snapshot()
+	c.mutex.Unlock()
+
+	c.logger.Info(fmt.Sprintf("Pipeline %s finished: %s", state.Id, state.Status))
+	c.eventEmitter.EmitEvent(event.PipelineFinished, state)
+}
+
+// runPipelineStep runs the command, or waits for it if already running, reporting whether it exited with code 0.
+func (c *DefaultRunner) runPipelineStep(command *domain.Command, options RunOptions) bool {
+	err := c.RunCommand(command, options)
+	if err != nil {
+		return false
+	}
+
+	c.WaitForCommand(command.Id)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.runStates[command.Id].Status == RunStatusExitedOk
+}
+
+// StopPipeline prevents a running pipeline from starting its next commands, leaving the current one running.
+func (c *DefaultRunner) StopPipeline(id string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if p, exists := c.pipelines[id]; exists && p.state.Status == PipelineStatusRunning {
+		p.cancelled = true
+	}
+}
+
+// GetPipelineStates returns the state of the current or last run of every pipeline that has been run.
+func (c *DefaultRunner) GetPipelineStates() map[string]PipelineState {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	pipelineStates := make(map[string]PipelineState, len(c.pipelines))
+	for id, p := range c.pipelines {
+		pipelineStates[id] = p.snapshot()
+	}
+	return pipelineStates
+}
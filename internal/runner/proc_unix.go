@@ -3,11 +3,17 @@
 package runner
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"strings"
 	"syscall"
 	"time"
+
+	"github.com/creack/pty"
+
+	"gomander/internal/command/domain"
 )
 
 func SetProcAttributes(cmd *exec.Cmd) {
@@ -44,27 +50,96 @@ func SetProcEnv(cmd *exec.Cmd, environmentPaths []string) {
 	cmd.Env = append(cmd.Env, "PATH="+newPath)
 }
 
-func StopProcessGracefully(cmd *exec.Cmd) error {
-	// Try graceful termination first
-	err := syscall.Kill(-cmd.Process.Pid, syscall.SIGTERM)
+// StartWithTerminal starts the command attached to a new pseudo-terminal and returns its controlling side.
+func StartWithTerminal(cmd *exec.Cmd, size TerminalSize) (*os.File, error) {
+	// Setsid makes the process leader of its own group too, so it can still be stopped as a group.
+	// It can't be combined with Setpgid, hence the attributes are replaced.
+	return pty.StartWithAttrs(cmd, &pty.Winsize{Cols: size.Cols, Rows: size.Rows}, &syscall.SysProcAttr{
+		Setsid:  true,
+		Setctty: true,
+	})
+}
+
+func SetTerminalSize(terminal *os.File, size TerminalSize) error {
+	return pty.Setsize(terminal, &pty.Winsize{Cols: size.Cols, Rows: size.Rows})
+}
+
+var stopSignals = map[domain.StopSignal]syscall.Signal{
+	domain.StopSignalInterrupt: syscall.SIGINT,
+	domain.StopSignalTerminate: syscall.SIGTERM,
+	domain.StopSignalQuit:      syscall.SIGQUIT,
+	domain.StopSignalHangup:    syscall.SIGHUP,
+}
+
+// SignalProcessGroup asks the process group of the command to stop with the signal, or with SIGTERM when it's unknown.
+func SignalProcessGroup(cmd *exec.Cmd, signal domain.StopSignal) error {
+	sig, exists := stopSignals[signal]
+	if !exists {
+		sig = syscall.SIGTERM
+	}
+	return syscall.Kill(-cmd.Process.Pid, sig)
+}
+
+// ReloadProcessGroup sends SIGHUP to the process group of the command, which many servers reload their configuration on.
+func ReloadProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGHUP)
+}
+
+func KillProcessGroup(cmd *exec.Cmd) error {
+	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+}
+
+// ProcessGroupId returns the group of the process, which is the process itself for the commands started by the runner.
+func ProcessGroupId(pid int) int {
+	pgid, err := syscall.Getpgid(pid)
 	if err != nil {
-		// Fallback to force kill
-		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
+		return pid
+	}
+	return pgid
+}
+
+// IsProcessRunning reports whether the process exists and belongs to the user, as others can't be Gomander's.
+func IsProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+	return syscall.Kill(pid, 0) == nil
+}
+
+// IsProcessGroupRunning reports whether any process of the group is still running and belongs to the user.
+func IsProcessGroupRunning(pgid int) bool {
+	// 0 and 1 would target the group of Gomander and every process
+	if pgid <= 1 {
+		return false
+	}
+	return syscall.Kill(-pgid, 0) == nil
+}
+
+// StopProcessGroup stops a process group the runner didn't start, so it can't wait for it and polls it instead.
+func StopProcessGroup(pgid int, timeout time.Duration) error {
+	if pgid <= 1 {
+		return fmt.Errorf("invalid process group %d", pgid)
+	}
+
+	err := syscall.Kill(-pgid, syscall.SIGTERM)
+	if errors.Is(err, syscall.ESRCH) {
+		return nil
+	}
+	if err == nil {
+		deadline := time.Now().Add(timeout)
+		for time.Now().Before(deadline) {
+			if !IsProcessGroupRunning(pgid) {
+				return nil
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
 	}
 
-	// Wait a bit for graceful shutdown
-	done := make(chan error, 1)
-	go func() {
-		done <- cmd.Wait()
-	}()
-
-	select {
-	case <-time.After(5 * time.Second):
-		// Force kill if graceful shutdown takes too long
-		return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
-	case <-done:
+	err = syscall.Kill(-pgid, syscall.SIGKILL)
+	if errors.Is(err, syscall.ESRCH) {
 		return nil
 	}
+	return err
 }
 
 func GetCommand(cmdStr string) *exec.Cmd {
@@ -3,12 +3,15 @@
 package runner
 
 import (
+	"errors"
 	"os"
 	"os/exec"
 	"strconv"
 	"strings"
 	"syscall"
 	"time"
+
+	"gomander/internal/command/domain"
 )
 
 func SetProcAttributes(cmd *exec.Cmd) {
@@ -46,34 +49,92 @@ func SetProcEnv(cmd *exec.Cmd, environmentPaths []string) {
 	cmd.Env = append(cmd.Env, "PATH="+newPath)
 }
 
-func StopProcessGracefully(cmd *exec.Cmd) error {
-	pid := strconv.Itoa(cmd.Process.Pid)
+var (
+	ErrTerminalModeNotSupported = errors.New("terminal mode is not supported on Windows")
+	ErrReloadNotSupported       = errors.New("reloading is not supported on Windows")
+)
+
+func StartWithTerminal(_ *exec.Cmd, _ TerminalSize) (*os.File, error) {
+	return nil, ErrTerminalModeNotSupported
+}
+
+func SetTerminalSize(_ *os.File, _ TerminalSize) error {
+	return ErrTerminalModeNotSupported
+}
 
-	// Try graceful termination
-	killCmd := exec.Command("taskkill", "/PID", pid)
+// SignalProcessGroup asks the process of the command to close, as there are no signals to send on Windows.
+func SignalProcessGroup(cmd *exec.Cmd, _ domain.StopSignal) error {
+	killCmd := exec.Command("taskkill", "/PID", strconv.Itoa(cmd.Process.Pid))
 	killCmd.SysProcAttr = &syscall.SysProcAttr{
 		HideWindow: true,
 	}
+	return killCmd.Run()
+}
+
+func ReloadProcessGroup(_ *exec.Cmd) error {
+	return ErrReloadNotSupported
+}
+
+func KillProcessGroup(cmd *exec.Cmd) error {
+	return exec.Command("taskkill", "/F", "/T", "/PID", strconv.Itoa(cmd.Process.Pid)).Run()
+}
+
+const (
+	processQueryLimitedInformation = 0x1000
+	stillActive                    = 259
+)
+
+// ProcessGroupId returns the group of the process, which is the process itself for the commands started by the runner.
+func ProcessGroupId(pid int) int {
+	return pid
+}
 
-	err := killCmd.Run()
+// IsProcessRunning reports whether the process exists.
+func IsProcessRunning(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	handle, err := syscall.OpenProcess(processQueryLimitedInformation, false, uint32(pid))
 	if err != nil {
-		// Fallback to force kill
-		return exec.Command("taskkill", "/F", "/T", "/PID", pid).Run()
+		return false
+	}
+	defer syscall.CloseHandle(handle)
+
+	// The handle can still be opened for a while after the process exits, so its exit code is checked too
+	var exitCode uint32
+	err = syscall.GetExitCodeProcess(handle, &exitCode)
+	return err == nil && exitCode == stillActive
+}
+
+// IsProcessGroupRunning reports whether the process leading the group is still running.
+func IsProcessGroupRunning(pgid int) bool {
+	return IsProcessRunning(pgid)
+}
+
+// StopProcessGroup stops a process tree the runner didn't start, so it can't wait for it and polls it instead.
+func StopProcessGroup(pgid int, timeout time.Duration) error {
+	pid := strconv.Itoa(pgid)
+
+	killCmd := exec.Command("taskkill", "/T", "/PID", pid)
+	killCmd.SysProcAttr = &syscall.SysProcAttr{
+		HideWindow: true,
+	}
+
+	if killCmd.Run() == nil {
+		deadline := time.Now().Add(timeout)
+		for time.Now().Before(deadline) {
+			if !IsProcessGroupRunning(pgid) {
+				return nil
+			}
+			time.Sleep(100 * time.Millisecond)
+		}
 	}
 
-	// Wait for graceful shutdown
-	done := make(chan error, 1)
-	go func() {
-		done <- cmd.Wait()
-	}()
-
-	select {
-	case <-time.After(5 * time.Second):
-		// Force kill if needed
-		return exec.Command("taskkill", "/F", "/T", "/PID", pid).Run()
-	case <-done:
+	if !IsProcessGroupRunning(pgid) {
 		return nil
 	}
+	return exec.Command("taskkill", "/F", "/T", "/PID", pid).Run()
 }
 
 func GetCommand(cmdStr string) *exec.Cmd {
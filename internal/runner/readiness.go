@@ -0,0 +1,164 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"gomander/internal/command/domain"
+	"gomander/internal/event"
+)
+
+var (
+	// ReadinessProbeInterval is the time between two checks of a tcp or http readiness probe.
+	ReadinessProbeInterval = 500 * time.Millisecond
+	// DefaultReadinessTimeout is used for probes without timeout.
+	DefaultReadinessTimeout = 60 * time.Second
+)
+
+// logProbe waits for a log line of the command containing the pattern.
+type logProbe struct {
+	pattern string
+	matched chan struct{}
+	once    sync.Once
+}
+
+func (p *logProbe) check(line string) {
+	if strings.Contains(line, p.pattern) {
+		p.once.Do(func() {
+			close(p.matched)
+		})
+	}
+}
+
+// startReadinessProbe checks the readiness probe of a command that has just started, returning a function to stop it.
+func (c *DefaultRunner) startReadinessProbe(command *domain.Command) context.CancelFunc {
+	probe := command.ReadinessProbe
+	if probe == nil {
+		return func() {}
+	}
+
+	timeout := DefaultReadinessTimeout
+	if probe.TimeoutSeconds > 0 {
+		timeout = time.Duration(probe.TimeoutSeconds) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+
+	// Polling probes are checked on every tick, while the log probe is notified as soon as the line is logged
+	var check func(ctx context.Context) bool
+	var notified <-chan struct{}
+
+	switch probe.Type {
+	case domain.ReadinessProbeTCP:
+		check = func(ctx context.Context) bool {
+			return checkTCPReadiness(ctx, probe.Target)
+		}
+	case domain.ReadinessProbeHTTP:
+		check = func(ctx context.Context) bool {
+			return checkHTTPReadiness(ctx, probe.Target)
+		}
+	case domain.ReadinessProbeLog:
+		lp := &logProbe{pattern: probe.Target, matched: make(chan struct{})}
+		c.logProbes.Store(command.Id, lp)
+		context.AfterFunc(ctx, func() {
+			c.logProbes.CompareAndDelete(command.Id, lp)
+		})
+		check = func(context.Context) bool { return false }
+		notified = lp.matched
+	default:
+		c.sendStreamLine(command, fmt.Sprintf("Unknown readiness probe type: %s", probe.Type))
+		cancel()
+		return cancel
+	}
+
+	go func() {
+		ticker := time.NewTicker(ReadinessProbeInterval)
+		defer ticker.Stop()
+
+		for {
+			if check(ctx) {
+				c.setReadiness(command.Id, ReadinessReady)
+				c.eventEmitter.EmitEvent(event.ProcessReady, command.Id)
+				cancel()
+				return
+			}
+
+			select {
+			case <-notified:
+				c.setReadiness(command.Id, ReadinessReady)
+				c.eventEmitter.EmitEvent(event.ProcessReady, command.Id)
+				cancel()
+				return
+			case <-ctx.Done():
+				// A cancellation means the process finished before being ready
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					c.sendStreamLine(command, fmt.Sprintf("Readiness probe did not pass after %s", timeout))
+					c.setReadiness(command.Id, ReadinessTimedOut)
+					c.eventEmitter.EmitEvent(event.ProcessReadinessTimeout, command.Id)
+				}
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return cancel
+}
+
+// setReadiness updates the readiness of the current run, unless the process already finished.
+func (c *DefaultRunner) setReadiness(id string, readiness Readiness) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	runState := c.runStates[id]
+	if !runState.IsActive() {
+		return
+	}
+	runState.Readiness = readiness
+	c.setRunState(runState)
+}
+
+func (c *DefaultRunner) checkLineForReadiness(command *domain.Command, line string) {
+	if lp, exists := c.logProbes.Load(command.Id); exists {
+		lp.(*logProbe).check(line)
+	}
+}
+
+// checkTCPReadiness accepts a host:port address or just a port, which is looked up on localhost.
+func checkTCPReadiness(ctx context.Context, target string) bool {
+	address := target
+	if !strings.Contains(address, ":") {
+		address = net.JoinHostPort("localhost", address)
+	}
+
+	dialer := net.Dialer{Timeout: time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return false
+	}
+	_ = conn.Close()
+
+	return true
+}
+
+func checkHTTPReadiness(ctx context.Context, url string) bool {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return false
+	}
+
+	client := http.Client{Timeout: 2 * time.Second}
+	response, err := client.Do(request)
+	if err != nil {
+		return false
+	}
+	_ = response.Body.Close()
+
+	return response.StatusCode >= 200 && response.StatusCode < 300
+}
@@ -0,0 +1,92 @@
+package runner
+
+import (
+	"gomander/internal/command/domain"
+)
+
+// RestartCommand stops the command and runs it again once it exited, or reloads it per its restart mode.
+func (c *DefaultRunner) RestartCommand(command *domain.Command, options RunOptions) error {
+	if c.reloadCommand(command) {
+		return nil
+	}
+
+	err := c.stopAndWait(command.Id)
+	if err != nil {
+		return err
+	}
+
+	return c.RunCommand(command, options)
+}
+
+// RestartCommandGroup stops the commands of a group in the given order, then calls start once all of them exited.
+func (c *DefaultRunner) RestartCommandGroup(id string, commands []domain.Command, start func() error) error {
+	c.mutex.Lock()
+	p, exists := c.pipelines[id]
+	pipelineRunning := exists && p.state.Status == PipelineStatusRunning
+	if pipelineRunning {
+		p.cancelled = true
+	}
+	c.mutex.Unlock()
+
+	for i := range commands {
+		if !pipelineRunning && c.reloadCommand(&commands[i]) {
+			continue
+		}
+
+		err := c.stopAndWait(commands[i].Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if pipelineRunning {
+		<-p.done
+	}
+
+	return start()
+}
+
+// reloadCommand sends SIGHUP to the command when it's running and reloads on restart, reporting whether it did.
+func (c *DefaultRunner) reloadCommand(command *domain.Command) bool {
+	if command.RestartMode != domain.RestartModeReload {
+		return false
+	}
+
+	c.mutex.Lock()
+	runningCommand, exists := c.runningCommands[command.Id]
+	c.mutex.Unlock()
+
+	if !exists || runningCommand.stopRequested {
+		return false
+	}
+
+	err := ReloadProcessGroup(runningCommand.cmd)
+	if err != nil {
+		c.sendStreamLine(command, "Failed to reload, restarting instead: "+err.Error())
+		return false
+	}
+
+	c.sendStreamLine(command, "Reloading with SIGHUP")
+	return true
+}
+
+// stopAndWait stops the command, keeping its file watch, and waits for its end to be recorded.
+func (c *DefaultRunner) stopAndWait(id string) error {
+	c.mutex.Lock()
+	runningCommand, running := c.runningCommands[id]
+	orphan := c.orphans[id]
+	c.mutex.Unlock()
+
+	err := c.stopRunningCommand(id)
+	if err != nil {
+		return err
+	}
+
+	if running {
+		runningCommand.wg.Wait()
+	}
+	if orphan != nil {
+		<-orphan.done
+	}
+	return nil
+}
@@ -2,16 +2,23 @@ package runner
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"os/exec"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	"gomander/internal/command/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+	"gomander/internal/environment"
 	"gomander/internal/event"
 	"gomander/internal/helpers/path"
 	"gomander/internal/logger"
+	"gomander/internal/logstore"
 )
 
 var ExpectedTerminationLogs = []string{
@@ -21,41 +28,141 @@ var ExpectedTerminationLogs = []string{
 	"exit status 143",
 	"exit status 137",
 	"exit status 130",
+	"signal: quit",
+	"signal: hangup",
+	"exit status 131",
+	"exit status 129",
 	"wait: no child processes",
 }
 
+// TerminalSize is the size of the pseudo-terminal used by commands running in terminal mode.
+type TerminalSize struct {
+	Cols uint16 `json:"cols"`
+	Rows uint16 `json:"rows"`
+}
+
+var DefaultTerminalSize = TerminalSize{Cols: 80, Rows: 24}
+
+// RunOptions holds the settings a command inherits from the user configuration and its project.
+type RunOptions struct {
+	EnvironmentPaths     []string
+	BaseWorkingDirectory string
+	// EnvironmentVariables are the project variables, overridden by the command ones.
+	EnvironmentVariables []environment.Variable
+	// EnvFiles are the project dotenv files, relative to the command working directory.
+	EnvFiles []string
+	// Trigger is recorded in the run history. Automatic restarts are always recorded as such.
+	Trigger commandrundomain.Trigger
+}
+
+var (
+	ErrCommandNotRunning = errors.New("command is not running")
+	ErrInputNotAccepted  = errors.New("command does not accept input")
+)
+
+var (
+	// RestartInitialDelay is the delay before the first restart, doubled on each consecutive attempt.
+	RestartInitialDelay = time.Second
+	// RestartMaxDelay caps the exponential backoff between restarts.
+	RestartMaxDelay = 30 * time.Second
+	// RestartResetWindow is how long a process needs to stay up for its restart attempts to be reset.
+	RestartResetWindow = time.Minute
+)
+
+// RestartingPayload is the payload of the ProcessRestarting event. MaxAttempts is 0 when the restarts are not limited.
+type RestartingPayload struct {
+	Id          string `json:"id"`
+	Attempt     int    `json:"attempt"`
+	MaxAttempts int    `json:"maxAttempts"`
+	DelayMs     int64  `json:"delayMs"`
+}
+
 type RunningCommand struct {
-	cmd *exec.Cmd
-	wg  *sync.WaitGroup
+	command *domain.Command
+	cmd     *exec.Cmd
+	// exited is closed once the process exited and its output was read
+	exited        chan struct{}
+	terminal      *os.File
+	stdin         io.Writer
+	outputs       []io.Closer
+	wg            *sync.WaitGroup
+	options       RunOptions
+	startedAt     time.Time
+	restarts      int
+	stopRequested bool
+	run           commandrundomain.CommandRun
 }
 
 type DefaultRunner struct {
 	runningCommands map[string]RunningCommand
-	eventEmitter    event.EventEmitter
-	logger          logger.Logger
-	mutex           sync.Mutex
+	pendingRestarts map[string]*time.Timer
+	waitingCommands map[string]*waitingCommand
+	pipelines       map[string]*pipeline
+	orphans         map[string]*orphanedProcess
+	// startingCommands holds the commands being started, and whether they were stopped meanwhile
+	startingCommands map[string]bool
+	fileWatches      map[string]*fileWatch
+	runStates        map[string]RunState
+	logProbes        sync.Map
+	errorMatchers    sync.Map
+	detectedErrors   sync.Map
+	terminalSizes    map[string]TerminalSize
+	eventEmitter     event.EventEmitter
+	logger           logger.Logger
+	logStore         logstore.LogStore
+	commandRunRepo   commandrundomain.Repository
+	mutex            sync.Mutex
+	// stateChanged is broadcast on every run state change, waking up the commands waiting for their dependencies
+	stateChanged *sync.Cond
 }
 
 type Runner interface {
-	RunCommand(command *domain.Command, environmentPaths []string, baseWorkingDirectory string) error
-	RunCommands(commands []domain.Command, environmentPaths []string, baseWorkingDirectory string) error
+	RunCommand(command *domain.Command, options RunOptions) error
+	RunCommands(commands []domain.Command, options RunOptions) error
+	RunCommandsWithDependencies(commands []domain.Command, dependencies map[string][]Dependency, options RunOptions) error
+	RunPipeline(id string, commands []domain.Command, continueOnFailure bool, options RunOptions) error
+	StopPipeline(id string)
+	GetPipelineStates() map[string]PipelineState
 	StopRunningCommand(id string) error
 	StopAllRunningCommands() []error
 	StopRunningCommands(commands []domain.Command) error
-	GetRunningCommandIds() []string
+	GetRunStates() map[string]RunState
+	ResizeTerminal(id string, size TerminalSize) error
+	WriteToCommand(id string, data string) error
+	AddOrphanedProcess(run commandrundomain.CommandRun)
+	AdoptOrphanedProcess(id string) error
+	RestartCommand(command *domain.Command, options RunOptions) error
+	RestartCommandGroup(id string, commands []domain.Command, start func() error) error
 }
 
-func NewDefaultRunner(logger logger.Logger, emitter event.EventEmitter) *DefaultRunner {
-	return &DefaultRunner{
-		runningCommands: make(map[string]RunningCommand),
-		eventEmitter:    emitter,
-		logger:          logger,
+func NewDefaultRunner(
+	logger logger.Logger,
+	emitter event.EventEmitter,
+	logStore logstore.LogStore,
+	commandRunRepo commandrundomain.Repository,
+) *DefaultRunner {
+	runner := &DefaultRunner{
+		runningCommands:  make(map[string]RunningCommand),
+		pendingRestarts:  make(map[string]*time.Timer),
+		waitingCommands:  make(map[string]*waitingCommand),
+		pipelines:        make(map[string]*pipeline),
+		orphans:          make(map[string]*orphanedProcess),
+		startingCommands: make(map[string]bool),
+		fileWatches:      make(map[string]*fileWatch),
+		runStates:        make(map[string]RunState),
+		terminalSizes:    make(map[string]TerminalSize),
+		eventEmitter:     emitter,
+		logger:           logger,
+		logStore:         logStore,
+		commandRunRepo:   commandRunRepo,
 	}
+	runner.stateChanged = sync.NewCond(&runner.mutex)
+	return runner
 }
 
-func (c *DefaultRunner) RunCommands(commands []domain.Command, environmentPaths []string, baseWorkingDirectory string) error {
+func (c *DefaultRunner) RunCommands(commands []domain.Command, options RunOptions) error {
 	for _, command := range commands {
-		err := c.RunCommand(&command, environmentPaths, baseWorkingDirectory)
+		err := c.RunCommand(&command, options)
 		if err != nil {
 			return err
 		}
@@ -75,152 +182,504 @@ func (c *DefaultRunner) StopRunningCommands(commands []domain.Command) error {
 }
 
 // RunCommand executes a command and streams its output.
-func (c *DefaultRunner) RunCommand(command *domain.Command, environmentPaths []string, baseWorkingDirectory string) error {
+func (c *DefaultRunner) RunCommand(command *domain.Command, options RunOptions) error {
+	return c.runCommand(command, options, 0)
+}
+
+// runCommand executes a command that has already been restarted the given number of times.
+func (c *DefaultRunner) runCommand(command *domain.Command, options RunOptions, restarts int) error {
 	c.mutex.Lock()
 
-	if _, exists := c.runningCommands[command.Id]; exists {
+	// Running the command manually supersedes any scheduled restart or wait for its dependencies
+	c.cancelPendingRestart(command.Id)
+	delete(c.waitingCommands, command.Id)
+
+	_, starting := c.startingCommands[command.Id]
+	if _, exists := c.runningCommands[command.Id]; exists || starting || c.orphans[command.Id] != nil {
 		// Command is already running, skip it
 		c.mutex.Unlock()
 		return nil
 	}
 
+	// Reserved while the process is prepared and started without the mutex, which other commands need
+	c.startingCommands[command.Id] = false
+	c.setRunState(RunState{
+		CommandId: command.Id,
+		Status:    RunStatusStarting,
+		StartedAt: time.Now(),
+	})
+	startedAt := c.runStates[command.Id].StartedAt
+	terminalSize := c.getTerminalSize(command.Id)
+	c.mutex.Unlock()
+
+	c.startLogRun(command.Id)
+
 	// Get the command object based on the project string and OS
 	cmd := GetCommand(command.Command)
 
 	// Enable color output and set terminal type
 	cmd.Env = append(os.Environ(), "FORCE_COLOR=1", "TERM=xterm-256color")
-	cmd.Dir = path.GetComputedPath(baseWorkingDirectory, command.WorkingDirectory)
+	cmd.Dir = path.GetComputedPath(options.BaseWorkingDirectory, command.WorkingDirectory)
 
 	// Set project attributes based on OS
 	SetProcAttributes(cmd)
-	SetProcEnv(cmd, environmentPaths)
+	SetProcEnv(cmd, options.EnvironmentPaths)
 
-	stdout, err := cmd.StdoutPipe()
-	if err != nil {
-		c.sendStreamLine(command, err.Error())
-		c.mutex.Unlock()
-		return err
-	}
+	run := newCommandRun(command, cmd.Dir, options, restarts, startedAt)
+	c.detectedErrors.Delete(command.Id)
 
-	stderr, err := cmd.StderrPipe()
-	if err != nil {
+	abortStart := func(err error) error {
 		c.sendStreamLine(command, err.Error())
+
+		c.mutex.Lock()
+		delete(c.startingCommands, command.Id)
+		c.failStart(command.Id)
+		runState := c.runStates[command.Id]
 		c.mutex.Unlock()
+
+		c.finishCommandRun(&run, runState)
+		c.createCommandRun(&run)
 		return err
 	}
 
+	c.sendStartingLine(command)
+	c.prepareErrorPatterns(command)
+
+	// Later entries take precedence, so the user environment is overridden by the project env files,
+	// those by the project variables, and so on with the command env files and variables
+	cmd.Env = c.appendEnvFiles(command, cmd.Env, cmd.Dir, options.EnvFiles)
+	cmd.Env = append(cmd.Env, environment.ToEnv(options.EnvironmentVariables)...)
+	cmd.Env = c.appendEnvFiles(command, cmd.Env, cmd.Dir, command.EnvFiles)
+	cmd.Env = append(cmd.Env, environment.ToEnv(command.EnvironmentVariables)...)
+
 	var wg sync.WaitGroup
 	runningCommand := RunningCommand{
-		cmd: cmd,
-		wg:  &wg,
+		command:  command,
+		cmd:      cmd,
+		exited:   make(chan struct{}),
+		wg:       &wg,
+		options:  options,
+		restarts: restarts,
+		run:      run,
 	}
 
-	c.sendStartingLine(command)
-	if err := cmd.Start(); err != nil {
-		c.sendStreamLine(command, err.Error())
-		c.mutex.Unlock()
-		return err
+	var outputs []io.Reader
+	streamOutput := c.streamOutput
+
+	if command.TerminalMode {
+		terminal, err := StartWithTerminal(cmd, terminalSize)
+		if err != nil {
+			return abortStart(err)
+		}
+
+		runningCommand.terminal = terminal
+		runningCommand.stdin = terminal
+		runningCommand.outputs = []io.Closer{terminal}
+		outputs = []io.Reader{terminal}
+		streamOutput = c.streamRawOutput
+	} else {
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			return abortStart(err)
+		}
+
+		stderr, err := cmd.StderrPipe()
+		if err != nil {
+			return abortStart(err)
+		}
+
+		// Other commands keep reading EOF from their input
+		if command.Interactive {
+			stdin, err := cmd.StdinPipe()
+			if err != nil {
+				return abortStart(err)
+			}
+			runningCommand.stdin = stdin
+		}
+
+		if err := cmd.Start(); err != nil {
+			return abortStart(err)
+		}
+
+		outputs = []io.Reader{stdout, stderr}
+		runningCommand.outputs = []io.Closer{stdout, stderr}
 	}
 
 	c.eventEmitter.EmitEvent(event.ProcessStarted, command.Id)
 
+	// Recorded so the process can be found if Gomander crashes
+	runningCommand.run.Pid = cmd.Process.Pid
+	runningCommand.run.Pgid = ProcessGroupId(cmd.Process.Pid)
+	runningCommand.run.ProcessStartTime = ProcessStartTime(cmd.Process.Pid)
+
 	// Save the command in the runningCommands map
+	c.mutex.Lock()
+	// A stop requested while starting is applied once the process can be waited for
+	stopRequested := c.startingCommands[command.Id]
+	delete(c.startingCommands, command.Id)
+
+	runningCommand.startedAt = time.Now()
+	runningCommand.stopRequested = stopRequested
 	c.runningCommands[command.Id] = runningCommand
+	if !stopRequested {
+		c.startFileWatch(command, options, cmd.Dir)
+	}
+
+	runState := c.runStates[command.Id]
+	runState.Status = RunStatusRunning
+	if stopRequested {
+		runState.Status = RunStatusStopping
+	}
+	if command.ReadinessProbe != nil {
+		runState.Readiness = ReadinessPending
+	}
+	c.setRunState(runState)
 	c.mutex.Unlock()
 
+	c.createCommandRun(&runningCommand.run)
+
+	// Started before streaming the output, so log probes don't miss any line
+	stopReadinessProbe := c.startReadinessProbe(command)
+
 	// Add to WaitGroup before starting goroutines to avoid race conditions
-	wg.Add(3) // stdout, stderr, and wait goroutines
+	wg.Add(len(outputs) + 1) // output streams and wait goroutines
 
 	var scanWg sync.WaitGroup
 
-	scanWg.Add(2) // For stdout and stderr streaming
+	scanWg.Add(len(outputs)) // For output streaming
 
-	// Stream stdout
-	go func() {
-		defer scanWg.Done()
-		defer wg.Done()
-		c.streamOutput(command, stdout)
-	}()
-	// Stream stderr
-	go func() {
-		defer scanWg.Done()
-		defer wg.Done()
-		c.streamOutput(command, stderr)
-	}()
+	for _, output := range outputs {
+		go func() {
+			defer scanWg.Done()
+			defer wg.Done()
+			streamOutput(command, output)
+		}()
+	}
 
 	// Wait in background until the command finishes, because it ends naturally or because it is stopped.
 	go func() {
 		defer wg.Done()
 
+		var waitErr error
+
 		// Notify the event emitter that the command has finished and remove it from the runningCommands map
 		defer func() {
+			stopReadinessProbe()
+
 			c.mutex.Lock()
+			finishedCommand := c.runningCommands[command.Id]
 			delete(c.runningCommands, command.Id)
+			runState := c.runStates[command.Id].finish(exitStatus(cmd.ProcessState, finishedCommand.stopRequested))
+			c.setRunState(runState)
+			c.endLogRun(command.Id)
 			c.mutex.Unlock()
 			c.logger.Info("Command execution ended: " + command.Id)
-			c.eventEmitter.EmitEvent(event.ProcessFinished, command.Id)
+			c.eventEmitter.EmitEvent(event.ProcessFinished, runState)
+
+			c.finishCommandRun(&finishedCommand.run, runState)
+			c.updateCommandRun(&finishedCommand.run)
+
+			if !finishedCommand.stopRequested {
+				c.scheduleRestart(command, finishedCommand, waitErr)
+			}
 		}()
 
 		// Wait for all pipes to finish
 		scanWg.Wait()
 
-		// If the command is still running (StopRunningCommand has not been called, this is a self-ended command), wait for it to finish
-		if cmd.ProcessState == nil {
-			err := cmd.Wait()
-			if err != nil {
-				c.sendStreamLine(command, err.Error())
+		err := cmd.Wait()
+		close(runningCommand.exited)
+		waitErr = err
+		// The exit of a stopped command is expected, whatever its status
+		if err != nil && !c.isStopRequested(command.Id) {
+			c.sendStreamLine(command, err.Error())
 
-				if !isExpectedError(err) {
-					c.logger.Error("[ERROR - Waiting for project]: " + err.Error())
-				}
+			if !isExpectedError(err) {
+				c.logger.Error("[ERROR - Waiting for project]: " + err.Error())
 			}
 		}
+
+		if runningCommand.terminal != nil {
+			_ = runningCommand.terminal.Close()
+		}
 	}()
 
+	if stopRequested {
+		go func() {
+			if err := c.stopProcess(runningCommand); err != nil {
+				c.logger.Error(err.Error())
+			}
+		}()
+	}
+
 	return nil
 }
 
+// appendEnvFiles appends the variables of the dotenv files to env, skipping the ones that can't be read.
+func (c *DefaultRunner) appendEnvFiles(command *domain.Command, env []string, workingDirectory string, envFiles []string) []string {
+	for _, envFile := range envFiles {
+		envFilePath := path.GetComputedPath(workingDirectory, envFile)
+
+		data, err := os.ReadFile(envFilePath)
+		if err != nil {
+			c.sendStreamLine(command, "Failed to load env file: "+err.Error())
+			continue
+		}
+
+		variables, err := environment.ParseDotEnv(string(data), func(key string) (string, bool) {
+			return environment.Lookup(env, key)
+		})
+		if err != nil {
+			c.sendStreamLine(command, "Failed to parse env file "+envFilePath+": "+err.Error())
+			continue
+		}
+
+		env = append(env, environment.ToEnv(variables)...)
+	}
+	return env
+}
+
 func (c *DefaultRunner) sendStartingLine(command *domain.Command) {
+	c.persistOutput(command.Id, command.Command+"\n")
 	c.eventEmitter.EmitEvent(event.NewLogEntry, map[string]string{
 		"id":   command.Id,
 		"line": "\033[1;36m" + command.Command + "\033[0m",
 	})
 }
 
+// StopRunningCommand stops the command and its file watch, so it's not restarted until it's run again.
 func (c *DefaultRunner) StopRunningCommand(id string) error {
 	c.mutex.Lock()
+	c.stopFileWatch(id)
+	c.mutex.Unlock()
+
+	return c.stopRunningCommand(id)
+}
+
+// stopRunningCommand stops the command, leaving its file watch as is.
+func (c *DefaultRunner) stopRunningCommand(id string) error {
+	c.mutex.Lock()
+	if c.cancelPendingRestart(id) {
+		runState := c.runStates[id]
+		c.mutex.Unlock()
+		// The restarting state is left for the one of the last run
+		c.eventEmitter.EmitEvent(event.ProcessFinished, runState)
+		return nil
+	}
+
+	if c.cancelWaitingCommand(id) {
+		runState := c.runStates[id]
+		c.mutex.Unlock()
+		c.eventEmitter.EmitEvent(event.ProcessFinished, runState)
+		return nil
+	}
+
+	if orphan, exists := c.orphans[id]; exists {
+		orphan.stopRequested = true
+		c.setStopping(id)
+		run := orphan.run
+		c.mutex.Unlock()
+		return stopOrphanedProcess(run)
+	}
+
+	if _, starting := c.startingCommands[id]; starting {
+		c.startingCommands[id] = true
+		c.setStopping(id)
+		c.mutex.Unlock()
+		return nil
+	}
+
 	runningCommand, exists := c.runningCommands[id]
+	if exists {
+		runningCommand.stopRequested = true
+		c.runningCommands[id] = runningCommand
+		c.setStopping(id)
+	}
 	c.mutex.Unlock()
 
 	if !exists {
 		return nil
 	}
 
-	return StopProcessGracefully(runningCommand.cmd)
+	return c.stopProcess(runningCommand)
 }
 
 func (c *DefaultRunner) StopAllRunningCommands() []error {
 	errs := make([]error, 0)
 
+	c.mutex.Lock()
+	for id := range c.pendingRestarts {
+		c.cancelPendingRestart(id)
+	}
+	for id := range c.waitingCommands {
+		c.cancelWaitingCommand(id)
+	}
+	for id := range c.fileWatches {
+		c.stopFileWatch(id)
+	}
+	for id := range c.startingCommands {
+		c.startingCommands[id] = true
+		c.setStopping(id)
+	}
+	for _, p := range c.pipelines {
+		if p.state.Status == PipelineStatusRunning {
+			p.cancelled = true
+		}
+	}
+
 	// Create a slice to hold commands to stop
 	// this is necessary because we should not modify the map while iterating over it
-	commandsToStop := make([]*exec.Cmd, 0, len(c.runningCommands))
+	commandsToStop := make([]RunningCommand, 0, len(c.runningCommands))
 
-	for _, cmd := range c.runningCommands {
-		commandsToStop = append(commandsToStop, cmd.cmd)
+	for id, runningCommand := range c.runningCommands {
+		runningCommand.stopRequested = true
+		c.runningCommands[id] = runningCommand
+		c.setStopping(id)
+		commandsToStop = append(commandsToStop, runningCommand)
 	}
 
-	for _, cmd := range commandsToStop {
-		err := StopProcessGracefully(cmd)
+	// Orphaned processes are left running until the user decides what to do with them
+	orphansToStop := make([]commandrundomain.CommandRun, 0)
+	for id, orphan := range c.orphans {
+		if orphan.adopted {
+			orphan.stopRequested = true
+			c.setStopping(id)
+			orphansToStop = append(orphansToStop, orphan.run)
+		}
+	}
+	c.mutex.Unlock()
+
+	// Stopped at the same time, so their grace periods add up to the longest one instead of their sum
+	var wg sync.WaitGroup
+	var errsMutex sync.Mutex
+	stop := func(stopFunc func() error) {
+		defer wg.Done()
+		err := stopFunc()
 
 		if err != nil {
+			errsMutex.Lock()
 			errs = append(errs, err)
+			errsMutex.Unlock()
 		}
 	}
 
+	for _, runningCommand := range commandsToStop {
+		wg.Add(1)
+		go stop(func() error { return c.stopProcess(runningCommand) })
+	}
+
+	for _, run := range orphansToStop {
+		wg.Add(1)
+		go stop(func() error { return stopOrphanedProcess(run) })
+	}
+
+	wg.Wait()
 	return errs
 }
 
+func (c *DefaultRunner) isStopRequested(id string) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.runningCommands[id].stopRequested
+}
+
+// failStart must be called with the mutex held.
+func (c *DefaultRunner) failStart(id string) {
+	c.setRunState(c.runStates[id].finish(RunStatusExitedError, nil))
+	c.endLogRun(id)
+}
+
+// setStopping must be called with the mutex held.
+func (c *DefaultRunner) setStopping(id string) {
+	runState := c.runStates[id]
+	runState.Status = RunStatusStopping
+	c.setRunState(runState)
+}
+
+// setRunState must be called with the mutex held.
+func (c *DefaultRunner) setRunState(runState RunState) {
+	c.runStates[runState.CommandId] = runState
+	c.stateChanged.Broadcast()
+}
+
+// scheduleRestart restarts a command that exited on its own per its restart policy, with an exponential backoff.
+func (c *DefaultRunner) scheduleRestart(command *domain.Command, finishedCommand RunningCommand, waitErr error) {
+	if !shouldRestart(command.RestartPolicy, waitErr) {
+		return
+	}
+
+	attempt := finishedCommand.restarts + 1
+	if time.Since(finishedCommand.startedAt) >= RestartResetWindow {
+		attempt = 1
+	}
+
+	if command.MaxRestarts > 0 && attempt > command.MaxRestarts {
+		c.sendStreamLine(command, fmt.Sprintf("Not restarting after %d attempts", command.MaxRestarts))
+		return
+	}
+
+	delay := restartDelay(attempt)
+
+	c.mutex.Lock()
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		c.mutex.Lock()
+		if c.pendingRestarts[command.Id] != timer {
+			// The restart was cancelled meanwhile
+			c.mutex.Unlock()
+			return
+		}
+		delete(c.pendingRestarts, command.Id)
+		c.mutex.Unlock()
+
+		err := c.runCommand(command, finishedCommand.options, attempt)
+		if err != nil {
+			c.logger.Error("[ERROR - Restarting command]: " + err.Error())
+		}
+	})
+	c.pendingRestarts[command.Id] = timer
+	c.mutex.Unlock()
+
+	c.logger.Info(fmt.Sprintf("Restarting command %s in %s (attempt %d)", command.Id, delay, attempt))
+	c.eventEmitter.EmitEvent(event.ProcessRestarting, RestartingPayload{
+		Id:          command.Id,
+		Attempt:     attempt,
+		MaxAttempts: command.MaxRestarts,
+		DelayMs:     delay.Milliseconds(),
+	})
+}
+
+// cancelPendingRestart must be called with the mutex held. It reports whether there was a restart to cancel.
+func (c *DefaultRunner) cancelPendingRestart(id string) bool {
+	timer, pending := c.pendingRestarts[id]
+	if !pending {
+		return false
+	}
+
+	timer.Stop()
+	delete(c.pendingRestarts, id)
+	return true
+}
+
+func shouldRestart(policy domain.RestartPolicy, waitErr error) bool {
+	switch policy {
+	case domain.RestartPolicyAlways:
+		return true
+	case domain.RestartPolicyOnFailure:
+		return waitErr != nil
+	default:
+		return false
+	}
+}
+
+func restartDelay(attempt int) time.Duration {
+	delay := RestartInitialDelay
+	for i := 1; i < attempt && delay < RestartMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, RestartMaxDelay)
+}
+
 // isExpectedError checks if the error is one of the expected termination logs.
 func isExpectedError(err error) bool {
 	for _, expected := range ExpectedTerminationLogs {
@@ -231,7 +690,7 @@ func isExpectedError(err error) bool {
 	return false
 }
 
-func (c *DefaultRunner) streamOutput(command *domain.Command, pipeReader io.ReadCloser) {
+func (c *DefaultRunner) streamOutput(command *domain.Command, pipeReader io.Reader) {
 	scanner := bufio.NewScanner(pipeReader)
 	scanner.Buffer(make([]byte, 1024), 1024*1024) // Set buffer size to 1MB
 
@@ -242,8 +701,71 @@ func (c *DefaultRunner) streamOutput(command *domain.Command, pipeReader io.Read
 	}
 }
 
+// streamRawOutput forwards the terminal output as it arrives, so escape sequences reach the frontend untouched.
+func (c *DefaultRunner) streamRawOutput(command *domain.Command, terminalReader io.Reader) {
+	buffer := make([]byte, 32*1024)
+	var pending []byte
+	var partialLine string
+
+	for {
+		n, err := terminalReader.Read(buffer)
+		if n > 0 {
+			// Keep incomplete UTF-8 sequences for the next read, so they are not mangled
+			chunk, rest := splitIncompleteRune(append(pending, buffer[:n]...))
+			pending = append([]byte(nil), rest...)
+
+			if len(chunk) > 0 {
+				c.sendRawChunk(command, string(chunk))
+
+				// Error patterns are still matched line by line
+				lines := strings.Split(partialLine+string(chunk), "\n")
+				partialLine = lines[len(lines)-1]
+				for _, line := range lines[:len(lines)-1] {
+					c.processStreamLine(command, line)
+				}
+				if len(partialLine) > maxPartialLineLength {
+					c.processStreamLine(command, partialLine)
+					partialLine = ""
+				}
+			}
+		}
+		if err != nil {
+			// Reading from the terminal fails with EIO once the process exits, which is the expected way to end
+			if partialLine != "" {
+				c.processStreamLine(command, partialLine)
+			}
+			return
+		}
+	}
+}
+
+const maxPartialLineLength = 1024 * 1024
+
+// splitIncompleteRune splits the data at the start of a trailing incomplete UTF-8 sequence, if any.
+func splitIncompleteRune(data []byte) ([]byte, []byte) {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if utf8.FullRune(data[i:]) {
+				return data, nil
+			}
+			return data[:i], data[i:]
+		}
+	}
+	return data, nil
+}
+
+func (c *DefaultRunner) sendRawChunk(command *domain.Command, chunk string) {
+	c.persistOutput(command.Id, chunk)
+	c.eventEmitter.EmitEvent(event.NewLogEntry, map[string]string{
+		"id":   command.Id,
+		"line": chunk,
+		"raw":  "true",
+	})
+}
+
 func (c *DefaultRunner) sendStreamLine(command *domain.Command, line string) {
 	c.processStreamLine(command, line)
+	c.persistOutput(command.Id, line+"\n")
 
 	c.eventEmitter.EmitEvent(event.NewLogEntry, map[string]string{
 		"id":   command.Id,
@@ -251,23 +773,34 @@ func (c *DefaultRunner) sendStreamLine(command *domain.Command, line string) {
 	})
 }
 
-func (c *DefaultRunner) processStreamLine(command *domain.Command, line string) {
-	c.checkLineForErrors(command, line)
+func (c *DefaultRunner) startLogRun(id string) {
+	err := c.logStore.StartRun(id)
+	if err != nil {
+		c.logger.Error("[ERROR - Starting log file]: " + err.Error())
+	}
 }
 
-func (c *DefaultRunner) checkLineForErrors(command *domain.Command, line string) {
-	errorPatterns := command.ErrorPatterns
-
-	for _, pattern := range errorPatterns {
-		matchString := strings.Contains(line, pattern)
+// endLogRun must be called with the mutex held.
+func (c *DefaultRunner) endLogRun(id string) {
+	err := c.logStore.EndRun(id)
+	if err != nil {
+		c.logger.Error("[ERROR - Closing log file]: " + err.Error())
+	}
+}
 
-		if matchString {
-			c.eventEmitter.EmitEvent(event.CommandErrorDetected, command.Id)
-			break
-		}
+// persistOutput writes the output to the log files of the command, so it can be read after leaving the frontend.
+func (c *DefaultRunner) persistOutput(id string, output string) {
+	err := c.logStore.Append(id, output)
+	if err != nil {
+		c.logger.Error("[ERROR - Writing log file]: " + err.Error())
 	}
 }
 
+func (c *DefaultRunner) processStreamLine(command *domain.Command, line string) {
+	c.checkLineForErrors(command, line)
+	c.checkLineForReadiness(command, line)
+}
+
 func (c *DefaultRunner) GetRunningCommands() map[string]RunningCommand {
 	return c.runningCommands
 }
@@ -284,13 +817,56 @@ func (c *DefaultRunner) WaitForCommand(commandId string) {
 	}
 }
 
-func (c *DefaultRunner) GetRunningCommandIds() []string {
+// WriteToCommand sends the data to the standard input of a running command.
+func (c *DefaultRunner) WriteToCommand(id string, data string) error {
+	c.mutex.Lock()
+	runningCommand, exists := c.runningCommands[id]
+	c.mutex.Unlock()
+
+	if !exists {
+		return ErrCommandNotRunning
+	}
+	if runningCommand.stdin == nil {
+		return ErrInputNotAccepted
+	}
+
+	_, err := io.WriteString(runningCommand.stdin, data)
+	return err
+}
+
+// ResizeTerminal updates the pseudo-terminal size of a command, which is kept for its next runs.
+func (c *DefaultRunner) ResizeTerminal(id string, size TerminalSize) error {
+	c.mutex.Lock()
+	c.terminalSizes[id] = size
+	runningCommand, exists := c.runningCommands[id]
+	c.mutex.Unlock()
+
+	if !exists || runningCommand.terminal == nil {
+		return nil
+	}
+
+	return SetTerminalSize(runningCommand.terminal, size)
+}
+
+// getTerminalSize must be called with the mutex held
+func (c *DefaultRunner) getTerminalSize(id string) TerminalSize {
+	if size, exists := c.terminalSizes[id]; exists {
+		return size
+	}
+	return DefaultTerminalSize
+}
+
+// GetRunStates returns the state of the current or last run of every command that has been run.
+func (c *DefaultRunner) GetRunStates() map[string]RunState {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
-	ids := make([]string, 0, len(c.runningCommands))
-	for id := range c.runningCommands {
-		ids = append(ids, id)
+	runStates := make(map[string]RunState, len(c.runStates))
+	for id, runState := range c.runStates {
+		if runState.IsActive() {
+			runState.DurationMs = time.Since(runState.StartedAt).Milliseconds()
+		}
+		runStates[id] = runState
 	}
-	return ids
+	return runStates
 }
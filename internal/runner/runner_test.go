@@ -1,15 +1,27 @@
 package runner_test
 
 import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	commanddomain "gomander/internal/command/domain"
+	commandgroupdomain "gomander/internal/commandgroup/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+	test3 "gomander/internal/commandrun/domain/test"
+	"gomander/internal/environment"
 	"gomander/internal/event"
 	test2 "gomander/internal/event/test"
 	"gomander/internal/logger/test"
+	"gomander/internal/logstore"
 	"gomander/internal/runner"
 
 	"github.com/stretchr/testify/assert"
@@ -23,6 +35,29 @@ func validWorkingDirectory() string {
 	return "/"
 }
 
+// newRunner creates a runner persisting the logs to a temporary folder, without asserting the run history.
+func newRunner(t *testing.T, logger *test.MockLogger, emitter *test2.MockEventEmitter) *runner.DefaultRunner {
+	commandRunRepository := new(test3.MockCommandRunRepository)
+	commandRunRepository.On("Create", mock.Anything).Return(nil).Maybe()
+	commandRunRepository.On("Update", mock.Anything).Return(nil).Maybe()
+
+	return runner.NewDefaultRunner(logger, emitter, logstore.NewDefaultLogStore(t.TempDir()), commandRunRepository)
+}
+
+// runStateOf matches the run state emitted for a command, whatever its status.
+func runStateOf(commandId string) interface{} {
+	return mock.MatchedBy(func(state runner.RunState) bool {
+		return state.CommandId == commandId
+	})
+}
+
+// stoppingOf matches the stopping event of a command, whatever its grace period.
+func stoppingOf(commandId string) interface{} {
+	return mock.MatchedBy(func(payload runner.StoppingPayload) bool {
+		return payload.Id == commandId
+	})
+}
+
 func TestDefaultRunner_RunCommand(t *testing.T) {
 	commandId := "1"
 
@@ -31,10 +66,10 @@ func TestDefaultRunner_RunCommand(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
 		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
 		mockEmitterLogEntry(emitter, commandId, "a")
 		mockEmitterLogEntry(emitter, commandId, "b")
 		mockEmitterLogEntry(emitter, commandId, "c")
@@ -56,7 +91,7 @@ func TestDefaultRunner_RunCommand(t *testing.T) {
 			Command:          "echo 'a'&& echo 'b'&& echo 'c'",
 			WorkingDirectory: validWorkingDirectory(),
 			Position:         0,
-		}, []string{"/test"}, "/test")
+		}, runner.RunOptions{EnvironmentPaths: []string{"/test"}, BaseWorkingDirectory: "/test"})
 		r.WaitForCommand(commandId)
 
 		// Assert
@@ -70,10 +105,10 @@ func TestDefaultRunner_RunCommand(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
 		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
 		// Not an amazing matcher, but different OSes will have different error messages
 		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
 
@@ -89,7 +124,7 @@ func TestDefaultRunner_RunCommand(t *testing.T) {
 			Command:          "definitely-not-a-real-command-12345",
 			WorkingDirectory: validWorkingDirectory(),
 			Position:         0,
-		}, []string{}, "")
+		}, runner.RunOptions{})
 		r.WaitForCommand(commandId)
 
 		// Assert
@@ -97,6 +132,43 @@ func TestDefaultRunner_RunCommand(t *testing.T) {
 		assert.Empty(t, r.GetRunningCommands())
 		mock.AssertExpectationsForObjects(t, emitter, logger)
 	})
+
+	t.Run("Should persist the output of the run", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("The test command relies on a POSIX shell")
+		}
+
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		logStore := logstore.NewDefaultLogStore(t.TempDir())
+
+		commandRunRepository := new(test3.MockCommandRunRepository)
+		commandRunRepository.On("Create", mock.Anything).Return(nil)
+		commandRunRepository.On("Update", mock.Anything).Return(nil)
+
+		r := runner.NewDefaultRunner(logger, emitter, logStore, commandRunRepository)
+
+		emitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Return()
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Test",
+			Command:          "echo 'a' && echo 'b'",
+			WorkingDirectory: validWorkingDirectory(),
+		}, runner.RunOptions{})
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		page, err := logStore.GetLines(commandId, "", 0, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"echo 'a' && echo 'b'", "a", "b"}, page.Lines)
+	})
 }
 
 func TestDefaultRunner_StopRunningCommand(t *testing.T) {
@@ -105,14 +177,15 @@ func TestDefaultRunner_StopRunningCommand(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
 		commandId := "1"
 
 		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(commandId)).Return()
 
 		// Sometimes, in CI, this event is not emitted fast enough, so we use Maybe()
-		emitter.On("EmitEvent", event.ProcessFinished, commandId).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Maybe().Return()
 		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
 
 		logger.On("Info", mock.Anything).Return()
@@ -128,7 +201,7 @@ func TestDefaultRunner_StopRunningCommand(t *testing.T) {
 			Command:          infiniteCmd(),
 			WorkingDirectory: validWorkingDirectory(),
 			Position:         0,
-		}, []string{}, "")
+		}, runner.RunOptions{})
 		assert.NoError(t, err)
 
 		assert.Eventually(t, func() bool {
@@ -151,11 +224,12 @@ func TestDefaultRunner_StopRunningCommand(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 		commandId := "1"
 
 		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, commandId).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(commandId)).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Maybe().Return()
 		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
 
 		logger.On("Info", mock.Anything).Return()
@@ -171,7 +245,7 @@ func TestDefaultRunner_StopRunningCommand(t *testing.T) {
 			WorkingDirectory: validWorkingDirectory(),
 			Position:         0,
 		}
-		err := r.RunCommand(&command, []string{}, "")
+		err := r.RunCommand(&command, runner.RunOptions{})
 		assert.NoError(t, err)
 
 		assert.Eventually(t, func() bool {
@@ -179,7 +253,7 @@ func TestDefaultRunner_StopRunningCommand(t *testing.T) {
 		}, 1*time.Second, 20*time.Millisecond)
 
 		// Try to run the same command again
-		err = r.RunCommand(&command, []string{}, "")
+		err = r.RunCommand(&command, runner.RunOptions{})
 
 		// Assert
 		assert.NoError(t, err)
@@ -198,15 +272,17 @@ func TestDefaultRunner_StopAllRunningCommands(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
 		cmd1Id := "1"
 		cmd2Id := "2"
 
 		emitter.On("EmitEvent", event.ProcessStarted, cmd1Id).Return()
 		emitter.On("EmitEvent", event.ProcessStarted, cmd2Id).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, cmd1Id).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, cmd2Id).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(cmd1Id)).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(cmd2Id)).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(cmd1Id)).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(cmd2Id)).Return()
 
 		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
 
@@ -223,7 +299,7 @@ func TestDefaultRunner_StopAllRunningCommands(t *testing.T) {
 			Command:          infiniteCmd(),
 			WorkingDirectory: validWorkingDirectory(),
 			Position:         0,
-		}, []string{}, "")
+		}, runner.RunOptions{})
 		assert.NoError(t, err)
 
 		err = r.RunCommand(&commanddomain.Command{
@@ -233,7 +309,7 @@ func TestDefaultRunner_StopAllRunningCommands(t *testing.T) {
 			Command:          infiniteCmd(),
 			WorkingDirectory: validWorkingDirectory(),
 			Position:         0,
-		}, []string{}, "")
+		}, runner.RunOptions{})
 		assert.NoError(t, err)
 
 		assert.Eventually(t, func() bool {
@@ -259,15 +335,15 @@ func TestDefaultRunner_RunCommands(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
 		cmd1Id := "1"
 		cmd2Id := "2"
 
 		emitter.On("EmitEvent", event.ProcessStarted, cmd1Id).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, cmd1Id).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(cmd1Id)).Maybe().Return()
 		emitter.On("EmitEvent", event.ProcessStarted, cmd2Id).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, cmd2Id).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(cmd2Id)).Maybe().Return()
 		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(
 			data map[string]string) bool {
 			return strings.Contains(data["line"], "echo")
@@ -300,7 +376,7 @@ func TestDefaultRunner_RunCommands(t *testing.T) {
 		}
 
 		// Act
-		err := r.RunCommands(commands, []string{"/test"}, "/test")
+		err := r.RunCommands(commands, runner.RunOptions{EnvironmentPaths: []string{"/test"}, BaseWorkingDirectory: "/test"})
 
 		// Wait for both commands to complete
 		r.WaitForCommand(cmd1Id)
@@ -317,15 +393,15 @@ func TestDefaultRunner_RunCommands(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
 		cmd1Id := "1"
 		cmd2Id := "2"
 
 		// Mock for the first command to succeed
 		emitter.On("EmitEvent", event.ProcessStarted, cmd1Id).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, cmd1Id).Maybe().Return()
-		emitter.On("EmitEvent", event.ProcessFinished, cmd2Id).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(cmd1Id)).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(cmd2Id)).Maybe().Return()
 		mockEmitterLogEntry(emitter, cmd1Id, "command1 output")
 
 		// For the second command, we won't set expectations because it should
@@ -357,7 +433,7 @@ func TestDefaultRunner_RunCommands(t *testing.T) {
 		}
 
 		// Act
-		err := r.RunCommands(commands, []string{}, "")
+		err := r.RunCommands(commands, runner.RunOptions{})
 
 		// Wait for the first command to complete
 		r.WaitForCommand(cmd1Id)
@@ -378,15 +454,17 @@ func TestDefaultRunner_StopRunningCommands(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
 		cmd1Id := "1"
 		cmd2Id := "2"
 
 		emitter.On("EmitEvent", event.ProcessStarted, cmd1Id).Return()
 		emitter.On("EmitEvent", event.ProcessStarted, cmd2Id).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, cmd1Id).Maybe().Return()
-		emitter.On("EmitEvent", event.ProcessFinished, cmd2Id).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(cmd1Id)).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(cmd2Id)).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(cmd1Id)).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(cmd2Id)).Maybe().Return()
 		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
 
 		logger.On("Info", mock.Anything).Return()
@@ -412,10 +490,10 @@ func TestDefaultRunner_StopRunningCommands(t *testing.T) {
 			Position:         1,
 		}
 
-		err := r.RunCommand(&cmd1, []string{}, "")
+		err := r.RunCommand(&cmd1, runner.RunOptions{})
 		assert.NoError(t, err)
 
-		err = r.RunCommand(&cmd2, []string{}, "")
+		err = r.RunCommand(&cmd2, runner.RunOptions{})
 		assert.NoError(t, err)
 
 		assert.Eventually(t, func() bool {
@@ -444,7 +522,7 @@ func TestDefaultRunner_StopRunningCommands(t *testing.T) {
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
 		// Define commands that aren't running
 		cmd1 := commanddomain.Command{
@@ -475,133 +553,2100 @@ func TestDefaultRunner_StopRunningCommands(t *testing.T) {
 	})
 }
 
-func TestDefaultRunner_GetRunningCommandIds(t *testing.T) {
-	t.Run("Should return empty list when no commands are running", func(t *testing.T) {
+func mockEmitterLogEntry(emitter *test2.MockEventEmitter, id string, line string) {
+	if runtime.GOOS == "windows" {
+		emitter.On("EmitEvent", event.NewLogEntry, map[string]string{
+			"id":   id,
+			"line": "'" + line + "'",
+		}).Return()
+	} else {
+		emitter.On("EmitEvent", event.NewLogEntry, map[string]string{
+			"id":   id,
+			"line": line,
+		}).Return()
+	}
+}
+
+func TestDefaultRunner_ErrorPatternDetection(t *testing.T) {
+	t.Run("Should emit CommandErrorDetected event when error pattern is matched", func(t *testing.T) {
 		// Arrange
 		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "error-pattern-test"
+
+		// Mock the standard events
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+
+		// Mock the error detection event - this is what we're testing
+		emitter.On("EmitEvent", event.CommandErrorDetected, runner.ErrorDetectedPayload{
+			Id:       commandId,
+			Line:     "ERROR: Something went wrong",
+			Pattern:  "ERROR:",
+			Severity: commanddomain.ErrorPatternSeverityError,
+		}).Return()
+
+		// Mock log entries for the command output
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
 		logger.On("Info", mock.Anything).Return()
 		logger.On("Debug", mock.Anything).Return()
-		logger.On("Error", mock.Anything).Return()
 
+		// Act - create a command with error patterns and output that matches them
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Error Pattern Test",
+			Command:          "echo 'Starting...' && echo 'ERROR: Something went wrong' && echo 'Done'",
+			WorkingDirectory: validWorkingDirectory(),
+			Position:         0,
+			ErrorPatterns: []commanddomain.ErrorPattern{
+				{Pattern: "ERROR:"},
+				{Pattern: "FATAL:"},
+			},
+		}, runner.RunOptions{})
+
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, r.GetRunningCommands())
+
+		// Verify that CommandErrorDetected was called
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+	t.Run("Should match regular expressions and report the severity of the first matching pattern", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("The test command relies on a POSIX shell")
+		}
+
+		// Arrange
+		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "severity-test"
+
+		emitter.On("EmitEvent", event.CommandErrorDetected, runner.ErrorDetectedPayload{
+			Id:       commandId,
+			Line:     "WARN: foo() is deprecated",
+			Pattern:  `^WARN:.*deprecated`,
+			Severity: commanddomain.ErrorPatternSeverityWarning,
+			Label:    "Deprecation",
+		}).Return().Once()
+		emitter.On("EmitEvent", event.CommandErrorDetected, runner.ErrorDetectedPayload{
+			Id:       commandId,
+			Line:     "panic: boom",
+			Pattern:  "panic:",
+			Severity: commanddomain.ErrorPatternSeverityError,
+		}).Return().Once()
 		emitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
 
-		sut := runner.NewDefaultRunner(logger, emitter)
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Return()
 
 		// Act
-		result := sut.GetRunningCommandIds()
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			Command:          "echo 'WARN: foo() is deprecated' && echo 'WARN: slow query' && echo 'panic: boom'",
+			WorkingDirectory: validWorkingDirectory(),
+			ErrorPatterns: []commanddomain.ErrorPattern{
+				{Pattern: `^WARN:.*deprecated`, Regex: true, Severity: commanddomain.ErrorPatternSeverityWarning, Label: "Deprecation"},
+				{Pattern: "panic:"},
+				{Pattern: "panic: boom", Severity: commanddomain.ErrorPatternSeverityInfo},
+			},
+		}, runner.RunOptions{})
+		r.WaitForCommand(commandId)
 
 		// Assert
-		assert.Empty(t, result)
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
 	})
+}
+
+func TestDefaultRunner_RunHistory(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test command relies on a POSIX shell")
+	}
 
-	t.Run("Should return list of running command ids", func(t *testing.T) {
+	t.Run("Should record the run with its trigger, exit code and detected errors", func(t *testing.T) {
 		// Arrange
 		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		commandRunRepository := new(test3.MockCommandRunRepository)
+
+		r := runner.NewDefaultRunner(logger, emitter, logstore.NewDefaultLogStore(t.TempDir()), commandRunRepository)
+
+		commandId := "worker"
+		var createdRun commandrundomain.CommandRun
+		commandRunRepository.On("Create", mock.Anything).Run(func(args mock.Arguments) {
+			createdRun = *args.Get(0).(*commandrundomain.CommandRun)
+		}).Return(nil).Once()
+		commandRunRepository.On("Update", mock.MatchedBy(func(run *commandrundomain.CommandRun) bool {
+			return run.Id == createdRun.Id &&
+				run.Status == string(runner.RunStatusExitedError) &&
+				run.ExitCode != nil && *run.ExitCode == 3 &&
+				run.FinishedAt != nil &&
+				run.ErrorDetected
+		})).Return(nil).Once()
+
+		emitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
 		logger.On("Info", mock.Anything).Return()
 		logger.On("Debug", mock.Anything).Return()
 		logger.On("Error", mock.Anything).Return()
 
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			Command:          "echo 'panic: boom' && exit 3",
+			WorkingDirectory: validWorkingDirectory(),
+			ErrorPatterns:    []commanddomain.ErrorPattern{{Pattern: "panic:"}},
+		}, runner.RunOptions{Trigger: commandrundomain.TriggerHTTP})
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, commandId, createdRun.CommandId)
+		assert.Equal(t, "echo 'panic: boom' && exit 3", createdRun.Command)
+		assert.Equal(t, validWorkingDirectory(), createdRun.WorkingDirectory)
+		assert.Equal(t, commandrundomain.TriggerHTTP, createdRun.Trigger)
+		assert.Equal(t, string(runner.RunStatusRunning), createdRun.Status)
+		commandRunRepository.AssertExpectations(t)
+	})
+}
+
+func infiniteCmd() string {
+	if runtime.GOOS == "windows" {
+		return "ping -t 127.0.0.1"
+	}
+	return "ping 127.0.0.1"
+}
+
+func TestDefaultRunner_EnvironmentVariables(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test command relies on a POSIX shell")
+	}
+
+	t.Run("Should apply project variables overridden by command variables", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
-		emitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
 
-		sut := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
-		// Create a few commands that will run for a short time
-		command1 := &commanddomain.Command{
-			Id:      "cmd-1",
-			Command: infiniteCmd(),
-		}
-		command2 := &commanddomain.Command{
-			Id:      "cmd-2",
-			Command: infiniteCmd(),
-		}
+		commandId := "env-test"
 
-		// Start the commands
-		_ = sut.RunCommand(command1, []string{}, validWorkingDirectory())
-		_ = sut.RunCommand(command2, []string{}, validWorkingDirectory())
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(data map[string]string) bool {
+			return strings.Contains(data["line"], "echo")
+		})).Return()
+		mockEmitterLogEntry(emitter, commandId, "project command command")
 
-		// Give them a moment to start
-		time.Sleep(10 * time.Millisecond)
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Return()
 
 		// Act
-		result := sut.GetRunningCommandIds()
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Env Test",
+			Command:          "echo \"$FROM_PROJECT $OVERRIDDEN $FROM_COMMAND\"",
+			WorkingDirectory: validWorkingDirectory(),
+			EnvironmentVariables: []environment.Variable{
+				{Key: "OVERRIDDEN", Value: "command"},
+				{Key: "FROM_COMMAND", Value: "command"},
+			},
+		}, runner.RunOptions{
+			EnvironmentVariables: []environment.Variable{
+				{Key: "FROM_PROJECT", Value: "project"},
+				{Key: "OVERRIDDEN", Value: "project"},
+			},
+		})
+		r.WaitForCommand(commandId)
 
 		// Assert
-		assert.Len(t, result, 2)
-		assert.Contains(t, result, "cmd-1")
-		assert.Contains(t, result, "cmd-2")
-
-		// Wait for the commands to finish so we don't affect other tests
-		time.Sleep(200 * time.Millisecond)
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
 	})
 }
 
-func mockEmitterLogEntry(emitter *test2.MockEventEmitter, id string, line string) {
+func TestDefaultRunner_EnvFiles(t *testing.T) {
 	if runtime.GOOS == "windows" {
-		emitter.On("EmitEvent", event.NewLogEntry, map[string]string{
-			"id":   id,
-			"line": "'" + line + "'",
-		}).Return()
-	} else {
-		emitter.On("EmitEvent", event.NewLogEntry, map[string]string{
-			"id":   id,
-			"line": line,
-		}).Return()
+		t.Skip("The test command relies on a POSIX shell")
 	}
-}
 
-func TestDefaultRunner_ErrorPatternDetection(t *testing.T) {
-	t.Run("Should emit CommandErrorDetected event when error pattern is matched", func(t *testing.T) {
+	t.Run("Should load the env files relative to the working directory", func(t *testing.T) {
 		// Arrange
 		logger := new(test.MockLogger)
 		emitter := new(test2.MockEventEmitter)
 
-		r := runner.NewDefaultRunner(logger, emitter)
+		r := newRunner(t, logger, emitter)
 
-		commandId := "error-pattern-test"
+		commandId := "env-files-test"
+		workingDirectory := t.TempDir()
+
+		err := os.WriteFile(filepath.Join(workingDirectory, ".env"), []byte("FROM_PROJECT_FILE=project-file\nOVERRIDDEN=project-file\n"), 0o600)
+		assert.NoError(t, err)
+		err = os.WriteFile(filepath.Join(workingDirectory, ".env.local"), []byte("export OVERRIDDEN=\"${OVERRIDDEN}-command-file\"\n"), 0o600)
+		assert.NoError(t, err)
 
-		// Mock the standard events
 		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
-		emitter.On("EmitEvent", event.ProcessFinished, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(data map[string]string) bool {
+			return strings.Contains(data["line"], "echo")
+		})).Return()
+		mockEmitterLogEntry(emitter, commandId, "project-file project-file-command-file")
 
-		// Mock the error detection event - this is what we're testing
-		emitter.On("EmitEvent", event.CommandErrorDetected, commandId).Return()
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Return()
 
-		// Mock log entries for the command output
-		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+		// Act
+		err = r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Env Files Test",
+			Command:          "echo \"$FROM_PROJECT_FILE $OVERRIDDEN\"",
+			WorkingDirectory: workingDirectory,
+			EnvFiles:         []string{".env.local"},
+		}, runner.RunOptions{
+			EnvFiles: []string{".env"},
+		})
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+
+	t.Run("Should report invalid env files in the logs and still run the command", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "invalid-env-files-test"
+		workingDirectory := t.TempDir()
+
+		err := os.WriteFile(filepath.Join(workingDirectory, ".env"), []byte("VALID=1\nINVALID\n"), 0o600)
+		assert.NoError(t, err)
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(data map[string]string) bool {
+			return strings.Contains(data["line"], "echo")
+		})).Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(data map[string]string) bool {
+			return strings.HasPrefix(data["line"], "Failed to parse env file") && strings.Contains(data["line"], "line 2")
+		})).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(data map[string]string) bool {
+			return strings.HasPrefix(data["line"], "Failed to load env file") && strings.Contains(data["line"], "missing.env")
+		})).Return().Once()
+		mockEmitterLogEntry(emitter, commandId, "valid:")
 
 		logger.On("Info", mock.Anything).Return()
 		logger.On("Debug", mock.Anything).Return()
 
-		// Act - create a command with error patterns and output that matches them
+		// Act
+		err = r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Invalid Env Files Test",
+			Command:          "echo \"valid:$VALID\"",
+			WorkingDirectory: workingDirectory,
+			EnvFiles:         []string{".env", "missing.env"},
+		}, runner.RunOptions{})
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+}
+
+func TestDefaultRunner_RunStates(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test command relies on a POSIX shell")
+	}
+
+	t.Run("Should report the exit code and status of finished commands", func(t *testing.T) {
+		testCases := []struct {
+			name             string
+			command          string
+			expectedStatus   runner.RunStatus
+			expectedExitCode int
+		}{
+			{name: "successful", command: "exit 0", expectedStatus: runner.RunStatusExitedOk, expectedExitCode: 0},
+			{name: "failed", command: "exit 3", expectedStatus: runner.RunStatusExitedError, expectedExitCode: 3},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				// Arrange
+				logger := new(test.MockLogger)
+				emitter := new(test2.MockEventEmitter)
+
+				r := newRunner(t, logger, emitter)
+
+				commandId := "run-state-test"
+
+				emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+				emitter.On("EmitEvent", event.ProcessFinished, mock.MatchedBy(func(state runner.RunState) bool {
+					return state.CommandId == commandId &&
+						state.Status == tc.expectedStatus &&
+						state.ExitCode != nil && *state.ExitCode == tc.expectedExitCode &&
+						state.FinishedAt != nil && !state.FinishedAt.Before(state.StartedAt)
+				})).Return().Once()
+				emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+				logger.On("Info", mock.Anything).Return()
+				logger.On("Error", mock.Anything).Maybe().Return()
+
+				// Act
+				err := r.RunCommand(&commanddomain.Command{
+					Id:               commandId,
+					ProjectId:        commandId,
+					Name:             "Run State Test",
+					Command:          tc.command,
+					WorkingDirectory: validWorkingDirectory(),
+				}, runner.RunOptions{})
+				r.WaitForCommand(commandId)
+
+				// Assert
+				assert.NoError(t, err)
+
+				state := r.GetRunStates()[commandId]
+				assert.Equal(t, tc.expectedStatus, state.Status)
+				assert.False(t, state.IsActive())
+				mock.AssertExpectationsForObjects(t, emitter, logger)
+			})
+		}
+	})
+
+	t.Run("Should report running commands and mark them as killed when stopped", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "killed-run-state-test"
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(commandId)).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, mock.MatchedBy(func(state runner.RunState) bool {
+			return state.CommandId == commandId && state.Status == runner.RunStatusKilled
+		})).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Error", mock.Anything).Maybe().Return()
+
 		err := r.RunCommand(&commanddomain.Command{
 			Id:               commandId,
 			ProjectId:        commandId,
-			Name:             "Error Pattern Test",
-			Command:          "echo 'Starting...' && echo 'ERROR: Something went wrong' && echo 'Done'",
+			Name:             "Killed Run State Test",
+			Command:          "sleep 10",
 			WorkingDirectory: validWorkingDirectory(),
-			Position:         0,
-			ErrorPatterns: []string{
-				"ERROR:",
-				"FATAL:",
-			},
-		}, []string{}, "")
+		}, runner.RunOptions{})
+		assert.NoError(t, err)
+
+		runningState := r.GetRunStates()[commandId]
 
+		// Act
+		err = r.StopRunningCommand(commandId)
 		r.WaitForCommand(commandId)
 
 		// Assert
 		assert.NoError(t, err)
-		assert.Empty(t, r.GetRunningCommands())
-
-		// Verify that CommandErrorDetected was called
+		assert.Equal(t, runner.RunStatusRunning, runningState.Status)
+		assert.Nil(t, runningState.FinishedAt)
+		assert.Equal(t, runner.RunStatusKilled, r.GetRunStates()[commandId].Status)
 		mock.AssertExpectationsForObjects(t, emitter, logger)
 	})
+
+	t.Run("Should report commands that fail to start as exited with error", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "failed-start-run-state-test"
+
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Failed Start Run State Test",
+			Command:          "echo 'never'",
+			WorkingDirectory: "/nonexistent/working/directory",
+		}, runner.RunOptions{})
+
+		// Assert
+		assert.Error(t, err)
+
+		state := r.GetRunStates()[commandId]
+		assert.Equal(t, runner.RunStatusExitedError, state.Status)
+		assert.Nil(t, state.ExitCode)
+		assert.NotNil(t, state.FinishedAt)
+		emitter.AssertNotCalled(t, "EmitEvent", event.ProcessStarted, commandId)
+	})
 }
 
-func infiniteCmd() string {
+func setRestartInitialDelay(t *testing.T, delay time.Duration) {
+	previousDelay := runner.RestartInitialDelay
+	runner.RestartInitialDelay = delay
+	t.Cleanup(func() {
+		runner.RestartInitialDelay = previousDelay
+	})
+}
+
+func TestDefaultRunner_RestartPolicy(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test command relies on a POSIX shell")
+	}
+
+	t.Run("Should restart a failing command with backoff until reaching the max restarts", func(t *testing.T) {
+		// Arrange
+		setRestartInitialDelay(t, 10*time.Millisecond)
+
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "restart-test"
+		givenUp := make(chan struct{})
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return().Times(3)
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return().Times(3)
+		emitter.On("EmitEvent", event.ProcessRestarting, runner.RestartingPayload{
+			Id: commandId, Attempt: 1, MaxAttempts: 2, DelayMs: 10,
+		}).Return().Once()
+		emitter.On("EmitEvent", event.ProcessRestarting, runner.RestartingPayload{
+			Id: commandId, Attempt: 2, MaxAttempts: 2, DelayMs: 20,
+		}).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, map[string]string{
+			"id":   commandId,
+			"line": "Not restarting after 2 attempts",
+		}).Run(func(args mock.Arguments) {
+			close(givenUp)
+		}).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Error", mock.Anything).Return()
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Restart Test",
+			Command:          "exit 1",
+			WorkingDirectory: validWorkingDirectory(),
+			RestartPolicy:    commanddomain.RestartPolicyOnFailure,
+			MaxRestarts:      2,
+		}, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+
+		select {
+		case <-givenUp:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the command was not restarted the expected number of times")
+		}
+
+		assert.Empty(t, r.GetRunningCommands())
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+
+	t.Run("Should not restart a successful command with the on-failure policy", func(t *testing.T) {
+		// Arrange
+		setRestartInitialDelay(t, 10*time.Millisecond)
+
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "no-restart-test"
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return().Once()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "No Restart Test",
+			Command:          "exit 0",
+			WorkingDirectory: validWorkingDirectory(),
+			RestartPolicy:    commanddomain.RestartPolicyOnFailure,
+		}, runner.RunOptions{})
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		emitter.AssertNotCalled(t, "EmitEvent", event.ProcessRestarting, mock.Anything)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+
+	t.Run("Should not restart a command stopped by the user", func(t *testing.T) {
+		// Arrange
+		setRestartInitialDelay(t, 10*time.Millisecond)
+
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "stopped-restart-test"
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return().Once()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(commandId)).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Error", mock.Anything).Maybe().Return()
+
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Stopped Restart Test",
+			Command:          "sleep 10",
+			WorkingDirectory: validWorkingDirectory(),
+			RestartPolicy:    commanddomain.RestartPolicyAlways,
+		}, runner.RunOptions{})
+		assert.NoError(t, err)
+
+		// Act
+		err = r.StopRunningCommand(commandId)
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		time.Sleep(50 * time.Millisecond) // Longer than the restart delay
+		assert.Empty(t, r.GetRunningCommands())
+		emitter.AssertNotCalled(t, "EmitEvent", event.ProcessRestarting, mock.Anything)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+
+	t.Run("Should cancel a pending restart when stopping the command", func(t *testing.T) {
+		// Arrange
+		setRestartInitialDelay(t, time.Hour)
+
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "cancel-restart-test"
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return().Once()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return().Twice()
+		emitter.On("EmitEvent", event.ProcessRestarting, runner.RestartingPayload{
+			Id: commandId, Attempt: 1, MaxAttempts: 0, DelayMs: runner.RestartMaxDelay.Milliseconds(),
+		}).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Cancel Restart Test",
+			Command:          "exit 0",
+			WorkingDirectory: validWorkingDirectory(),
+			RestartPolicy:    commanddomain.RestartPolicyAlways,
+		}, runner.RunOptions{})
+		assert.NoError(t, err)
+		r.WaitForCommand(commandId)
+
+		// Act
+		err = r.StopRunningCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+}
+
+func TestDefaultRunner_ReadinessProbe(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test command relies on a POSIX shell")
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer listener.Close()
+
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/health" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer httpServer.Close()
+
+	testCases := []struct {
+		name    string
+		command string
+		probe   commanddomain.ReadinessProbe
+	}{
+		{
+			name:    "Should emit ready when the tcp port accepts connections",
+			command: "sleep 10",
+			probe:   commanddomain.ReadinessProbe{Type: commanddomain.ReadinessProbeTCP, Target: listener.Addr().String()},
+		},
+		{
+			name:    "Should emit ready when the http url returns 2xx",
+			command: "sleep 10",
+			probe:   commanddomain.ReadinessProbe{Type: commanddomain.ReadinessProbeHTTP, Target: httpServer.URL + "/health"},
+		},
+		{
+			name:    "Should emit ready when a log line matches the pattern",
+			command: "echo 'booting'; echo 'server listening on 8080'; sleep 10",
+			probe:   commanddomain.ReadinessProbe{Type: commanddomain.ReadinessProbeLog, Target: "listening on"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			logger := new(test.MockLogger)
+			emitter := new(test2.MockEventEmitter)
+
+			r := newRunner(t, logger, emitter)
+
+			commandId := "readiness-test"
+			ready := make(chan struct{})
+
+			emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+			emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(commandId)).Return()
+			emitter.On("EmitEvent", event.ProcessReady, commandId).Run(func(args mock.Arguments) {
+				close(ready)
+			}).Return().Once()
+			emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+			emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+			logger.On("Info", mock.Anything).Return()
+			logger.On("Debug", mock.Anything).Maybe().Return()
+			logger.On("Error", mock.Anything).Maybe().Return()
+
+			probe := tc.probe
+
+			// Act
+			err := r.RunCommand(&commanddomain.Command{
+				Id:               commandId,
+				ProjectId:        commandId,
+				Name:             "Readiness Test",
+				Command:          tc.command,
+				WorkingDirectory: validWorkingDirectory(),
+				ReadinessProbe:   &probe,
+			}, runner.RunOptions{})
+			assert.NoError(t, err)
+
+			// Assert
+			select {
+			case <-ready:
+			case <-time.After(5 * time.Second):
+				t.Fatal("the command was not reported as ready")
+			}
+
+			err = r.StopRunningCommand(commandId)
+			assert.NoError(t, err)
+			r.WaitForCommand(commandId)
+			mock.AssertExpectationsForObjects(t, emitter, logger)
+		})
+	}
+
+	t.Run("Should emit a timeout when the probe does not pass in time", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "readiness-timeout-test"
+		timedOut := make(chan struct{})
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, stoppingOf(commandId)).Return()
+		emitter.On("EmitEvent", event.ProcessReadinessTimeout, commandId).Run(func(args mock.Arguments) {
+			close(timedOut)
+		}).Return().Once()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+		emitter.On("EmitEvent", event.NewLogEntry, map[string]string{
+			"id":   commandId,
+			"line": "Readiness probe did not pass after 1s",
+		}).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Error", mock.Anything).Maybe().Return()
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Readiness Timeout Test",
+			Command:          "sleep 10",
+			WorkingDirectory: validWorkingDirectory(),
+			ReadinessProbe: &commanddomain.ReadinessProbe{
+				Type:           commanddomain.ReadinessProbeLog,
+				Target:         "never logged",
+				TimeoutSeconds: 1,
+			},
+		}, runner.RunOptions{})
+		assert.NoError(t, err)
+
+		// Assert
+		select {
+		case <-timedOut:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the readiness timeout was not reported")
+		}
+
+		err = r.StopRunningCommand(commandId)
+		assert.NoError(t, err)
+		r.WaitForCommand(commandId)
+		emitter.AssertNotCalled(t, "EmitEvent", event.ProcessReady, commandId)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+
+	t.Run("Should not emit any readiness event when the process finishes before being ready", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "readiness-finished-test"
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Error", mock.Anything).Maybe().Return()
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Readiness Finished Test",
+			Command:          "exit 1",
+			WorkingDirectory: validWorkingDirectory(),
+			ReadinessProbe: &commanddomain.ReadinessProbe{
+				Type:           commanddomain.ReadinessProbeLog,
+				Target:         "never logged",
+				TimeoutSeconds: 1,
+			},
+		}, runner.RunOptions{})
+		r.WaitForCommand(commandId)
+		time.Sleep(1500 * time.Millisecond) // Longer than the probe timeout
+
+		// Assert
+		assert.NoError(t, err)
+		emitter.AssertNotCalled(t, "EmitEvent", event.ProcessReady, commandId)
+		emitter.AssertNotCalled(t, "EmitEvent", event.ProcessReadinessTimeout, commandId)
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+}
+
+func TestDefaultRunner_RunCommandsWithDependencies(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test commands rely on a POSIX shell")
+	}
+
+	newCommand := func(id, command string) commanddomain.Command {
+		return commanddomain.Command{
+			Id:               id,
+			ProjectId:        id,
+			Name:             id,
+			Command:          command,
+			WorkingDirectory: validWorkingDirectory(),
+		}
+	}
+
+	// arrange mocks the events and returns a channel receiving the ids of the started commands
+	arrange := func(logger *test.MockLogger, emitter *test2.MockEventEmitter) chan string {
+		started := make(chan string, 10)
+
+		emitter.On("EmitEvent", event.ProcessStarted, mock.Anything).Run(func(args mock.Arguments) {
+			started <- args.Get(1).(string)
+		}).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, mock.Anything).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, mock.Anything).Maybe().Return()
+		emitter.On("EmitEvent", event.ProcessReady, mock.Anything).Maybe().Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Maybe().Return()
+		logger.On("Error", mock.Anything).Maybe().Return()
+
+		return started
+	}
+
+	receive := func(t *testing.T, started chan string) string {
+		select {
+		case id := <-started:
+			return id
+		case <-time.After(5 * time.Second):
+			t.Fatal("no command was started")
+			return ""
+		}
+	}
+
+	t.Run("Should start a command once its dependency exited successfully", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		started := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		migrate := newCommand("migrate", "sleep 0.2")
+		server := newCommand("server", "sleep 10")
+
+		// Act
+		err := r.RunCommandsWithDependencies([]commanddomain.Command{migrate, server}, map[string][]runner.Dependency{
+			server.Id: {{CommandId: migrate.Id, Condition: commandgroupdomain.DependencyConditionExitedOk}},
+		}, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, runner.RunStatusWaiting, r.GetRunStates()[server.Id].Status)
+
+		assert.Equal(t, migrate.Id, receive(t, started))
+		assert.Equal(t, server.Id, receive(t, started))
+		assert.Equal(t, runner.RunStatusExitedOk, r.GetRunStates()[migrate.Id].Status)
+
+		err = r.StopRunningCommand(server.Id)
+		assert.NoError(t, err)
+		r.WaitForCommand(server.Id)
+	})
+
+	t.Run("Should start a command once its dependency is ready", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		started := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		database := newCommand("database", "sleep 0.2; echo 'accepting connections'; sleep 10")
+		database.ReadinessProbe = &commanddomain.ReadinessProbe{Type: commanddomain.ReadinessProbeLog, Target: "accepting connections"}
+		api := newCommand("api", "sleep 10")
+
+		// Act
+		err := r.RunCommandsWithDependencies([]commanddomain.Command{database, api}, map[string][]runner.Dependency{
+			api.Id: {{CommandId: database.Id, Condition: commandgroupdomain.DependencyConditionReady}},
+		}, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, database.Id, receive(t, started))
+		assert.Equal(t, api.Id, receive(t, started))
+		assert.Equal(t, runner.ReadinessReady, r.GetRunStates()[database.Id].Readiness)
+		emitter.AssertCalled(t, "EmitEvent", event.ProcessReady, database.Id)
+
+		r.StopAllRunningCommands()
+		r.WaitForCommand(api.Id)
+		r.WaitForCommand(database.Id)
+	})
+
+	t.Run("Should not start the commands depending on a failed command", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		build := newCommand("build", "exit 1")
+		serve := newCommand("serve", "sleep 10")
+		open := newCommand("open", "sleep 10")
+
+		openFinished := make(chan struct{})
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(open.Id)).Run(func(args mock.Arguments) {
+			close(openFinished)
+		}).Return().Once()
+		started := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		// Act
+		err := r.RunCommandsWithDependencies([]commanddomain.Command{build, serve, open}, map[string][]runner.Dependency{
+			serve.Id: {{CommandId: build.Id, Condition: commandgroupdomain.DependencyConditionExitedOk}},
+			open.Id:  {{CommandId: serve.Id, Condition: commandgroupdomain.DependencyConditionRunning}},
+		}, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, build.Id, receive(t, started))
+
+		select {
+		case <-openFinished:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the dependent commands were not reported as finished")
+		}
+
+		runStates := r.GetRunStates()
+		assert.Equal(t, runner.RunStatusExitedError, runStates[serve.Id].Status)
+		assert.Equal(t, runner.RunStatusExitedError, runStates[open.Id].Status)
+
+		emitter.AssertCalled(t, "EmitEvent", event.NewLogEntry, map[string]string{
+			"id":   serve.Id,
+			"line": "Not started because build did not reach the exited-ok condition",
+		})
+		assert.Empty(t, started)
+	})
+
+	t.Run("Should not take the state of a previous run of a dependency into account", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		started := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		migrate := newCommand("migrate", "exit 0")
+		server := newCommand("server", "sleep 10")
+
+		err := r.RunCommand(&migrate, runner.RunOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, migrate.Id, receive(t, started))
+		r.WaitForCommand(migrate.Id)
+		assert.Equal(t, runner.RunStatusExitedOk, r.GetRunStates()[migrate.Id].Status)
+
+		migrate.Command = "sleep 0.2; exit 1"
+
+		// Act
+		err = r.RunCommandsWithDependencies([]commanddomain.Command{migrate, server}, map[string][]runner.Dependency{
+			server.Id: {{CommandId: migrate.Id, Condition: commandgroupdomain.DependencyConditionExitedOk}},
+		}, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, migrate.Id, receive(t, started))
+		r.WaitForCommand(migrate.Id)
+
+		assert.Eventually(t, func() bool {
+			return r.GetRunStates()[server.Id].Status == runner.RunStatusExitedError
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Empty(t, started)
+	})
+
+	t.Run("Should cancel a waiting command when stopping it", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		started := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		worker := newCommand("worker", "sleep 10")
+		report := newCommand("report", "sleep 10")
+
+		err := r.RunCommandsWithDependencies([]commanddomain.Command{worker, report}, map[string][]runner.Dependency{
+			report.Id: {{CommandId: worker.Id, Condition: commandgroupdomain.DependencyConditionExitedOk}},
+		}, runner.RunOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, worker.Id, receive(t, started))
+
+		// Act
+		err = r.StopRunningCommands([]commanddomain.Command{report, worker})
+		assert.NoError(t, err)
+		r.WaitForCommand(worker.Id)
+
+		// Assert
+		runStates := r.GetRunStates()
+		assert.Equal(t, runner.RunStatusKilled, runStates[report.Id].Status)
+		assert.Equal(t, runner.RunStatusKilled, runStates[worker.Id].Status)
+
+		// Give the waiting goroutine a chance to wrongly start the command
+		time.Sleep(100 * time.Millisecond)
+		assert.Empty(t, started)
+		emitter.AssertCalled(t, "EmitEvent", event.ProcessFinished, runStateOf(report.Id))
+	})
+}
+
+func TestDefaultRunner_RunPipeline(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test commands rely on a POSIX shell")
+	}
+
+	newCommand := func(id, command string) commanddomain.Command {
+		return commanddomain.Command{
+			Id:               id,
+			ProjectId:        id,
+			Name:             id,
+			Command:          command,
+			WorkingDirectory: validWorkingDirectory(),
+		}
+	}
+
+	// arrange mocks the events and returns channels receiving the started commands and the finished pipeline
+	arrange := func(logger *test.MockLogger, emitter *test2.MockEventEmitter) (chan string, chan runner.PipelineState) {
+		started := make(chan string, 10)
+		finished := make(chan runner.PipelineState, 1)
+
+		emitter.On("EmitEvent", event.ProcessStarted, mock.Anything).Run(func(args mock.Arguments) {
+			started <- args.Get(1).(string)
+		}).Return()
+		emitter.On("EmitEvent", event.PipelineFinished, mock.Anything).Run(func(args mock.Arguments) {
+			finished <- args.Get(1).(runner.PipelineState)
+		}).Return().Once()
+		emitter.On("EmitEvent", event.PipelineStarted, mock.Anything).Return().Once()
+		emitter.On("EmitEvent", event.PipelineStepStarted, mock.Anything).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, mock.Anything).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, mock.Anything).Maybe().Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Maybe().Return()
+		logger.On("Error", mock.Anything).Maybe().Return()
+
+		return started, finished
+	}
+
+	receive := func(t *testing.T, finished chan runner.PipelineState) runner.PipelineState {
+		select {
+		case state := <-finished:
+			return state
+		case <-time.After(5 * time.Second):
+			t.Fatal("the pipeline did not finish")
+			return runner.PipelineState{}
+		}
+	}
+
+	startedIds := func(started chan string) []string {
+		ids := make([]string, 0)
+		for len(started) > 0 {
+			ids = append(ids, <-started)
+		}
+		return ids
+	}
+
+	t.Run("Should run the commands one after another", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		started, finished := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		lint := newCommand("lint", "sleep 0.1")
+		build := newCommand("build", "exit 0")
+
+		// Act
+		err := r.RunPipeline("pipeline", []commanddomain.Command{lint, build}, false, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+
+		state := receive(t, finished)
+		assert.Equal(t, runner.PipelineStatusSucceeded, state.Status)
+		assert.Equal(t, 2, state.CurrentStep)
+		assert.Equal(t, 2, state.TotalSteps)
+		assert.Empty(t, state.FailedCommandIds)
+		assert.NotNil(t, state.FinishedAt)
+		assert.Equal(t, []string{lint.Id, build.Id}, startedIds(started))
+		assert.Equal(t, state, r.GetPipelineStates()["pipeline"])
+
+		emitter.AssertCalled(t, "EmitEvent", event.PipelineStepStarted, mock.MatchedBy(func(state runner.PipelineState) bool {
+			return state.CurrentCommandId == build.Id && state.CurrentStep == 2
+		}))
+	})
+
+	t.Run("Should stop at the first failing command", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		started, finished := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		lint := newCommand("lint", "exit 1")
+		build := newCommand("build", "exit 0")
+
+		// Act
+		err := r.RunPipeline("pipeline", []commanddomain.Command{lint, build}, false, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+
+		state := receive(t, finished)
+		assert.Equal(t, runner.PipelineStatusFailed, state.Status)
+		assert.Equal(t, 1, state.CurrentStep)
+		assert.Equal(t, []string{lint.Id}, state.FailedCommandIds)
+		assert.Equal(t, []string{lint.Id}, startedIds(started))
+	})
+
+	t.Run("Should run the next commands after a failure when continuing on failure", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		started, finished := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		lint := newCommand("lint", "exit 1")
+		unitTests := newCommand("test", "exit 2")
+		build := newCommand("build", "exit 0")
+
+		// Act
+		err := r.RunPipeline("pipeline", []commanddomain.Command{lint, unitTests, build}, true, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+
+		state := receive(t, finished)
+		assert.Equal(t, runner.PipelineStatusFailed, state.Status)
+		assert.Equal(t, 3, state.CurrentStep)
+		assert.Equal(t, []string{lint.Id, unitTests.Id}, state.FailedCommandIds)
+		assert.Equal(t, []string{lint.Id, unitTests.Id, build.Id}, startedIds(started))
+	})
+
+	t.Run("Should cancel the pipeline when it is stopped", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		started, finished := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		serve := newCommand("serve", "sleep 10")
+		build := newCommand("build", "exit 0")
+
+		err := r.RunPipeline("pipeline", []commanddomain.Command{serve, build}, true, runner.RunOptions{})
+		assert.NoError(t, err)
+
+		select {
+		case <-started:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the first command was not started")
+		}
+
+		// Act
+		r.StopPipeline("pipeline")
+		err = r.StopRunningCommand(serve.Id)
+		assert.NoError(t, err)
+
+		// Assert
+		state := receive(t, finished)
+		assert.Equal(t, runner.PipelineStatusCancelled, state.Status)
+		assert.Equal(t, []string{serve.Id}, state.FailedCommandIds)
+		assert.Empty(t, startedIds(started))
+	})
+}
+
+func TestDefaultRunner_WriteToCommand(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test command relies on a POSIX shell")
+	}
+
+	t.Run("Should send the data to the command standard input", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "stdin-test"
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(data map[string]string) bool {
+			return strings.Contains(data["line"], "read answer")
+		})).Return()
+		mockEmitterLogEntry(emitter, commandId, "received yes")
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Return()
+
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Stdin Test",
+			Command:          "read answer && echo \"received $answer\"",
+			WorkingDirectory: validWorkingDirectory(),
+			Interactive:      true,
+		}, runner.RunOptions{})
+		assert.NoError(t, err)
+
+		// Act
+		err = r.WriteToCommand(commandId, "yes\n")
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, r.GetRunningCommands())
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+
+	t.Run("Should return an error if the command is not running", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		// Act
+		err := r.WriteToCommand("not-running", "yes\n")
+
+		// Assert
+		assert.ErrorIs(t, err, runner.ErrCommandNotRunning)
+	})
+
+	t.Run("Should close the standard input of commands that are not interactive", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+		events := orderedEvents(logger, emitter)
+
+		commandId := "no-stdin-test"
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "No Stdin Test",
+			Command:          "read answer || echo 'no input'; sleep 5",
+			WorkingDirectory: validWorkingDirectory(),
+		}, runner.RunOptions{})
+		assert.NoError(t, err)
+		waitForEvent(t, events, "no input")
+
+		writeErr := r.WriteToCommand(commandId, "yes\n")
+		stopCommands(r, commandId)
+
+		// Assert
+		assert.ErrorIs(t, writeErr, runner.ErrInputNotAccepted)
+	})
+}
+
+func TestDefaultRunner_TerminalMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("Terminal mode is not supported on Windows")
+	}
+
+	t.Run("Should run the command attached to a terminal and stream raw output", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "terminal-test"
+
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return()
+		// Starting line
+		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(data map[string]string) bool {
+			return data["raw"] == "" && strings.Contains(data["line"], "test -t 1")
+		})).Return()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.MatchedBy(func(data map[string]string) bool {
+			return data["id"] == commandId && data["raw"] == "true" && strings.Contains(data["line"], "is a terminal")
+		})).Return()
+
+		logger.On("Info", mock.Anything).Return()
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Terminal Test",
+			Command:          "test -t 1 && echo 'is a terminal'",
+			WorkingDirectory: validWorkingDirectory(),
+			TerminalMode:     true,
+		}, runner.RunOptions{})
+		r.WaitForCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, r.GetRunningCommands())
+		mock.AssertExpectationsForObjects(t, emitter, logger)
+	})
+
+	t.Run("Should resize the terminal of a running command", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "terminal-resize-test"
+
+		emitter.On("EmitEvent", mock.Anything, mock.Anything).Return()
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Return()
+		logger.On("Error", mock.Anything).Maybe().Return()
+
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Terminal Resize Test",
+			Command:          infiniteCmd(),
+			WorkingDirectory: validWorkingDirectory(),
+			TerminalMode:     true,
+		}, runner.RunOptions{})
+		assert.NoError(t, err)
+
+		// Act
+		err = r.ResizeTerminal(commandId, runner.TerminalSize{Cols: 120, Rows: 40})
+
+		// Assert
+		assert.NoError(t, err)
+
+		// Cleanup
+		err = r.StopRunningCommand(commandId)
+		assert.NoError(t, err)
+		r.WaitForCommand(commandId)
+	})
+
+	t.Run("Should not fail when resizing a command that is not running", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		// Act
+		err := r.ResizeTerminal("not-running", runner.TerminalSize{Cols: 120, Rows: 40})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
+
+// startOrphanedProcess starts a process in its own group as a previous Gomander would have, reaping it once it exits.
+func startOrphanedProcess(t *testing.T, commandId string) commandrundomain.CommandRun {
+	cmd := exec.Command("sleep", "30")
+	runner.SetProcAttributes(cmd)
+	assert.NoError(t, cmd.Start())
+	go func() { _ = cmd.Wait() }()
+	t.Cleanup(func() { _ = cmd.Process.Kill() })
+
+	return commandrundomain.CommandRun{
+		Id:               "run-" + commandId,
+		CommandId:        commandId,
+		Status:           string(runner.RunStatusRunning),
+		StartedAt:        time.Now(),
+		Pid:              cmd.Process.Pid,
+		Pgid:             cmd.Process.Pid,
+		OwnerPid:         999999,
+		ProcessStartTime: runner.ProcessStartTime(cmd.Process.Pid),
+	}
+}
+
+func TestDefaultRunner_OrphanedProcesses(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The orphaned process is started with POSIX process groups")
+	}
+
+	previousInterval := runner.OrphanCheckInterval
+	runner.OrphanCheckInterval = 20 * time.Millisecond
+	t.Cleanup(func() { runner.OrphanCheckInterval = previousInterval })
+
+	t.Run("Should adopt an orphaned process and record it as killed once stopped", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		commandRunRepository := new(test3.MockCommandRunRepository)
+		updatedRuns := make(chan commandrundomain.CommandRun, 2)
+		commandRunRepository.On("Update", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			updatedRuns <- *args.Get(0).(*commandrundomain.CommandRun)
+		})
+
+		r := runner.NewDefaultRunner(logger, emitter, logstore.NewDefaultLogStore(t.TempDir()), commandRunRepository)
+
+		commandId := "orphan"
+		run := startOrphanedProcess(t, commandId)
+
+		finished := make(chan runner.RunState, 1)
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Return()
+		emitter.On("EmitEvent", event.ProcessStarted, commandId).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return().Run(func(args mock.Arguments) {
+			finished <- args.Get(1).(runner.RunState)
+		})
+		logger.On("Info", mock.Anything).Return()
+
+		// Act
+		r.AddOrphanedProcess(run)
+		orphanedStatus := r.GetRunStates()[commandId].Status
+
+		err := r.AdoptOrphanedProcess(commandId)
+		adoptedStatus := r.GetRunStates()[commandId].Status
+
+		stopErr := r.StopRunningCommand(commandId)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, stopErr)
+		assert.Equal(t, runner.RunStatusOrphaned, orphanedStatus)
+		assert.Equal(t, runner.RunStatusRunning, adoptedStatus)
+
+		select {
+		case state := <-finished:
+			assert.Equal(t, runner.RunStatusKilled, state.Status)
+		case <-time.After(2 * time.Second):
+			t.Fatal("the orphaned process was not reported as finished")
+		}
+
+		adoptedRun := <-updatedRuns
+		assert.Equal(t, os.Getpid(), adoptedRun.OwnerPid)
+
+		select {
+		case finishedRun := <-updatedRuns:
+			assert.Equal(t, string(runner.RunStatusKilled), finishedRun.Status)
+			assert.NotNil(t, finishedRun.FinishedAt)
+		case <-time.After(2 * time.Second):
+			t.Fatal("the end of the orphaned run was not recorded")
+		}
+		mock.AssertExpectationsForObjects(t, emitter)
+	})
+
+	t.Run("Should not run a command while its orphaned process is running", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "orphan"
+		finished := make(chan struct{})
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return().Run(func(mock.Arguments) { close(finished) })
+		logger.On("Info", mock.Anything).Return()
+
+		r.AddOrphanedProcess(startOrphanedProcess(t, commandId))
+
+		// Act
+		err := r.RunCommand(&commanddomain.Command{
+			Id:               commandId,
+			ProjectId:        commandId,
+			Name:             "Orphan",
+			Command:          infiniteCmd(),
+			WorkingDirectory: validWorkingDirectory(),
+		}, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, r.GetRunningCommands())
+		assert.Equal(t, runner.RunStatusOrphaned, r.GetRunStates()[commandId].Status)
+
+		// Cleanup
+		assert.NoError(t, r.StopRunningCommand(commandId))
+		<-finished
+	})
+
+	t.Run("Should leave alone a process reusing the pid of the orphaned process", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commandId := "orphan"
+		run := startOrphanedProcess(t, commandId)
+		if run.ProcessStartTime == 0 {
+			t.Skip("The start time of the processes is not read on this system")
+		}
+		run.ProcessStartTime--
+
+		finished := make(chan runner.RunState, 1)
+		emitter.On("EmitEvent", event.ProcessFinished, runStateOf(commandId)).Return().Run(func(args mock.Arguments) {
+			finished <- args.Get(1).(runner.RunState)
+		})
+		logger.On("Info", mock.Anything).Return()
+
+		// Act
+		r.AddOrphanedProcess(run)
+
+		// Assert
+		select {
+		case state := <-finished:
+			assert.Equal(t, runner.RunStatusExitedError, state.Status)
+		case <-time.After(2 * time.Second):
+			t.Fatal("the orphaned process was not reported as finished")
+		}
+		assert.True(t, runner.IsProcessGroupRunning(run.Pgid))
+	})
+
+	t.Run("Should fail to adopt a command without an orphaned process", func(t *testing.T) {
+		// Arrange
+		r := newRunner(t, new(test.MockLogger), new(test2.MockEventEmitter))
+
+		// Act
+		err := r.AdoptOrphanedProcess("not-orphaned")
+
+		// Assert
+		assert.ErrorIs(t, err, runner.ErrNotOrphaned)
+	})
+}
+
+func TestDefaultRunner_StopOptions(t *testing.T) {
 	if runtime.GOOS == "windows" {
-		return "ping -t 127.0.0.1"
+		t.Skip("The test commands rely on POSIX signals")
 	}
-	return "ping 127.0.0.1"
+
+	// arrange mocks the events and returns channels receiving the log lines and the stopping event of the command
+	arrange := func(logger *test.MockLogger, emitter *test2.MockEventEmitter) (chan string, chan runner.StoppingPayload) {
+		lines := make(chan string, 100)
+		stopping := make(chan runner.StoppingPayload, 1)
+
+		emitter.On("EmitEvent", event.ProcessStarted, mock.Anything).Return()
+		emitter.On("EmitEvent", event.ProcessFinished, mock.Anything).Return()
+		emitter.On("EmitEvent", event.ProcessStopping, mock.Anything).Run(func(args mock.Arguments) {
+			stopping <- args.Get(1).(runner.StoppingPayload)
+		}).Return().Once()
+		emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Run(func(args mock.Arguments) {
+			lines <- args.Get(1).(map[string]string)["line"]
+		}).Return()
+
+		logger.On("Info", mock.Anything).Return()
+		logger.On("Debug", mock.Anything).Maybe().Return()
+		logger.On("Error", mock.Anything).Maybe().Return()
+
+		return lines, stopping
+	}
+
+	waitForLine := func(t *testing.T, lines chan string, expected string) {
+		timeout := time.After(5 * time.Second)
+		for {
+			select {
+			case line := <-lines:
+				if line == expected {
+					return
+				}
+			case <-timeout:
+				t.Fatalf("the line %q was not logged", expected)
+			}
+		}
+	}
+
+	t.Run("Should stop the command with its stop signal", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		lines, stopping := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		command := &commanddomain.Command{
+			Id:               "signal",
+			Name:             "Signal",
+			Command:          "trap 'echo interrupted; exit 0' INT; echo ready; while true; do sleep 0.1; done",
+			WorkingDirectory: validWorkingDirectory(),
+			StopSignal:       commanddomain.StopSignalInterrupt,
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForLine(t, lines, "ready")
+
+		// Act
+		err := r.StopRunningCommand(command.Id)
+		r.WaitForCommand(command.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		waitForLine(t, lines, "interrupted")
+		assert.Equal(t, runner.StoppingPayload{Id: command.Id, Signal: "SIGINT", TimeoutMs: 5000}, <-stopping)
+		assert.Equal(t, runner.RunStatusKilled, r.GetRunStates()[command.Id].Status)
+	})
+
+	t.Run("Should kill the command when it does not exit within its grace period", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		lines, stopping := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		command := &commanddomain.Command{
+			Id:                 "stubborn",
+			Name:               "Stubborn",
+			Command:            "trap '' TERM; echo ready; while true; do sleep 0.1; done",
+			WorkingDirectory:   validWorkingDirectory(),
+			StopTimeoutSeconds: 1,
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForLine(t, lines, "ready")
+
+		// Act
+		stoppedAt := time.Now()
+		err := r.StopRunningCommand(command.Id)
+		r.WaitForCommand(command.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(stoppedAt), time.Second)
+		waitForLine(t, lines, "Not stopped after 1s, killing it")
+		assert.Equal(t, runner.StoppingPayload{Id: command.Id, Signal: "SIGTERM", TimeoutMs: 1000}, <-stopping)
+		assert.Equal(t, runner.RunStatusKilled, r.GetRunStates()[command.Id].Status)
+	})
+
+	t.Run("Should close the output of a killed command that is still held by processes outside its group", func(t *testing.T) {
+		if _, err := exec.LookPath("setsid"); err != nil {
+			t.Skip("setsid is not available")
+		}
+
+		// Arrange
+		defer func(timeout time.Duration) { runner.KillTimeout = timeout }(runner.KillTimeout)
+		runner.KillTimeout = 200 * time.Millisecond
+
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		lines, _ := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		command := &commanddomain.Command{
+			Id:                 "escaped",
+			Name:               "Escaped",
+			Command:            "trap '' TERM; setsid sleep 3 & echo ready; while true; do sleep 0.1; done",
+			WorkingDirectory:   validWorkingDirectory(),
+			StopTimeoutSeconds: 1,
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForLine(t, lines, "ready")
+
+		// Act
+		stoppedAt := time.Now()
+		err := r.StopRunningCommand(command.Id)
+		r.WaitForCommand(command.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(stoppedAt), 3*time.Second)
+		waitForLine(t, lines, "Output still open after killing it, closing it")
+		assert.Equal(t, runner.RunStatusKilled, r.GetRunStates()[command.Id].Status)
+	})
+
+	t.Run("Should run the stop command instead of sending the stop signal", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		lines, stopping := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		command := &commanddomain.Command{
+			Id:                 "compose",
+			Name:               "Compose",
+			Command:            "trap '' TERM; echo ready; while [ ! -f stopped ]; do sleep 0.1; done",
+			WorkingDirectory:   t.TempDir(),
+			StopCommand:        "touch stopped && echo stopping",
+			StopTimeoutSeconds: 5,
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForLine(t, lines, "ready")
+
+		// Act
+		stoppedAt := time.Now()
+		err := r.StopRunningCommand(command.Id)
+		r.WaitForCommand(command.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Less(t, time.Since(stoppedAt), 5*time.Second)
+		waitForLine(t, lines, "Running the stop command: touch stopped && echo stopping")
+		waitForLine(t, lines, "stopping")
+		assert.Equal(t, runner.StoppingPayload{Id: command.Id, StopCommand: command.StopCommand, TimeoutMs: 5000}, <-stopping)
+	})
+
+	t.Run("Should send the stop signal when the stop command fails", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		lines, _ := arrange(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		command := &commanddomain.Command{
+			Id:               "failing-stop",
+			Name:             "Failing Stop",
+			Command:          "echo ready; sleep 10",
+			WorkingDirectory: validWorkingDirectory(),
+			StopCommand:      "exit 3",
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForLine(t, lines, "ready")
+
+		// Act
+		err := r.StopRunningCommand(command.Id)
+		r.WaitForCommand(command.Id)
+
+		// Assert
+		assert.NoError(t, err)
+		waitForLine(t, lines, "Stop command failed: exit status 3, sending SIGTERM")
+		assert.Equal(t, runner.RunStatusKilled, r.GetRunStates()[command.Id].Status)
+	})
+}
+
+func TestDefaultRunner_Restart(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test commands rely on POSIX signals")
+	}
+
+	t.Run("Should start the new process only once the old one exited", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		events := orderedEvents(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		command := &commanddomain.Command{
+			Id:               "slow-exit",
+			Name:             "Slow Exit",
+			Command:          "trap 'sleep 0.3; echo cleaned up; exit 0' TERM; echo ready; while true; do sleep 0.1; done",
+			WorkingDirectory: validWorkingDirectory(),
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForEvent(t, events, "ready")
+
+		// Act
+		err := r.RestartCommand(command, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		received := waitForEvent(t, events, "ready")
+		assert.Equal(t, []string{"cleaned up", "finished", "started"}, onlyValues(received, "cleaned up", "finished", "started"))
+		assert.Equal(t, runner.RunStatusRunning, r.GetRunStates()[command.Id].Status)
+
+		stopCommands(r, command.Id)
+	})
+
+	t.Run("Should send SIGHUP to a command that reloads on restart", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		events := orderedEvents(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		command := &commanddomain.Command{
+			Id:               "reload",
+			Name:             "Reload",
+			Command:          "trap 'echo reloaded' HUP; echo ready; while true; do sleep 0.1; done",
+			WorkingDirectory: validWorkingDirectory(),
+			RestartMode:      commanddomain.RestartModeReload,
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForEvent(t, events, "ready")
+
+		// Act
+		err := r.RestartCommand(command, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		received := waitForEvent(t, events, "reloaded")
+		assert.Equal(t, []string{"Reloading with SIGHUP", "reloaded"}, onlyValues(received, "Reloading with SIGHUP", "reloaded", "started", "finished"))
+		assert.Equal(t, runner.RunStatusRunning, r.GetRunStates()[command.Id].Status)
+
+		stopCommands(r, command.Id)
+	})
+
+	t.Run("Should run a command that is not running", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		events := orderedEvents(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		command := &commanddomain.Command{
+			Id:               "idle",
+			Name:             "Idle",
+			Command:          "echo ready; sleep 10",
+			WorkingDirectory: validWorkingDirectory(),
+			RestartMode:      commanddomain.RestartModeReload,
+		}
+
+		// Act
+		err := r.RestartCommand(command, runner.RunOptions{})
+
+		// Assert
+		assert.NoError(t, err)
+		waitForEvent(t, events, "ready")
+		assert.Equal(t, runner.RunStatusRunning, r.GetRunStates()[command.Id].Status)
+
+		stopCommands(r, command.Id)
+	})
+
+	t.Run("Should run the group again once all its commands exited", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		events := orderedEvents(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		commands := []commanddomain.Command{
+			{Id: "api", Name: "API", Command: "echo api; sleep 10", WorkingDirectory: validWorkingDirectory()},
+			{Id: "database", Name: "Database", Command: "trap 'sleep 0.3; exit 0' TERM; echo database; while true; do sleep 0.1; done", WorkingDirectory: validWorkingDirectory()},
+		}
+		assert.NoError(t, r.RunCommands(commands, runner.RunOptions{}))
+		// Their outputs come in any order
+		if received := waitForEvent(t, events, "api"); !slices.Contains(received, "database") {
+			waitForEvent(t, events, "database")
+		}
+
+		var statesAtStart map[string]runner.RunState
+
+		// Act
+		err := r.RestartCommandGroup("group", commands, func() error {
+			statesAtStart = r.GetRunStates()
+			return r.RunCommands(commands, runner.RunOptions{})
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, runner.RunStatusKilled, statesAtStart["api"].Status)
+		assert.Equal(t, runner.RunStatusKilled, statesAtStart["database"].Status)
+		assert.Equal(t, runner.RunStatusRunning, r.GetRunStates()["api"].Status)
+		assert.Equal(t, runner.RunStatusRunning, r.GetRunStates()["database"].Status)
+
+		stopCommands(r, "api", "database")
+	})
+}
+
+func TestDefaultRunner_FileWatch(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The test commands rely on a POSIX shell")
+	}
+
+	writeFiles := func(t *testing.T, names ...string) {
+		for _, name := range names {
+			assert.NoError(t, os.MkdirAll(filepath.Dir(name), 0755))
+			assert.NoError(t, os.WriteFile(name, []byte(time.Now().String()), 0644))
+		}
+	}
+
+	// writeFilesUntil rewrites the files until the expected event is received, as the files are only
+	// watched once the watch is set up in the background
+	writeFilesUntil := func(t *testing.T, events chan string, expected string, names ...string) []string {
+		received := make([]string, 0)
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+		timeout := time.After(5 * time.Second)
+
+		writeFiles(t, names...)
+		for {
+			select {
+			case e := <-events:
+				received = append(received, e)
+				if e == expected {
+					return received
+				}
+			case <-ticker.C:
+				writeFiles(t, names...)
+			case <-timeout:
+				t.Fatalf("%q was not received, got %q", expected, received)
+			}
+		}
+	}
+
+	t.Run("Should restart the command when a watched file changes", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		events := orderedEvents(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		directory := t.TempDir()
+		command := &commanddomain.Command{
+			Id:               "watched",
+			Name:             "Watched",
+			Command:          "echo ready; sleep 10",
+			WorkingDirectory: directory,
+			FileWatch:        &commanddomain.FileWatch{Patterns: []string{"src/**/*.go"}, DebounceMs: 50},
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForEvent(t, events, "ready")
+
+		// Act
+		received := writeFilesUntil(t, events, "Restarting after changes to src/server/main.go", filepath.Join(directory, "src", "server", "main.go"))
+
+		// Assert
+		received = append(received, waitForEvent(t, events, "ready")...)
+		assert.Equal(t,
+			[]string{"Restarting after changes to src/server/main.go", "finished", "started"},
+			onlyValues(received, "Restarting after changes to src/server/main.go", "finished", "started"),
+		)
+		assert.Equal(t, runner.RunStatusRunning, r.GetRunStates()[command.Id].Status)
+
+		stopCommands(r, command.Id)
+	})
+
+	t.Run("Should not restart the command for ignored or unmatched files", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		events := orderedEvents(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		directory := t.TempDir()
+		writeFiles(t, filepath.Join(directory, "node_modules", "lib", "index.py"))
+		command := &commanddomain.Command{
+			Id:               "script",
+			Name:             "Script",
+			Command:          "echo ready; sleep 10",
+			WorkingDirectory: directory,
+			FileWatch:        &commanddomain.FileWatch{Patterns: []string{"*.py"}, Ignore: []string{"build"}, DebounceMs: 50},
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForEvent(t, events, "ready")
+
+		// Act
+		// The debounced restart reports the first matching change, so the ignored files are written first
+		received := writeFilesUntil(t, events, "Restarting after changes to app/main.py",
+			filepath.Join(directory, "node_modules", "lib", "index.py"),
+			filepath.Join(directory, "build", "main.py"),
+			filepath.Join(directory, "notes.txt"),
+			filepath.Join(directory, "app", "main.py"),
+		)
+
+		// Assert
+		assert.Equal(t,
+			[]string{"Restarting after changes to app/main.py"},
+			slices.DeleteFunc(received, func(e string) bool { return !strings.HasPrefix(e, "Restarting") }),
+		)
+		waitForEvent(t, events, "ready")
+
+		stopCommands(r, command.Id)
+	})
+
+	t.Run("Should run again a command that exited when a watched file changes", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		events := orderedEvents(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		directory := t.TempDir()
+		command := &commanddomain.Command{
+			Id:               "build",
+			Name:             "Build",
+			Command:          "echo built",
+			WorkingDirectory: directory,
+			FileWatch:        &commanddomain.FileWatch{Patterns: []string{"*.go"}, DebounceMs: 50},
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForEvent(t, events, "finished")
+
+		// Act
+		writeFilesUntil(t, events, "Restarting after changes to main.go", filepath.Join(directory, "main.go"))
+
+		// Assert
+		waitForEvent(t, events, "built")
+		waitForEvent(t, events, "finished")
+
+		stopCommands(r, command.Id)
+	})
+
+	t.Run("Should stop watching the files once the command is stopped", func(t *testing.T) {
+		// Arrange
+		logger := new(test.MockLogger)
+		emitter := new(test2.MockEventEmitter)
+		events := orderedEvents(logger, emitter)
+
+		r := newRunner(t, logger, emitter)
+
+		directory := t.TempDir()
+		command := &commanddomain.Command{
+			Id:               "stopped",
+			Name:             "Stopped",
+			Command:          "echo ready; sleep 10",
+			WorkingDirectory: directory,
+			FileWatch:        &commanddomain.FileWatch{Patterns: []string{"*.go"}, DebounceMs: 50},
+		}
+		assert.NoError(t, r.RunCommand(command, runner.RunOptions{}))
+		waitForEvent(t, events, "ready")
+		stopCommands(r, command.Id)
+		waitForEvent(t, events, "finished")
+
+		// Act
+		writeFiles(t, filepath.Join(directory, "main.go"))
+
+		// Assert
+		time.Sleep(300 * time.Millisecond)
+		assert.Empty(t, events)
+		assert.Equal(t, runner.RunStatusKilled, r.GetRunStates()[command.Id].Status)
+	})
+}
+
+// stopCommands stops the commands and waits for their processes to exit.
+func stopCommands(r *runner.DefaultRunner, ids ...string) {
+	for _, id := range ids {
+		_ = r.StopRunningCommand(id)
+		r.WaitForCommand(id)
+	}
+}
+
+// orderedEvents mocks the events and returns a channel receiving them in order: the log lines, and "started" or
+// "finished" for the processes.
+func orderedEvents(logger *test.MockLogger, emitter *test2.MockEventEmitter) chan string {
+	events := make(chan string, 200)
+
+	emitter.On("EmitEvent", event.ProcessStarted, mock.Anything).Run(func(mock.Arguments) {
+		events <- "started"
+	}).Return()
+	emitter.On("EmitEvent", event.ProcessFinished, mock.Anything).Run(func(mock.Arguments) {
+		events <- "finished"
+	}).Return()
+	emitter.On("EmitEvent", event.ProcessStopping, mock.Anything).Return().Maybe()
+	emitter.On("EmitEvent", event.NewLogEntry, mock.Anything).Run(func(args mock.Arguments) {
+		events <- args.Get(1).(map[string]string)["line"]
+	}).Return()
+
+	logger.On("Info", mock.Anything).Return()
+	logger.On("Debug", mock.Anything).Maybe().Return()
+	logger.On("Error", mock.Anything).Maybe().Return()
+
+	return events
+}
+
+// waitForEvent returns the events received until the expected one, included.
+func waitForEvent(t *testing.T, events chan string, expected string) []string {
+	received := make([]string, 0)
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case e := <-events:
+			received = append(received, e)
+			if e == expected {
+				return received
+			}
+		case <-timeout:
+			t.Fatalf("%q was not received, got %q", expected, received)
+		}
+	}
+}
+
+// onlyValues returns the values that are among the kept ones, keeping their order
+func onlyValues(values []string, kept ...string) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if slices.Contains(kept, value) {
+			result = append(result, value)
+		}
+	}
+	return result
 }
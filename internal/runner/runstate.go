@@ -0,0 +1,79 @@
+package runner
+
+import (
+	"os"
+	"time"
+)
+
+type RunStatus string
+
+const (
+	RunStatusWaiting     RunStatus = "waiting"
+	RunStatusStarting    RunStatus = "starting"
+	RunStatusRunning     RunStatus = "running"
+	RunStatusStopping    RunStatus = "stopping"
+	RunStatusExitedOk    RunStatus = "exited-ok"
+	RunStatusExitedError RunStatus = "exited-error"
+	RunStatusKilled      RunStatus = "killed"
+	// RunStatusOrphaned is a process left running by a previous Gomander, until it's adopted or stopped
+	RunStatusOrphaned RunStatus = "orphaned"
+)
+
+// Readiness is only tracked for commands with a readiness probe.
+type Readiness string
+
+const (
+	ReadinessPending  Readiness = "pending"
+	ReadinessReady    Readiness = "ready"
+	ReadinessTimedOut Readiness = "timed-out"
+)
+
+// RunState describes the current or last run of a command.
+type RunState struct {
+	CommandId  string     `json:"commandId"`
+	Status     RunStatus  `json:"status"`
+	ExitCode   *int       `json:"exitCode,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	DurationMs int64      `json:"durationMs"`
+	Readiness  Readiness  `json:"readiness,omitempty"`
+}
+
+// IsActive reports whether the command process is alive.
+func (s RunState) IsActive() bool {
+	return s.Status == RunStatusStarting || s.Status == RunStatusRunning || s.Status == RunStatusStopping ||
+		s.Status == RunStatusOrphaned
+}
+
+func (s RunState) finish(status RunStatus, exitCode *int) RunState {
+	finishedAt := time.Now()
+
+	s.Status = status
+	s.ExitCode = exitCode
+	s.FinishedAt = &finishedAt
+	s.DurationMs = finishedAt.Sub(s.StartedAt).Milliseconds()
+
+	return s
+}
+
+// exitStatus classifies how a process ended. Processes stopped on purpose or terminated by a signal are killed.
+func exitStatus(processState *os.ProcessState, stopRequested bool) (RunStatus, *int) {
+	if processState == nil {
+		if stopRequested {
+			return RunStatusKilled, nil
+		}
+		return RunStatusExitedError, nil
+	}
+
+	exitCode := processState.ExitCode()
+	switch {
+	case exitCode == -1:
+		return RunStatusKilled, nil
+	case stopRequested:
+		return RunStatusKilled, &exitCode
+	case exitCode == 0:
+		return RunStatusExitedOk, &exitCode
+	default:
+		return RunStatusExitedError, &exitCode
+	}
+}
@@ -0,0 +1,12 @@
+package runner
+
+import "golang.org/x/sys/unix"
+
+// ProcessStartTime returns when the process started, in nanoseconds since the epoch, or 0 when it doesn't exist.
+func ProcessStartTime(pid int) int64 {
+	info, err := unix.SysctlKinfoProc("kern.proc.pid", pid)
+	if err != nil || info.Proc.P_pid != int32(pid) {
+		return 0
+	}
+	return info.Proc.P_starttime.Nano()
+}
@@ -0,0 +1,32 @@
+package runner
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ProcessStartTime returns when the process started, in clock ticks since boot, or 0 when it doesn't exist.
+func ProcessStartTime(pid int) int64 {
+	stat, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return 0
+	}
+
+	// The name of the process can contain spaces and parentheses, so the fields are read after the last one
+	nameEnd := strings.LastIndexByte(string(stat), ')')
+	if nameEnd < 0 {
+		return 0
+	}
+
+	// The start time is the 22nd field, and the fields after the name start with the 3rd
+	fields := strings.Fields(string(stat[nameEnd+1:]))
+	if len(fields) < 20 {
+		return 0
+	}
+	startTime, err := strconv.ParseInt(fields[19], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return startTime
+}
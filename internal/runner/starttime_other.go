@@ -0,0 +1,8 @@
+//go:build !linux && !darwin && !windows
+
+package runner
+
+// ProcessStartTime returns 0, as the start time of the processes is not read on this system.
+func ProcessStartTime(_ int) int64 {
+	return 0
+}
@@ -0,0 +1,23 @@
+package runner
+
+import "syscall"
+
+// ProcessStartTime returns when the process was created, in nanoseconds since the epoch, or 0 when it doesn't exist.
+func ProcessStartTime(pid int) int64 {
+	if !IsProcessRunning(pid) {
+		return 0
+	}
+
+	handle, err := syscall.OpenProcess(processQueryLimitedInformation, false, uint32(pid))
+	if err != nil {
+		return 0
+	}
+	defer syscall.CloseHandle(handle)
+
+	var creation, exit, kernel, user syscall.Filetime
+	err = syscall.GetProcessTimes(handle, &creation, &exit, &kernel, &user)
+	if err != nil {
+		return 0
+	}
+	return creation.Nanoseconds()
+}
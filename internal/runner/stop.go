@@ -0,0 +1,140 @@
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"gomander/internal/command/domain"
+	"gomander/internal/event"
+)
+
+// DefaultStopTimeout is how long a stopped command has to exit before it's killed, unless it sets its own timeout.
+var DefaultStopTimeout = 5 * time.Second
+
+// KillTimeout is how long a killed command is waited for before its output is closed, and then before giving up.
+var KillTimeout = 5 * time.Second
+
+var (
+	errStopCommandTimeout = errors.New("the stop command did not finish in time")
+	ErrProcessNotExited   = errors.New("the process did not exit after being killed")
+)
+
+// StoppingPayload is the payload of the ProcessStopping event. Signal is empty when a StopCommand is run.
+type StoppingPayload struct {
+	Id          string `json:"id"`
+	Signal      string `json:"signal"`
+	StopCommand string `json:"stopCommand"`
+	TimeoutMs   int64  `json:"timeoutMs"`
+}
+
+func stopTimeout(command *domain.Command) time.Duration {
+	if command.StopTimeoutSeconds > 0 {
+		return time.Duration(command.StopTimeoutSeconds) * time.Second
+	}
+	return DefaultStopTimeout
+}
+
+// stopProcess asks the command to stop, killing its process group once the grace period is over.
+func (c *DefaultRunner) stopProcess(runningCommand RunningCommand) error {
+	command := runningCommand.command
+	timeout := stopTimeout(command)
+	deadline := time.Now().Add(timeout)
+
+	payload := StoppingPayload{Id: command.Id, TimeoutMs: timeout.Milliseconds()}
+	if command.StopCommand != "" {
+		payload.StopCommand = command.StopCommand
+	} else {
+		payload.Signal = string(command.GetStopSignal())
+	}
+	c.eventEmitter.EmitEvent(event.ProcessStopping, payload)
+
+	var err error
+	if command.StopCommand != "" {
+		err = c.runStopCommand(command, runningCommand.cmd, deadline)
+		if err != nil {
+			c.sendStreamLine(command, "Stop command failed: "+err.Error()+", sending "+string(command.GetStopSignal()))
+		}
+	}
+	if command.StopCommand == "" || err != nil {
+		err = SignalProcessGroup(runningCommand.cmd, command.GetStopSignal())
+		if err != nil {
+			return KillProcessGroup(runningCommand.cmd)
+		}
+	}
+
+	select {
+	case <-runningCommand.exited:
+		return nil
+	case <-time.After(time.Until(deadline)):
+		c.sendStreamLine(command, "Not stopped after "+timeout.String()+", killing it")
+		err = KillProcessGroup(runningCommand.cmd)
+		return errors.Join(err, c.waitKilled(runningCommand))
+	}
+}
+
+// waitKilled waits for a killed command to exit, closing its output if it's still held after KillTimeout.
+func (c *DefaultRunner) waitKilled(runningCommand RunningCommand) error {
+	select {
+	case <-runningCommand.exited:
+		return nil
+	case <-time.After(KillTimeout):
+	}
+
+	c.sendStreamLine(runningCommand.command, "Output still open after killing it, closing it")
+	for _, output := range runningCommand.outputs {
+		_ = output.Close()
+	}
+
+	select {
+	case <-runningCommand.exited:
+		return nil
+	case <-time.After(KillTimeout):
+		return fmt.Errorf("%w: %s", ErrProcessNotExited, runningCommand.command.Id)
+	}
+}
+
+// runStopCommand runs the stop command with the working directory and environment of the running process.
+func (c *DefaultRunner) runStopCommand(command *domain.Command, cmd *exec.Cmd, deadline time.Time) error {
+	stopCmd := GetCommand(command.StopCommand)
+	stopCmd.Dir = cmd.Dir
+	stopCmd.Env = cmd.Env
+	SetProcAttributes(stopCmd)
+
+	// The same writer for both streams, so they are not written at the same time
+	var output bytes.Buffer
+	stopCmd.Stdout = &output
+	stopCmd.Stderr = &output
+
+	c.sendStreamLine(command, "Running the stop command: "+command.StopCommand)
+
+	err := stopCmd.Start()
+	if err != nil {
+		return err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- stopCmd.Wait()
+	}()
+
+	select {
+	case err = <-done:
+	case <-time.After(time.Until(deadline)):
+		_ = KillProcessGroup(stopCmd)
+		<-done
+		err = errStopCommandTimeout
+	}
+
+	for _, line := range strings.Split(output.String(), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if line != "" {
+			c.sendStreamLine(command, line)
+		}
+	}
+
+	return err
+}
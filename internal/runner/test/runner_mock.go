@@ -4,6 +4,8 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	commanddomain "gomander/internal/command/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+	"gomander/internal/runner"
 )
 
 type MockRunner struct {
@@ -15,13 +17,32 @@ func (m *MockRunner) StopRunningCommands(commands []commanddomain.Command) error
 	return args.Error(0)
 }
 
-func (m *MockRunner) RunCommands(commands []commanddomain.Command, environmentPaths []string, baseWorkingDirectory string) error {
-	args := m.Called(commands, environmentPaths, baseWorkingDirectory)
+func (m *MockRunner) RunCommands(commands []commanddomain.Command, options runner.RunOptions) error {
+	args := m.Called(commands, options)
 	return args.Error(0)
 }
 
-func (m *MockRunner) RunCommand(command *commanddomain.Command, environmentPaths []string, baseWorkingDirectory string) error {
-	args := m.Called(command, environmentPaths, baseWorkingDirectory)
+func (m *MockRunner) RunCommandsWithDependencies(commands []commanddomain.Command, dependencies map[string][]runner.Dependency, options runner.RunOptions) error {
+	args := m.Called(commands, dependencies, options)
+	return args.Error(0)
+}
+
+func (m *MockRunner) RunPipeline(id string, commands []commanddomain.Command, continueOnFailure bool, options runner.RunOptions) error {
+	args := m.Called(id, commands, continueOnFailure, options)
+	return args.Error(0)
+}
+
+func (m *MockRunner) StopPipeline(id string) {
+	m.Called(id)
+}
+
+func (m *MockRunner) GetPipelineStates() map[string]runner.PipelineState {
+	args := m.Called()
+	return args.Get(0).(map[string]runner.PipelineState)
+}
+
+func (m *MockRunner) RunCommand(command *commanddomain.Command, options runner.RunOptions) error {
+	args := m.Called(command, options)
 	return args.Error(0)
 }
 
@@ -35,7 +56,36 @@ func (m *MockRunner) StopAllRunningCommands() []error {
 	return args.Get(0).([]error)
 }
 
-func (m *MockRunner) GetRunningCommandIds() []string {
+func (m *MockRunner) GetRunStates() map[string]runner.RunState {
 	args := m.Called()
-	return args.Get(0).([]string)
+	return args.Get(0).(map[string]runner.RunState)
+}
+
+func (m *MockRunner) ResizeTerminal(id string, size runner.TerminalSize) error {
+	args := m.Called(id, size)
+	return args.Error(0)
+}
+
+func (m *MockRunner) WriteToCommand(id string, data string) error {
+	args := m.Called(id, data)
+	return args.Error(0)
+}
+
+func (m *MockRunner) AddOrphanedProcess(run commandrundomain.CommandRun) {
+	m.Called(run)
+}
+
+func (m *MockRunner) AdoptOrphanedProcess(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockRunner) RestartCommand(command *commanddomain.Command, options runner.RunOptions) error {
+	args := m.Called(command, options)
+	return args.Error(0)
+}
+
+func (m *MockRunner) RestartCommandGroup(id string, commands []commanddomain.Command, start func() error) error {
+	args := m.Called(id, commands, start)
+	return args.Error(0)
 }
@@ -0,0 +1,164 @@
+package runner
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"gomander/internal/command/domain"
+)
+
+// DefaultWatchDebounce is how long the watched files must be left unchanged before the command is restarted
+var DefaultWatchDebounce = 300 * time.Millisecond
+
+// defaultWatchIgnore are never watched, as they are rewritten by tools without the command needing a restart
+var defaultWatchIgnore = []string{"node_modules", ".git"}
+
+// fileWatch lasts from the moment its command is run until it's stopped or run again.
+type fileWatch struct {
+	done chan struct{}
+	once sync.Once
+}
+
+func (w *fileWatch) stop() {
+	w.once.Do(func() { close(w.done) })
+}
+
+// startFileWatch must be called with the mutex held. It replaces the file watch of the command, if any.
+func (c *DefaultRunner) startFileWatch(command *domain.Command, options RunOptions, directory string) {
+	c.stopFileWatch(command.Id)
+
+	if command.FileWatch == nil || len(command.FileWatch.Patterns) == 0 {
+		return
+	}
+
+	watch := &fileWatch{done: make(chan struct{})}
+	c.fileWatches[command.Id] = watch
+	go c.watchFiles(command, options, directory, watch)
+}
+
+// stopFileWatch must be called with the mutex held.
+func (c *DefaultRunner) stopFileWatch(id string) {
+	watch, exists := c.fileWatches[id]
+	if !exists {
+		return
+	}
+
+	watch.stop()
+	delete(c.fileWatches, id)
+}
+
+// watchFiles restarts the command once the files matching its patterns stopped changing for the debounce delay.
+func (c *DefaultRunner) watchFiles(command *domain.Command, options RunOptions, directory string, watch *fileWatch) {
+	patterns := command.FileWatch.Patterns
+	ignore := append(slices.Clone(defaultWatchIgnore), command.FileWatch.Ignore...)
+
+	debounce := DefaultWatchDebounce
+	if command.FileWatch.DebounceMs > 0 {
+		debounce = time.Duration(command.FileWatch.DebounceMs) * time.Millisecond
+	}
+
+	changes := make(chan string, 100)
+	go func() {
+		skip := func(name string) bool {
+			return matchesAnyGlob(ignore, name)
+		}
+		onChange := func(name string) {
+			if matchesAnyGlob(ignore, name) || !matchesAnyGlob(patterns, name) {
+				return
+			}
+			select {
+			case changes <- name:
+			default:
+				// A restart is already due
+			}
+		}
+
+		err := watchDirectory(directory, watch.done, skip, onChange)
+		if err != nil {
+			c.sendStreamLine(command, "Failed to watch the files: "+err.Error())
+		}
+	}()
+
+	var debounced <-chan time.Time
+	changed := ""
+	for {
+		select {
+		case <-watch.done:
+			return
+		case name := <-changes:
+			if changed == "" {
+				changed = name
+			}
+			debounced = time.After(debounce)
+		case <-debounced:
+			c.mutex.Lock()
+			stopped := c.fileWatches[command.Id] != watch
+			c.mutex.Unlock()
+			if stopped {
+				return
+			}
+
+			c.sendStreamLine(command, "Restarting after changes to "+changed)
+			err := c.RestartCommand(command, options)
+			if err != nil {
+				c.sendStreamLine(command, "Failed to restart: "+err.Error())
+			}
+
+			changed = ""
+			debounced = nil
+		}
+	}
+}
+
+// matchesAnyGlob reports whether the name matches one of the patterns, as in .gitignore.
+func matchesAnyGlob(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.TrimPrefix(filepath.ToSlash(pattern), "./")
+		if !strings.Contains(pattern, "/") {
+			pattern = "**/" + pattern
+		}
+
+		if matchGlobSegments(strings.Split(pattern, "/"), strings.Split(name, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchGlobSegments(pattern []string, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchGlobSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		matched, err := filepath.Match(pattern[0], name[0])
+		if err != nil || !matched {
+			return false
+		}
+
+		pattern, name = pattern[1:], name[1:]
+	}
+
+	return len(name) == 0
+}
+
+// relativeName returns the path relative to the watched directory with forward slashes.
+func relativeName(root string, path string) string {
+	name, err := filepath.Rel(root, path)
+	if err != nil || name == "." {
+		return ""
+	}
+	return filepath.ToSlash(name)
+}
@@ -0,0 +1,114 @@
+package runner
+
+import (
+	"encoding/binary"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+)
+
+const inotifyEvents = syscall.IN_CREATE | syscall.IN_CLOSE_WRITE | syscall.IN_DELETE | syscall.IN_MOVED_FROM | syscall.IN_MOVED_TO
+
+// watchDirectory calls onChange with the name of every file changed under root, using inotify, until done is closed.
+func watchDirectory(root string, done <-chan struct{}, skip func(name string) bool, onChange func(name string)) error {
+	fd, err := syscall.InotifyInit1(syscall.IN_CLOEXEC | syscall.IN_NONBLOCK)
+	if err != nil {
+		return os.NewSyscallError("inotify_init1", err)
+	}
+
+	// Being non-blocking, the file is handled by the runtime poller, so closing it interrupts the pending read
+	file := os.NewFile(uintptr(fd), "inotify")
+	defer file.Close()
+	go func() {
+		<-done
+		_ = file.Close()
+	}()
+
+	// directories holds the name of the directory of each watch descriptor
+	directories := make(map[int]string)
+	// addTree watches the directory and the ones below it, reporting the files of a new one as changed
+	addTree := func(name string, isNew bool) error {
+		return filepath.WalkDir(filepath.Join(root, name), func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if path == root {
+					return err
+				}
+				// It's gone or unreadable, there's nothing to watch
+				return nil
+			}
+
+			name := relativeName(root, path)
+			if !entry.IsDir() {
+				if isNew {
+					onChange(name)
+				}
+				return nil
+			}
+
+			if name != "" && skip(name) {
+				return filepath.SkipDir
+			}
+
+			wd, err := syscall.InotifyAddWatch(fd, path, inotifyEvents|syscall.IN_ONLYDIR)
+			if err != nil {
+				return os.NewSyscallError("inotify_add_watch", err)
+			}
+			directories[wd] = name
+			return nil
+		})
+	}
+
+	err = addTree("", false)
+	if err != nil {
+		return err
+	}
+
+	buffer := make([]byte, 64*1024)
+	for {
+		n, err := file.Read(buffer)
+		if err != nil {
+			if errors.Is(err, os.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		for offset := 0; offset+syscall.SizeofInotifyEvent <= n; {
+			wd := int(int32(binary.NativeEndian.Uint32(buffer[offset:])))
+			mask := binary.NativeEndian.Uint32(buffer[offset+4:])
+			length := int(binary.NativeEndian.Uint32(buffer[offset+12:]))
+			start := offset + syscall.SizeofInotifyEvent
+			offset = start + length
+
+			if mask&syscall.IN_IGNORED != 0 {
+				delete(directories, wd)
+				continue
+			}
+
+			directory, exists := directories[wd]
+			if !exists || length == 0 {
+				continue
+			}
+
+			name := strings.TrimRight(string(buffer[start:start+length]), "\x00")
+			if directory != "" {
+				name = directory + "/" + name
+			}
+
+			if mask&syscall.IN_ISDIR != 0 {
+				if mask&(syscall.IN_CREATE|syscall.IN_MOVED_TO) != 0 && !skip(name) {
+					err = addTree(name, true)
+					if err != nil {
+						return err
+					}
+				}
+				continue
+			}
+
+			onChange(name)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//go:build !linux
+
+package runner
+
+import (
+	"io/fs"
+	"path/filepath"
+	"time"
+)
+
+// watchPollInterval is how often the watched files are checked, as they are polled outside Linux
+const watchPollInterval = time.Second
+
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// watchDirectory calls onChange with the name of every file changed under root, polling it, until done is closed.
+func watchDirectory(root string, done <-chan struct{}, skip func(name string) bool, onChange func(name string)) error {
+	previous, err := scanDirectory(root, skip)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := scanDirectory(root, skip)
+		if err != nil {
+			continue
+		}
+
+		for name, state := range current {
+			if previousState, exists := previous[name]; !exists || previousState != state {
+				onChange(name)
+			}
+		}
+		for name := range previous {
+			if _, exists := current[name]; !exists {
+				onChange(name)
+			}
+		}
+		previous = current
+	}
+}
+
+func scanDirectory(root string, skip func(name string) bool) (map[string]fileState, error) {
+	files := make(map[string]fileState)
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return err
+			}
+			return nil
+		}
+
+		name := relativeName(root, path)
+		if name != "" && skip(name) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil
+		}
+		files[name] = fileState{modTime: info.ModTime(), size: info.Size()}
+		return nil
+	})
+	return files, err
+}
@@ -0,0 +1,147 @@
+package supervisor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"gomander/internal/runner"
+)
+
+// socketHost is only used to build the request URLs, the connection goes through the socket
+const socketHost = "http://supervisor"
+
+var (
+	// DialTimeout bounds the connection to the socket of the supervisor
+	DialTimeout = 5 * time.Second
+	// RequestTimeout bounds the requests that don't wait for commands to stop
+	RequestTimeout = 10 * time.Second
+)
+
+// Client sends the requests to a supervisor through its socket
+type Client struct {
+	httpClient *http.Client
+	// Pid is the process of the supervisor
+	Pid int
+}
+
+// Dial connects to the supervisor listening on the socket, failing when none is or when it speaks another protocol
+func Dial(socketPath string) (*Client, error) {
+	c := &Client{
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return (&net.Dialer{Timeout: DialTimeout}).DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+
+	var version VersionInfo
+	err := c.get(ctx, "/version", &version)
+	if err != nil {
+		return nil, err
+	}
+
+	if version.ProtocolVersion != ProtocolVersion {
+		return nil, fmt.Errorf("%w: %d instead of %d", ErrProtocolMismatch, version.ProtocolVersion, ProtocolVersion)
+	}
+
+	c.Pid = version.Pid
+	return c, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, result interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, socketHost+path, nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req, result)
+}
+
+func (c *Client) post(ctx context.Context, path string, body request, result interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, socketHost+path, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req, result)
+}
+
+func (c *Client) do(req *http.Request, result interface{}) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return readError(resp)
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+// events calls onMessage with each event emitted by the supervisor, until the context is done or the
+// supervisor goes away
+func (c *Client) events(ctx context.Context, onMessage func(streamedMessage)) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, socketHost+"/events", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return readError(resp)
+	}
+
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var message streamedMessage
+		err = decoder.Decode(&message)
+		if err != nil {
+			return err
+		}
+		onMessage(message)
+	}
+}
+
+// readError restores the runner errors the callers check for
+func readError(resp *http.Response) error {
+	var body errorResponse
+	if json.NewDecoder(resp.Body).Decode(&body) != nil || body.Error == "" {
+		return fmt.Errorf("supervisor: %s", resp.Status)
+	}
+
+	switch body.Code {
+	case errorCodeCommandNotRunning:
+		return runner.ErrCommandNotRunning
+	case errorCodeNotOrphaned:
+		return runner.ErrNotOrphaned
+	case errorCodeInputNotAccepted:
+		return runner.ErrInputNotAccepted
+	default:
+		return errors.New(body.Error)
+	}
+}
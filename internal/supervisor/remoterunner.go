@@ -0,0 +1,190 @@
+package supervisor
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"sync"
+	"time"
+
+	"gomander/internal/command/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+	"gomander/internal/event"
+	"gomander/internal/logger"
+	"gomander/internal/runner"
+)
+
+// RelayRetryDelay is the delay before reconnecting to the events of the supervisor once the stream is lost
+var RelayRetryDelay = time.Second
+
+// RemoteRunner runs the commands in the supervisor. Its events are emitted by Relay, as if the commands
+// were running in this process.
+type RemoteRunner struct {
+	client  *Client
+	emitter event.EventEmitter
+	logger  logger.Logger
+	mutex   sync.Mutex
+	// runStates and pipelineStates are the last states received from the supervisor
+	runStates      map[string]runner.RunState
+	pipelineStates map[string]runner.PipelineState
+}
+
+func NewRemoteRunner(client *Client, emitter event.EventEmitter, l logger.Logger) *RemoteRunner {
+	return &RemoteRunner{
+		client:         client,
+		emitter:        emitter,
+		logger:         l,
+		runStates:      make(map[string]runner.RunState),
+		pipelineStates: make(map[string]runner.PipelineState),
+	}
+}
+
+// Relay emits the events of the supervisor until the context is done, reconnecting whenever the stream is lost
+func (r *RemoteRunner) Relay(ctx context.Context) {
+	for {
+		err := r.client.events(ctx, r.emit)
+		if ctx.Err() != nil {
+			return
+		}
+		r.logger.Error("Lost the events of the supervisor: " + err.Error())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(RelayRetryDelay):
+		}
+	}
+}
+
+// call sends a request bounded by RequestTimeout. The requests waiting for commands to stop aren't bounded,
+// as they take as long as the stop timeouts of the commands.
+func (r *RemoteRunner) call(path string, body request, result interface{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+	return r.client.post(ctx, path, body, result)
+}
+
+func (r *RemoteRunner) emit(message streamedMessage) {
+	payload, err := runner.DecodeEventPayload(message.Event, message.Payload)
+	if err != nil {
+		r.logger.Error("Invalid payload for the event " + string(message.Event) + ": " + err.Error())
+		return
+	}
+	r.emitter.EmitEvent(message.Event, payload)
+}
+
+func (r *RemoteRunner) RunCommand(command *domain.Command, options runner.RunOptions) error {
+	return r.call("/run-command", request{Command: command, Options: options}, nil)
+}
+
+func (r *RemoteRunner) RunCommands(commands []domain.Command, options runner.RunOptions) error {
+	return r.call("/run-commands", request{Commands: commands, Options: options}, nil)
+}
+
+func (r *RemoteRunner) RunCommandsWithDependencies(commands []domain.Command, dependencies map[string][]runner.Dependency, options runner.RunOptions) error {
+	return r.call("/run-commands-with-dependencies", request{Commands: commands, Dependencies: dependencies, Options: options}, nil)
+}
+
+func (r *RemoteRunner) RunPipeline(id string, commands []domain.Command, continueOnFailure bool, options runner.RunOptions) error {
+	return r.call("/run-pipeline", request{Id: id, Commands: commands, ContinueOnFailure: continueOnFailure, Options: options}, nil)
+}
+
+func (r *RemoteRunner) StopPipeline(id string) {
+	err := r.call("/stop-pipeline", request{Id: id}, nil)
+	if err != nil {
+		r.logger.Error(err.Error())
+	}
+}
+
+// GetPipelineStates returns the last states received when the supervisor can't be reached
+func (r *RemoteRunner) GetPipelineStates() map[string]runner.PipelineState {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+
+	states := make(map[string]runner.PipelineState)
+	err := r.client.get(ctx, "/pipeline-states", &states)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err != nil {
+		r.logger.Error("Failed to get the pipeline states from the supervisor: " + err.Error())
+		return maps.Clone(r.pipelineStates)
+	}
+	r.pipelineStates = states
+	return maps.Clone(states)
+}
+
+func (r *RemoteRunner) StopRunningCommand(id string) error {
+	return r.client.post(context.Background(), "/stop-command", request{Id: id}, nil)
+}
+
+func (r *RemoteRunner) StopAllRunningCommands() []error {
+	var response stopAllResponse
+	err := r.client.post(context.Background(), "/stop-all", request{}, &response)
+	if err != nil {
+		return []error{err}
+	}
+
+	errs := make([]error, 0, len(response.Errors))
+	for _, message := range response.Errors {
+		errs = append(errs, errors.New(message))
+	}
+	return errs
+}
+
+func (r *RemoteRunner) StopRunningCommands(commands []domain.Command) error {
+	return r.client.post(context.Background(), "/stop-commands", request{Commands: commands}, nil)
+}
+
+// GetRunStates returns the last states received when the supervisor can't be reached, so the commands don't
+// look stopped
+func (r *RemoteRunner) GetRunStates() map[string]runner.RunState {
+	ctx, cancel := context.WithTimeout(context.Background(), RequestTimeout)
+	defer cancel()
+
+	states := make(map[string]runner.RunState)
+	err := r.client.get(ctx, "/run-states", &states)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err != nil {
+		r.logger.Error("Failed to get the run states from the supervisor: " + err.Error())
+		return maps.Clone(r.runStates)
+	}
+	r.runStates = states
+	return maps.Clone(states)
+}
+
+func (r *RemoteRunner) ResizeTerminal(id string, size runner.TerminalSize) error {
+	return r.call("/resize-terminal", request{Id: id, Size: size}, nil)
+}
+
+func (r *RemoteRunner) WriteToCommand(id string, data string) error {
+	return r.call("/write", request{Id: id, Data: data}, nil)
+}
+
+func (r *RemoteRunner) RestartCommand(command *domain.Command, options runner.RunOptions) error {
+	return r.client.post(context.Background(), "/restart-command", request{Command: command, Options: options}, nil)
+}
+
+// RestartCommandGroup stops the commands in the supervisor, and runs the group again from here once they exited
+func (r *RemoteRunner) RestartCommandGroup(id string, commands []domain.Command, start func() error) error {
+	err := r.client.post(context.Background(), "/restart-command-group", request{Id: id, Commands: commands}, nil)
+	if err != nil {
+		return err
+	}
+	return start()
+}
+
+func (r *RemoteRunner) AddOrphanedProcess(run commandrundomain.CommandRun) {
+	err := r.call("/add-orphaned-process", request{Run: &run}, nil)
+	if err != nil {
+		r.logger.Error(err.Error())
+	}
+}
+
+func (r *RemoteRunner) AdoptOrphanedProcess(id string) error {
+	return r.call("/adopt-orphaned-process", request{Id: id}, nil)
+}
@@ -0,0 +1,253 @@
+package supervisor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"gomander/internal/event"
+	"gomander/internal/logger"
+	"gomander/internal/runner"
+)
+
+// IdleTimeout is how long the supervisor keeps running once no command is running and no app is attached
+var IdleTimeout = time.Minute
+
+// Server exposes a runner to the app instances through the socket
+type Server struct {
+	runner     runner.Runner
+	subscriber event.Subscriber
+	logger     logger.Logger
+	// attached counts the apps listening to the events
+	attached atomic.Int32
+}
+
+func NewServer(r runner.Runner, subscriber event.Subscriber, l logger.Logger) *Server {
+	return &Server{
+		runner:     r,
+		subscriber: subscriber,
+		logger:     l,
+	}
+}
+
+// Serve answers the requests until the supervisor is idle for IdleTimeout, or until the context is done,
+// in which case the running commands are stopped first.
+func (s *Server) Serve(ctx context.Context, listener net.Listener) error {
+	server := &http.Server{Handler: s.handler()}
+
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(listener)
+	}()
+
+	ticker := time.NewTicker(min(time.Second, IdleTimeout))
+	defer ticker.Stop()
+
+	idleSince := time.Now()
+	for {
+		select {
+		case err := <-served:
+			return err
+		case <-ctx.Done():
+			s.logger.Info("Stopping the running commands...")
+			for _, err := range s.runner.StopAllRunningCommands() {
+				s.logger.Error(err.Error())
+			}
+			return s.close(server, listener)
+		case <-ticker.C:
+			if !s.idle() {
+				idleSince = time.Now()
+				continue
+			}
+			if time.Since(idleSince) >= IdleTimeout {
+				s.logger.Info("No command is running and no app is attached, exiting")
+				return s.close(server, listener)
+			}
+		}
+	}
+}
+
+func (s *Server) close(server *http.Server, listener net.Listener) error {
+	// Closing the listener removes the socket, even if the server didn't get to track it yet
+	_ = listener.Close()
+	return server.Close()
+}
+
+func (s *Server) idle() bool {
+	if s.attached.Load() > 0 {
+		return false
+	}
+
+	for _, state := range s.runner.GetRunStates() {
+		if state.IsActive() || state.Status == runner.RunStatusWaiting {
+			return false
+		}
+	}
+
+	for _, state := range s.runner.GetPipelineStates() {
+		if state.Status == runner.PipelineStatusRunning {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /version", s.handleVersion)
+	mux.HandleFunc("GET /events", s.handleEvents)
+	mux.HandleFunc("GET /run-states", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, s.runner.GetRunStates())
+	})
+	mux.HandleFunc("GET /pipeline-states", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, s.runner.GetPipelineStates())
+	})
+	mux.HandleFunc("POST /run-command", s.handle(func(req request) error {
+		if req.Command == nil {
+			return errInvalidRequest
+		}
+		return s.runner.RunCommand(req.Command, req.Options)
+	}))
+	mux.HandleFunc("POST /run-commands", s.handle(func(req request) error {
+		return s.runner.RunCommands(req.Commands, req.Options)
+	}))
+	mux.HandleFunc("POST /run-commands-with-dependencies", s.handle(func(req request) error {
+		return s.runner.RunCommandsWithDependencies(req.Commands, req.Dependencies, req.Options)
+	}))
+	mux.HandleFunc("POST /run-pipeline", s.handle(func(req request) error {
+		return s.runner.RunPipeline(req.Id, req.Commands, req.ContinueOnFailure, req.Options)
+	}))
+	mux.HandleFunc("POST /stop-pipeline", s.handle(func(req request) error {
+		s.runner.StopPipeline(req.Id)
+		return nil
+	}))
+	mux.HandleFunc("POST /stop-command", s.handle(func(req request) error {
+		return s.runner.StopRunningCommand(req.Id)
+	}))
+	mux.HandleFunc("POST /stop-commands", s.handle(func(req request) error {
+		return s.runner.StopRunningCommands(req.Commands)
+	}))
+	mux.HandleFunc("POST /stop-all", s.handleStopAll)
+	mux.HandleFunc("POST /resize-terminal", s.handle(func(req request) error {
+		return s.runner.ResizeTerminal(req.Id, req.Size)
+	}))
+	mux.HandleFunc("POST /write", s.handle(func(req request) error {
+		return s.runner.WriteToCommand(req.Id, req.Data)
+	}))
+	mux.HandleFunc("POST /restart-command", s.handle(func(req request) error {
+		if req.Command == nil {
+			return errInvalidRequest
+		}
+		return s.runner.RestartCommand(req.Command, req.Options)
+	}))
+	// The group is run again by the app, through the other requests
+	mux.HandleFunc("POST /restart-command-group", s.handle(func(req request) error {
+		return s.runner.RestartCommandGroup(req.Id, req.Commands, func() error { return nil })
+	}))
+	mux.HandleFunc("POST /add-orphaned-process", s.handle(func(req request) error {
+		if req.Run == nil {
+			return errInvalidRequest
+		}
+		s.runner.AddOrphanedProcess(*req.Run)
+		return nil
+	}))
+	mux.HandleFunc("POST /adopt-orphaned-process", s.handle(func(req request) error {
+		return s.runner.AdoptOrphanedProcess(req.Id)
+	}))
+
+	return mux
+}
+
+var errInvalidRequest = errors.New("invalid request")
+
+// handle decodes the request and answers with no content, or with the error of the runner
+func (s *Server) handle(call func(req request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err == nil {
+			err = call(req)
+		}
+
+		switch {
+		case err == nil:
+			w.WriteHeader(http.StatusNoContent)
+		case errors.Is(err, runner.ErrCommandNotRunning):
+			writeError(w, http.StatusConflict, errorCodeCommandNotRunning, err)
+		case errors.Is(err, runner.ErrNotOrphaned):
+			writeError(w, http.StatusConflict, errorCodeNotOrphaned, err)
+		case errors.Is(err, runner.ErrInputNotAccepted):
+			writeError(w, http.StatusConflict, errorCodeInputNotAccepted, err)
+		case errors.Is(err, errInvalidRequest), errors.As(err, new(*json.SyntaxError)):
+			writeError(w, http.StatusBadRequest, errorCodeInvalidRequest, err)
+		default:
+			writeError(w, http.StatusInternalServerError, errorCodeInternal, err)
+		}
+	}
+}
+
+func (s *Server) handleVersion(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, VersionInfo{ProtocolVersion: ProtocolVersion, Pid: os.Getpid()})
+}
+
+func (s *Server) handleStopAll(w http.ResponseWriter, _ *http.Request) {
+	response := stopAllResponse{Errors: make([]string, 0)}
+	for _, err := range s.runner.StopAllRunningCommands() {
+		response.Errors = append(response.Errors, err.Error())
+	}
+	writeJSON(w, response)
+}
+
+// handleEvents streams every event emitted by the runner, one JSON message per line, until the app disconnects
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, errorCodeInternal, errors.New("streaming is not supported"))
+		return
+	}
+
+	messages, unsubscribe := s.subscriber.Subscribe(nil)
+	defer unsubscribe()
+
+	s.attached.Add(1)
+	defer s.attached.Add(-1)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			err := encoder.Encode(message)
+			if err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, payload interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+func writeError(w http.ResponseWriter, status int, code errorCode, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(errorResponse{Error: err.Error(), Code: code})
+}
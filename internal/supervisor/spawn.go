@@ -0,0 +1,43 @@
+package supervisor
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+// StartTimeout is how long a spawned supervisor has to answer on its socket
+var StartTimeout = 5 * time.Second
+
+// EnsureRunning connects to the supervisor listening on the socket, starting it with the command when there is none.
+// The command is detached, so the supervisor survives this process.
+func EnsureRunning(socketPath string, cmd *exec.Cmd) (*Client, error) {
+	client, err := Dial(socketPath)
+	if err == nil || errors.Is(err, ErrProtocolMismatch) {
+		return client, err
+	}
+
+	detach(cmd)
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+	// Reaps the supervisor if it exits while this process is still running
+	go func() {
+		_ = cmd.Wait()
+	}()
+
+	deadline := time.Now().Add(StartTimeout)
+	for {
+		time.Sleep(50 * time.Millisecond)
+
+		client, err = Dial(socketPath)
+		if err == nil || errors.Is(err, ErrProtocolMismatch) {
+			return client, err
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("the supervisor didn't start: %w", err)
+		}
+	}
+}
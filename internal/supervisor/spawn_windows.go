@@ -0,0 +1,19 @@
+//go:build windows
+
+package supervisor
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detachedProcess starts the supervisor without the console of the app
+const detachedProcess = 0x00000008
+
+// detach starts the supervisor in its own process group, so it doesn't get the console events sent to the app
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		HideWindow:    true,
+		CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP | detachedProcess,
+	}
+}
@@ -0,0 +1,108 @@
+// Package supervisor runs the commands in a background process that outlives the window, so closing or updating
+// Gomander doesn't stop them. The app talks to it through a Unix socket, with a runner forwarding the calls and
+// relaying the events back.
+package supervisor
+
+import (
+	"encoding/json"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+
+	"gomander/internal/command/domain"
+	commandrundomain "gomander/internal/commandrun/domain"
+	"gomander/internal/event"
+	"gomander/internal/runner"
+)
+
+// ProtocolVersion changes whenever the app and a supervisor started by another version can't understand each other
+const ProtocolVersion = 1
+
+const socketName = "supervisor.sock"
+
+var (
+	ErrAlreadyRunning   = errors.New("a supervisor is already running")
+	ErrProtocolMismatch = errors.New("the running supervisor speaks another protocol version")
+)
+
+// SocketPath returns the socket of the supervisor in the directory, which is shared by all the app instances
+func SocketPath(directory string) string {
+	return filepath.Join(directory, socketName)
+}
+
+// Listen creates the socket, only accessible by the current user. A socket left by a supervisor that is gone
+// is replaced, but not the one of a running supervisor.
+func Listen(socketPath string) (net.Listener, error) {
+	if conn, err := net.Dial("unix", socketPath); err == nil {
+		_ = conn.Close()
+		return nil, ErrAlreadyRunning
+	}
+
+	err := os.MkdirAll(filepath.Dir(socketPath), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Remove(socketPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.Chmod(socketPath, 0600)
+	if err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// VersionInfo is returned by the supervisor so the app can check it's compatible before using it
+type VersionInfo struct {
+	ProtocolVersion int `json:"protocolVersion"`
+	Pid             int `json:"pid"`
+}
+
+// request holds the arguments of the runner methods, each endpoint reading the ones it needs
+type request struct {
+	Id                string                         `json:"id,omitempty"`
+	Command           *domain.Command                `json:"command,omitempty"`
+	Commands          []domain.Command               `json:"commands,omitempty"`
+	Dependencies      map[string][]runner.Dependency `json:"dependencies,omitempty"`
+	ContinueOnFailure bool                           `json:"continueOnFailure,omitempty"`
+	Options           runner.RunOptions              `json:"options"`
+	Size              runner.TerminalSize            `json:"size"`
+	Data              string                         `json:"data,omitempty"`
+	Run               *commandrundomain.CommandRun   `json:"run,omitempty"`
+}
+
+type stopAllResponse struct {
+	Errors []string `json:"errors"`
+}
+
+type errorCode string
+
+const (
+	errorCodeInvalidRequest    errorCode = "invalid_request"
+	errorCodeCommandNotRunning errorCode = "command_not_running"
+	errorCodeNotOrphaned       errorCode = "not_orphaned"
+	errorCodeInputNotAccepted  errorCode = "input_not_accepted"
+	errorCodeInternal          errorCode = "internal_error"
+)
+
+type errorResponse struct {
+	Error string    `json:"error"`
+	Code  errorCode `json:"code"`
+}
+
+// streamedMessage is an event of the stream, with the payload left encoded until its type is known
+type streamedMessage struct {
+	Event   event.Event     `json:"event"`
+	Payload json.RawMessage `json:"payload"`
+}
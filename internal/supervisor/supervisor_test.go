@@ -0,0 +1,344 @@
+//go:build !windows
+
+package supervisor_test
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"gomander/internal/command/domain"
+	"gomander/internal/event"
+	eventtest "gomander/internal/event/test"
+	loggertest "gomander/internal/logger/test"
+	"gomander/internal/runner"
+	runnertest "gomander/internal/runner/test"
+	"gomander/internal/supervisor"
+)
+
+// socketPath is kept short, as the length of socket paths is limited
+func socketPath(t *testing.T) string {
+	directory, err := os.MkdirTemp("", "sv")
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(directory) })
+	return supervisor.SocketPath(directory)
+}
+
+type startedSupervisor struct {
+	socketPath  string
+	runner      *runnertest.MockRunner
+	broadcaster *event.Broadcaster
+	cancel      context.CancelFunc
+	done        chan error
+}
+
+func newLogger() *loggertest.MockLogger {
+	mockLogger := new(loggertest.MockLogger)
+	mockLogger.On("Info", mock.Anything).Return().Maybe()
+	mockLogger.On("Error", mock.Anything).Return().Maybe()
+	return mockLogger
+}
+
+// startSupervisor serves a runner with nothing running, unless the setup expects otherwise
+func startSupervisor(t *testing.T, setup func(r *runnertest.MockRunner)) *startedSupervisor {
+	path := socketPath(t)
+	listener, err := supervisor.Listen(path)
+	assert.NoError(t, err)
+
+	s := &startedSupervisor{
+		socketPath:  path,
+		runner:      new(runnertest.MockRunner),
+		broadcaster: event.NewBroadcaster(event.DiscardEventEmitter{}),
+		done:        make(chan error, 1),
+	}
+
+	if setup != nil {
+		setup(s.runner)
+	}
+	s.runner.On("GetRunStates").Return(map[string]runner.RunState{}).Maybe()
+	s.runner.On("GetPipelineStates").Return(map[string]runner.PipelineState{}).Maybe()
+	s.runner.On("StopAllRunningCommands").Return([]error{}).Maybe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	server := supervisor.NewServer(s.runner, s.broadcaster, newLogger())
+	go func() {
+		s.done <- server.Serve(ctx, listener)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-s.done
+	})
+	return s
+}
+
+func TestRemoteRunner(t *testing.T) {
+	t.Run("Should run the commands in the supervisor", func(t *testing.T) {
+		// Arrange
+		command := &domain.Command{Id: "cmd-1", Name: "api", Command: "npm start"}
+		options := runner.RunOptions{BaseWorkingDirectory: "/src/shop", EnvFiles: []string{".env"}}
+
+		s := startSupervisor(t, func(r *runnertest.MockRunner) {
+			r.On("RunCommand", command, options).Return(nil)
+		})
+		client, err := supervisor.Dial(s.socketPath)
+		assert.NoError(t, err)
+
+		r := supervisor.NewRemoteRunner(client, nil, newLogger())
+
+		// Act
+		err = r.RunCommand(command, options)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, os.Getpid(), client.Pid)
+		s.runner.AssertExpectations(t)
+	})
+
+	t.Run("Should return the errors of the runner", func(t *testing.T) {
+		// Arrange
+		s := startSupervisor(t, func(r *runnertest.MockRunner) {
+			r.On("StopRunningCommand", "cmd-1").Return(runner.ErrCommandNotRunning)
+			r.On("StopAllRunningCommands").Return([]error{assert.AnError}).Once()
+		})
+		client, err := supervisor.Dial(s.socketPath)
+		assert.NoError(t, err)
+
+		r := supervisor.NewRemoteRunner(client, nil, newLogger())
+
+		// Act
+		stopErr := r.StopRunningCommand("cmd-1")
+		stopAllErrs := r.StopAllRunningCommands()
+
+		// Assert
+		assert.ErrorIs(t, stopErr, runner.ErrCommandNotRunning)
+		assert.Len(t, stopAllErrs, 1)
+		assert.EqualError(t, stopAllErrs[0], assert.AnError.Error())
+	})
+
+	t.Run("Should return the run states of the supervisor", func(t *testing.T) {
+		// Arrange
+		startedAt := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+		states := map[string]runner.RunState{
+			"cmd-1": {CommandId: "cmd-1", Status: runner.RunStatusRunning, StartedAt: startedAt},
+		}
+
+		s := startSupervisor(t, func(r *runnertest.MockRunner) {
+			r.On("GetRunStates").Return(states)
+		})
+		client, err := supervisor.Dial(s.socketPath)
+		assert.NoError(t, err)
+
+		r := supervisor.NewRemoteRunner(client, nil, newLogger())
+
+		// Act
+		result := r.GetRunStates()
+
+		// Assert
+		assert.Equal(t, states, result)
+	})
+
+	t.Run("Should return the last run states when the supervisor does not answer in time", func(t *testing.T) {
+		// Arrange
+		defer func(timeout time.Duration) { supervisor.RequestTimeout = timeout }(supervisor.RequestTimeout)
+		supervisor.RequestTimeout = 100 * time.Millisecond
+
+		states := map[string]runner.RunState{
+			"cmd-1": {CommandId: "cmd-1", Status: runner.RunStatusRunning, StartedAt: time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)},
+		}
+
+		path := socketPath(t)
+		listener, err := net.Listen("unix", path)
+		assert.NoError(t, err)
+
+		answered := false
+		server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Path == "/version":
+				_ = json.NewEncoder(w).Encode(supervisor.VersionInfo{ProtocolVersion: supervisor.ProtocolVersion})
+			case !answered:
+				answered = true
+				_ = json.NewEncoder(w).Encode(states)
+			default:
+				<-r.Context().Done()
+			}
+		})}
+		go func() { _ = server.Serve(listener) }()
+		defer server.Close()
+
+		client, err := supervisor.Dial(path)
+		assert.NoError(t, err)
+
+		mockLogger := new(loggertest.MockLogger)
+		mockLogger.On("Error", mock.MatchedBy(func(message string) bool {
+			return strings.HasPrefix(message, "Failed to get the run states from the supervisor")
+		})).Return().Once()
+
+		r := supervisor.NewRemoteRunner(client, nil, mockLogger)
+		r.GetRunStates()
+
+		// Act
+		result := r.GetRunStates()
+
+		// Assert
+		assert.Equal(t, states, result)
+		mockLogger.AssertExpectations(t)
+	})
+
+	t.Run("Should emit the events of the supervisor with their payload", func(t *testing.T) {
+		// Arrange
+		s := startSupervisor(t, nil)
+		client, err := supervisor.Dial(s.socketPath)
+		assert.NoError(t, err)
+
+		started := make(chan struct{}, 100)
+		logged := make(chan struct{}, 1)
+		mockEmitter := new(eventtest.MockEventEmitter)
+		mockEmitter.On("EmitEvent", event.ProcessStarted, "cmd-1").Return().Run(func(mock.Arguments) { started <- struct{}{} })
+		mockEmitter.On("EmitEvent", event.NewLogEntry, map[string]string{"id": "cmd-1", "line": "Listening"}).Return().Run(func(mock.Arguments) { logged <- struct{}{} })
+
+		r := supervisor.NewRemoteRunner(client, mockEmitter, newLogger())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go r.Relay(ctx)
+
+		// The events are only streamed once the app is attached
+		assert.Eventually(t, func() bool {
+			s.broadcaster.EmitEvent(event.ProcessStarted, "cmd-1")
+			select {
+			case <-started:
+				return true
+			case <-time.After(10 * time.Millisecond):
+				return false
+			}
+		}, time.Second, 10*time.Millisecond)
+
+		// Act
+		s.broadcaster.EmitEvent(event.NewLogEntry, map[string]string{"id": "cmd-1", "line": "Listening"})
+
+		// Assert
+		select {
+		case <-logged:
+		case <-time.After(time.Second):
+			t.Fatal("the log entry was not relayed")
+		}
+		mockEmitter.AssertExpectations(t)
+	})
+}
+
+func TestDial(t *testing.T) {
+	t.Run("Should fail when no supervisor is running", func(t *testing.T) {
+		// Act
+		_, err := supervisor.Dial(socketPath(t))
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("Should fail when the supervisor speaks another protocol", func(t *testing.T) {
+		// Arrange
+		path := socketPath(t)
+		listener, err := net.Listen("unix", path)
+		assert.NoError(t, err)
+
+		server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_ = json.NewEncoder(w).Encode(supervisor.VersionInfo{ProtocolVersion: supervisor.ProtocolVersion + 1})
+		})}
+		go func() { _ = server.Serve(listener) }()
+		defer server.Close()
+
+		// Act
+		_, err = supervisor.Dial(path)
+
+		// Assert
+		assert.ErrorIs(t, err, supervisor.ErrProtocolMismatch)
+	})
+}
+
+func TestListen(t *testing.T) {
+	t.Run("Should fail when a supervisor is already running", func(t *testing.T) {
+		// Arrange
+		s := startSupervisor(t, nil)
+
+		// Act
+		_, err := supervisor.Listen(s.socketPath)
+
+		// Assert
+		assert.ErrorIs(t, err, supervisor.ErrAlreadyRunning)
+	})
+
+	t.Run("Should replace the socket left by a supervisor that is gone", func(t *testing.T) {
+		// Arrange
+		path := socketPath(t)
+		assert.NoError(t, os.WriteFile(path, nil, 0600))
+
+		// Act
+		listener, err := supervisor.Listen(path)
+
+		// Assert
+		assert.NoError(t, err)
+		defer listener.Close()
+
+		info, err := os.Stat(path)
+		assert.NoError(t, err)
+		assert.Equal(t, os.ModeSocket, info.Mode().Type())
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	})
+}
+
+func TestServer_Serve(t *testing.T) {
+	t.Run("Should exit once idle, removing the socket", func(t *testing.T) {
+		// Arrange
+		previousTimeout := supervisor.IdleTimeout
+		supervisor.IdleTimeout = 50 * time.Millisecond
+		defer func() { supervisor.IdleTimeout = previousTimeout }()
+
+		s := startSupervisor(t, func(r *runnertest.MockRunner) {
+			r.On("GetRunStates").Return(map[string]runner.RunState{
+				"cmd-1": {CommandId: "cmd-1", Status: runner.RunStatusExitedOk},
+			})
+		})
+
+		// Act & Assert
+		select {
+		case err := <-s.done:
+			assert.NoError(t, err)
+			s.done <- err
+		case <-time.After(2 * time.Second):
+			t.Fatal("the supervisor didn't exit")
+		}
+
+		_, err := os.Stat(s.socketPath)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("Should stop the commands when the context is done", func(t *testing.T) {
+		// Arrange
+		s := startSupervisor(t, func(r *runnertest.MockRunner) {
+			r.On("StopAllRunningCommands").Return([]error{}).Once()
+		})
+
+		// Act
+		s.cancel()
+
+		// Assert
+		select {
+		case err := <-s.done:
+			assert.NoError(t, err)
+			s.done <- err
+		case <-time.After(2 * time.Second):
+			t.Fatal("the supervisor didn't exit")
+		}
+		s.runner.AssertExpectations(t)
+	})
+}
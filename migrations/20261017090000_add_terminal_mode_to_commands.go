@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddTerminalModeToCommands, downAddTerminalModeToCommands)
+}
+
+func upAddTerminalModeToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command ADD COLUMN terminal_mode BOOLEAN DEFAULT FALSE;
+	`)
+	return err
+}
+
+func downAddTerminalModeToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command DROP COLUMN terminal_mode;
+	`)
+	return err
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddEnvironmentVariablesToProjectsAndCommands, downAddEnvironmentVariablesToProjectsAndCommands)
+}
+
+func upAddEnvironmentVariablesToProjectsAndCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE project ADD COLUMN environment_variables TEXT;
+		ALTER TABLE command ADD COLUMN environment_variables TEXT;
+	`)
+	return err
+}
+
+func downAddEnvironmentVariablesToProjectsAndCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE project DROP COLUMN environment_variables;
+		ALTER TABLE command DROP COLUMN environment_variables;
+	`)
+	return err
+}
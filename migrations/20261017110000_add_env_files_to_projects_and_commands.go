@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddEnvFilesToProjectsAndCommands, downAddEnvFilesToProjectsAndCommands)
+}
+
+func upAddEnvFilesToProjectsAndCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE project ADD COLUMN env_files TEXT;
+		ALTER TABLE command ADD COLUMN env_files TEXT;
+	`)
+	return err
+}
+
+func downAddEnvFilesToProjectsAndCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE project DROP COLUMN env_files;
+		ALTER TABLE command DROP COLUMN env_files;
+	`)
+	return err
+}
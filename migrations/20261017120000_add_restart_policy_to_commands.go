@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddRestartPolicyToCommands, downAddRestartPolicyToCommands)
+}
+
+func upAddRestartPolicyToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command ADD COLUMN restart_policy TEXT DEFAULT 'never';
+		ALTER TABLE command ADD COLUMN max_restarts INTEGER DEFAULT 0;
+	`)
+	return err
+}
+
+func downAddRestartPolicyToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command DROP COLUMN restart_policy;
+		ALTER TABLE command DROP COLUMN max_restarts;
+	`)
+	return err
+}
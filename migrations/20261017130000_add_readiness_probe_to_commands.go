@@ -0,0 +1,28 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddReadinessProbeToCommands, downAddReadinessProbeToCommands)
+}
+
+func upAddReadinessProbeToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command ADD COLUMN readiness_probe TEXT;
+	`)
+	return err
+}
+
+func downAddReadinessProbeToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command DROP COLUMN readiness_probe;
+	`)
+	return err
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upCreateCommandGroupDependencyTable, downCreateCommandGroupDependencyTable)
+}
+
+func upCreateCommandGroupDependencyTable(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE command_group_dependency (
+			command_group_id TEXT NOT NULL,
+			command_id TEXT NOT NULL,
+			depends_on_command_id TEXT NOT NULL,
+			condition TEXT,
+			position INTEGER,
+			PRIMARY KEY (command_group_id, command_id, depends_on_command_id)
+		);
+	`)
+	return err
+}
+
+func downCreateCommandGroupDependencyTable(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE command_group_dependency;
+	`)
+	return err
+}
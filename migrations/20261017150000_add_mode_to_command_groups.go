@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddModeToCommandGroups, downAddModeToCommandGroups)
+}
+
+func upAddModeToCommandGroups(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command_group ADD COLUMN mode TEXT DEFAULT 'parallel';
+		ALTER TABLE command_group ADD COLUMN continue_on_failure BOOLEAN DEFAULT FALSE;
+	`)
+	return err
+}
+
+func downAddModeToCommandGroups(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command_group DROP COLUMN mode;
+		ALTER TABLE command_group DROP COLUMN continue_on_failure;
+	`)
+	return err
+}
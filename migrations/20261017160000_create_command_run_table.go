@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upCreateCommandRunTable, downCreateCommandRunTable)
+}
+
+func upCreateCommandRunTable(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		CREATE TABLE command_run (
+			id TEXT PRIMARY KEY,
+			command_id TEXT NOT NULL,
+			command TEXT,
+			working_directory TEXT,
+			trigger_source TEXT,
+			status TEXT,
+			started_at DATETIME NOT NULL,
+			finished_at DATETIME,
+			exit_code INTEGER,
+			error_detected BOOLEAN DEFAULT FALSE
+		);
+		CREATE INDEX idx_command_run_command_id_started_at ON command_run (command_id, started_at);
+	`)
+	return err
+}
+
+func downCreateCommandRunTable(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		DROP TABLE command_run;
+	`)
+	return err
+}
@@ -0,0 +1,98 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upConvertErrorPatternsToJson, downConvertErrorPatternsToJson)
+}
+
+// errorPatternRow mirrors the stored error patterns, which were previously plain text separated by new lines.
+type errorPatternRow struct {
+	Pattern  string `json:"pattern"`
+	Regex    bool   `json:"regex"`
+	Severity string `json:"severity"`
+	Label    string `json:"label"`
+}
+
+func upConvertErrorPatternsToJson(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	return convertErrorPatterns(ctx, tx, func(data string) (string, error) {
+		patterns := make([]errorPatternRow, 0)
+		for _, pattern := range strings.Split(data, "\n") {
+			if pattern != "" {
+				patterns = append(patterns, errorPatternRow{Pattern: pattern, Severity: "error"})
+			}
+		}
+		if len(patterns) == 0 {
+			return "", nil
+		}
+
+		converted, err := json.Marshal(patterns)
+		return string(converted), err
+	})
+}
+
+func downConvertErrorPatternsToJson(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	return convertErrorPatterns(ctx, tx, func(data string) (string, error) {
+		var patterns []errorPatternRow
+		err := json.Unmarshal([]byte(data), &patterns)
+		if err != nil {
+			return "", err
+		}
+
+		texts := make([]string, 0, len(patterns))
+		for _, pattern := range patterns {
+			texts = append(texts, pattern.Pattern)
+		}
+		return strings.Join(texts, "\n"), nil
+	})
+}
+
+func convertErrorPatterns(ctx context.Context, tx *sql.Tx, convert func(string) (string, error)) error {
+	rows, err := tx.QueryContext(ctx, `SELECT id, error_patterns FROM command WHERE error_patterns IS NOT NULL AND error_patterns != ''`)
+	if err != nil {
+		return err
+	}
+
+	converted := make(map[string]string)
+	for rows.Next() {
+		var id, data string
+		err = rows.Scan(&id, &data)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+
+		converted[id], err = convert(data)
+		if err != nil {
+			_ = rows.Close()
+			return err
+		}
+	}
+	err = rows.Err()
+	if err != nil {
+		_ = rows.Close()
+		return err
+	}
+	err = rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for id, data := range converted {
+		_, err = tx.ExecContext(ctx, `UPDATE command SET error_patterns = ? WHERE id = ?`, data, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
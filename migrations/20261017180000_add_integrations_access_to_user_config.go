@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddIntegrationsAccessToUserConfig, downAddIntegrationsAccessToUserConfig)
+}
+
+func upAddIntegrationsAccessToUserConfig(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE user_config ADD COLUMN integrations_token TEXT DEFAULT '';
+		ALTER TABLE user_config ADD COLUMN integrations_lan_access BOOLEAN DEFAULT FALSE;
+	`)
+	return err
+}
+
+func downAddIntegrationsAccessToUserConfig(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE user_config DROP COLUMN integrations_token;
+		ALTER TABLE user_config DROP COLUMN integrations_lan_access;
+	`)
+	return err
+}
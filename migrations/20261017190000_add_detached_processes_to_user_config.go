@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddDetachedProcessesToUserConfig, downAddDetachedProcessesToUserConfig)
+}
+
+func upAddDetachedProcessesToUserConfig(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE user_config ADD COLUMN detached_processes BOOLEAN DEFAULT FALSE")
+	return err
+}
+
+func downAddDetachedProcessesToUserConfig(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE user_config DROP COLUMN detached_processes")
+	return err
+}
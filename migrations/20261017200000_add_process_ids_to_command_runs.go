@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddProcessIdsToCommandRuns, downAddProcessIdsToCommandRuns)
+}
+
+func upAddProcessIdsToCommandRuns(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command_run ADD COLUMN pid INTEGER DEFAULT 0;
+		ALTER TABLE command_run ADD COLUMN pgid INTEGER DEFAULT 0;
+		ALTER TABLE command_run ADD COLUMN owner_pid INTEGER DEFAULT 0;
+	`)
+	return err
+}
+
+func downAddProcessIdsToCommandRuns(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command_run DROP COLUMN pid;
+		ALTER TABLE command_run DROP COLUMN pgid;
+		ALTER TABLE command_run DROP COLUMN owner_pid;
+	`)
+	return err
+}
@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddStopOptionsToCommands, downAddStopOptionsToCommands)
+}
+
+func upAddStopOptionsToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command ADD COLUMN stop_signal TEXT DEFAULT '';
+		ALTER TABLE command ADD COLUMN stop_timeout_seconds INTEGER DEFAULT 0;
+		ALTER TABLE command ADD COLUMN stop_command TEXT DEFAULT '';
+	`)
+	return err
+}
+
+func downAddStopOptionsToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, `
+		ALTER TABLE command DROP COLUMN stop_signal;
+		ALTER TABLE command DROP COLUMN stop_timeout_seconds;
+		ALTER TABLE command DROP COLUMN stop_command;
+	`)
+	return err
+}
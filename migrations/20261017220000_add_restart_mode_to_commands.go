@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddRestartModeToCommands, downAddRestartModeToCommands)
+}
+
+func upAddRestartModeToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE command ADD COLUMN restart_mode TEXT DEFAULT ''")
+	return err
+}
+
+func downAddRestartModeToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE command DROP COLUMN restart_mode")
+	return err
+}
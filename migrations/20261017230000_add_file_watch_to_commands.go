@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddFileWatchToCommands, downAddFileWatchToCommands)
+}
+
+func upAddFileWatchToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE command ADD COLUMN file_watch TEXT DEFAULT ''")
+	return err
+}
+
+func downAddFileWatchToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE command DROP COLUMN file_watch")
+	return err
+}
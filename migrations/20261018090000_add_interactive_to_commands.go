@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddInteractiveToCommands, downAddInteractiveToCommands)
+}
+
+func upAddInteractiveToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE command ADD COLUMN interactive BOOLEAN DEFAULT FALSE")
+	return err
+}
+
+func downAddInteractiveToCommands(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE command DROP COLUMN interactive")
+	return err
+}
@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddProcessStartTimeToCommandRuns, downAddProcessStartTimeToCommandRuns)
+}
+
+func upAddProcessStartTimeToCommandRuns(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is applied.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE command_run ADD COLUMN process_start_time INTEGER DEFAULT 0")
+	return err
+}
+
+func downAddProcessStartTimeToCommandRuns(ctx context.Context, tx *sql.Tx) error {
+	// This code is executed when the migration is rolled back.
+	_, err := tx.ExecContext(ctx, "ALTER TABLE command_run DROP COLUMN process_start_time")
+	return err
+}